@@ -0,0 +1,388 @@
+// Command windigo-rc compiles icons, a manifest, version information, string
+// tables, accelerator tables and dialogs into a .syso object, which is linked
+// by "go build" when placed in the package folder, or into a .res file.
+//
+// Usage:
+//
+//	windigo-rc [flags]
+//
+// Example:
+//
+//	windigo-rc -arch amd64 -ico app.ico -manifest app.exe.manifest \
+//		-version 1.2.0.0 -product "My App" -company "Me"
+//
+// This writes rsrc_windows_amd64.syso, whose icon has the resource ID 101.
+//
+// Accelerator tables and dialogs are read from JSON files, keyed by resource
+// ID, which can be a number or a name:
+//
+//	{"101": [
+//		{"key": 83, "cmd": 2001, "virtkey": true, "control": true},
+//		{"key": 112, "cmd": 2002, "virtkey": true}
+//	]}
+//
+//	{"IDD_ABOUT": {
+//		"style": 2160590976, "cx": 160, "cy": 60, "title": "About",
+//		"fontSize": 8, "fontFace": "MS Shell Dlg",
+//		"controls": [
+//			{"class": 128, "title": "OK", "id": 1, "style": 1342242817,
+//				"x": 55, "y": 40, "cx": 50, "cy": 14}
+//		]
+//	}}
+//
+// A dialog "class", "menu" or control "title" can also be a number or a
+// string; the predefined control classes are the atoms 128 (button) to 133
+// (combo box).
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rodrigocfd/windigo/rc"
+)
+
+type _ListFlag []string
+
+func (me *_ListFlag) String() string {
+	return strings.Join(*me, ",")
+}
+
+func (me *_ListFlag) Set(value string) error {
+	*me = append(*me, value)
+	return nil
+}
+
+func main() {
+	var icons _ListFlag
+	flag.Var(&icons, "ico", ".ico file; can be repeated, and the icons get sequential IDs")
+	icoId := flag.Uint("icoid", 101, "resource ID of the first icon")
+	manifest := flag.String("manifest", "", "application manifest file")
+	version := flag.String("version", "", "file and product version, like 1.2.0.0")
+	company := flag.String("company", "", "CompanyName version string")
+	product := flag.String("product", "", "ProductName version string")
+	description := flag.String("description", "", "FileDescription version string")
+	copyright := flag.String("copyright", "", "LegalCopyright version string")
+	stringsPath := flag.String("strings", "", `JSON file with the string table, like {"1001": "Hello"}`)
+	accelPath := flag.String("accel", "", "JSON file with accelerator tables")
+	dialogPath := flag.String("dialog", "", "JSON file with dialog templates")
+	lang := flag.String("lang", "0x0409", "language ID of the resources")
+	arch := flag.String("arch", runtime.GOARCH, "target architecture: 386, amd64 or arm64")
+	out := flag.String("o", "", "output file; .res extension writes a .res file (default rsrc_windows_ARCH.syso)")
+	flag.Parse()
+
+	if err := run(icons, uint16(*icoId), *manifest, *version, *company, *product,
+		*description, *copyright, *stringsPath, *accelPath, *dialogPath,
+		*lang, *arch, *out); err != nil {
+		fmt.Fprintln(os.Stderr, "windigo-rc:", err)
+		os.Exit(1)
+	}
+}
+
+func run(
+	icons []string, icoId uint16,
+	manifest, version, company, product, description, copyright string,
+	stringsPath, accelPath, dialogPath, lang, arch, out string,
+) error {
+	langId, err := strconv.ParseUint(lang, 0, 16)
+	if err != nil {
+		return fmt.Errorf("invalid language ID: %s", lang)
+	}
+
+	res := rc.New()
+
+	for i, icoPath := range icons {
+		ico, err := os.ReadFile(icoPath)
+		if err != nil {
+			return err
+		}
+		if err := res.AddIcon(rc.Num(icoId+uint16(i)), uint16(langId), ico); err != nil {
+			return fmt.Errorf("%s: %w", icoPath, err)
+		}
+	}
+
+	if manifest != "" {
+		contents, err := os.ReadFile(manifest)
+		if err != nil {
+			return err
+		}
+		res.AddManifest(uint16(langId), contents)
+	}
+
+	if version != "" {
+		info, err := versionInfo(uint16(langId), version, company, product, description, copyright)
+		if err != nil {
+			return err
+		}
+		res.AddVersion(uint16(langId), info)
+	}
+
+	if stringsPath != "" {
+		strs, err := stringTable(stringsPath)
+		if err != nil {
+			return err
+		}
+		res.AddStringTable(uint16(langId), strs)
+	}
+
+	if accelPath != "" {
+		tables, err := accelTables(accelPath)
+		if err != nil {
+			return err
+		}
+		for _, name := range sortedKeys(tables) {
+			res.AddAccelerators(resId(name), uint16(langId), tables[name])
+		}
+	}
+
+	if dialogPath != "" {
+		dlgs, err := dialogs(dialogPath)
+		if err != nil {
+			return err
+		}
+		for _, name := range sortedKeys(dlgs) {
+			if err := res.AddDialog(resId(name), uint16(langId), dlgs[name]); err != nil {
+				return fmt.Errorf("%s: dialog %s: %w", dialogPath, name, err)
+			}
+		}
+	}
+
+	if out == "" {
+		out = "rsrc_windows_" + arch + ".syso"
+	}
+	if strings.HasSuffix(strings.ToLower(out), ".res") {
+		return os.WriteFile(out, res.Res(), 0o644)
+	}
+
+	machine, err := rc.ParseArch(arch)
+	if err != nil {
+		return err
+	}
+	syso, err := res.Coff(machine)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(out, syso, 0o644)
+}
+
+func versionInfo(
+	langId uint16,
+	version, company, product, description, copyright string,
+) (*rc.VersionInfo, error) {
+	var nums [4]uint16
+	parts := strings.Split(version, ".")
+	if len(parts) > 4 {
+		return nil, fmt.Errorf("invalid version: %s", version)
+	}
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version: %s", version)
+		}
+		nums[i] = uint16(n)
+	}
+	verStr := fmt.Sprintf("%d.%d.%d.%d", nums[0], nums[1], nums[2], nums[3])
+
+	strs := []rc.VersionString{}
+	for _, pair := range [][2]string{
+		{"CompanyName", company},
+		{"FileDescription", description},
+		{"FileVersion", verStr},
+		{"LegalCopyright", copyright},
+		{"ProductName", product},
+		{"ProductVersion", verStr},
+	} {
+		if pair[1] != "" {
+			strs = append(strs, rc.VersionString{Key: pair[0], Value: pair[1]})
+		}
+	}
+
+	const cpUnicode = 1200
+	return &rc.VersionInfo{
+		FileVersion:    nums,
+		ProductVersion: nums,
+		FileFlagsMask:  0x3f,
+		FileOS:         0x0004_0004, // VOS_NT_WINDOWS32
+		FileType:       1,           // VFT_APP
+		StringTables: []rc.VersionStringTable{{
+			LangId:   langId,
+			CodePage: cpUnicode,
+			Strings:  strs,
+		}},
+		Translations: []rc.VersionTranslation{{LangId: langId, CodePage: cpUnicode}},
+	}, nil
+}
+
+func stringTable(path string) (map[uint16]string, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]string
+	if err := json.Unmarshal(contents, &raw); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	strs := make(map[uint16]string, len(raw))
+	for key, str := range raw {
+		id, err := strconv.ParseUint(key, 0, 16)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid string ID: %s", path, key)
+		}
+		strs[uint16(id)] = str
+	}
+	return strs, nil
+}
+
+// Converts a JSON key into a resource ID: a number, or else a name.
+func resId(key string) rc.Id {
+	if n, err := strconv.ParseUint(key, 0, 16); err == nil {
+		return rc.Num(uint16(n))
+	}
+	return rc.Str(key)
+}
+
+// Returns the keys of the map in ascending order, so the output is the same
+// regardless of the map iteration order.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// A number or a string in the JSON file, converted to an rc.Id.
+type _JsonId struct {
+	rc.Id
+}
+
+func (me *_JsonId) UnmarshalJSON(data []byte) error {
+	var n uint16
+	if err := json.Unmarshal(data, &n); err == nil {
+		me.Id = rc.Num(n)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("expected a number or a string: %s", data)
+	}
+	me.Id = rc.Str(s)
+	return nil
+}
+
+func accelTables(path string) (map[string][]rc.Accel, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string][]struct {
+		Key      uint16 `json:"key"`
+		Cmd      uint16 `json:"cmd"`
+		VirtKey  bool   `json:"virtkey"`
+		Shift    bool   `json:"shift"`
+		Control  bool   `json:"control"`
+		Alt      bool   `json:"alt"`
+		NoInvert bool   `json:"noinvert"`
+	}
+	if err := json.Unmarshal(contents, &raw); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	tables := make(map[string][]rc.Accel, len(raw))
+	for name, entries := range raw {
+		accels := make([]rc.Accel, 0, len(entries))
+		for _, entry := range entries {
+			var flags uint8
+			for _, pair := range []struct {
+				set  bool
+				flag uint8
+			}{
+				{entry.VirtKey, rc.FVIRTKEY},
+				{entry.Shift, rc.FSHIFT},
+				{entry.Control, rc.FCONTROL},
+				{entry.Alt, rc.FALT},
+				{entry.NoInvert, rc.FNOINVERT},
+			} {
+				if pair.set {
+					flags |= pair.flag
+				}
+			}
+			accels = append(accels, rc.Accel{Flags: flags, Key: entry.Key, Cmd: entry.Cmd})
+		}
+		tables[name] = accels
+	}
+	return tables, nil
+}
+
+func dialogs(path string) (map[string]*rc.Dialog, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]struct {
+		HelpId      uint32  `json:"helpId"`
+		ExStyle     uint32  `json:"exStyle"`
+		Style       uint32  `json:"style"`
+		X           int16   `json:"x"`
+		Y           int16   `json:"y"`
+		Cx          int16   `json:"cx"`
+		Cy          int16   `json:"cy"`
+		Menu        _JsonId `json:"menu"`
+		Class       _JsonId `json:"class"`
+		Title       string  `json:"title"`
+		FontSize    uint16  `json:"fontSize"`
+		FontWeight  uint16  `json:"fontWeight"`
+		FontItalic  bool    `json:"fontItalic"`
+		FontCharset uint8   `json:"fontCharset"`
+		FontFace    string  `json:"fontFace"`
+		Controls    []struct {
+			HelpId       uint32  `json:"helpId"`
+			ExStyle      uint32  `json:"exStyle"`
+			Style        uint32  `json:"style"`
+			X            int16   `json:"x"`
+			Y            int16   `json:"y"`
+			Cx           int16   `json:"cx"`
+			Cy           int16   `json:"cy"`
+			Id           uint32  `json:"id"`
+			Class        _JsonId `json:"class"`
+			Title        _JsonId `json:"title"`
+			CreationData []byte  `json:"creationData"` // base64
+		} `json:"controls"`
+	}
+	if err := json.Unmarshal(contents, &raw); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	dlgs := make(map[string]*rc.Dialog, len(raw))
+	for name, d := range raw {
+		dlg := &rc.Dialog{
+			HelpId: d.HelpId, ExStyle: d.ExStyle, Style: d.Style,
+			X: d.X, Y: d.Y, Cx: d.Cx, Cy: d.Cy,
+			Menu: d.Menu.Id, Class: d.Class.Id, Title: d.Title,
+			FontSize: d.FontSize, FontWeight: d.FontWeight, FontItalic: d.FontItalic,
+			FontCharset: d.FontCharset, FontFace: d.FontFace,
+			Controls: make([]rc.DialogControl, 0, len(d.Controls)),
+		}
+		for _, c := range d.Controls {
+			if c.Class.IsZero() {
+				return nil, fmt.Errorf("%s: dialog %s: control %d has no class", path, name, c.Id)
+			}
+			dlg.Controls = append(dlg.Controls, rc.DialogControl{
+				HelpId: c.HelpId, ExStyle: c.ExStyle, Style: c.Style,
+				X: c.X, Y: c.Y, Cx: c.Cx, Cy: c.Cy,
+				Id: c.Id, Class: c.Class.Id, Title: c.Title.Id,
+				CreationData: c.CreationData,
+			})
+		}
+		dlgs[name] = dlg
+	}
+	return dlgs, nil
+}
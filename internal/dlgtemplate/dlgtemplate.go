@@ -0,0 +1,143 @@
+// Package dlgtemplate serializes dialog templates in the DLGTEMPLATEEX format,
+// shared by the rc and ui packages, which convert their own types into it. It
+// doesn't depend on any system call, so it builds on any OS.
+package dlgtemplate
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unicode/utf16"
+)
+
+// A sz_Or_Ord field: a string, an ordinal, or none if both are zero. The
+// string takes precedence.
+type SzOrOrd struct {
+	Ord uint16
+	Str string
+}
+
+// A whole DLGTEMPLATEEX, with its controls.
+type Dialog struct {
+	HelpId      uint32
+	ExStyle     uint32
+	Style       uint32 // DS_SETFONT is added if FontFace is not empty.
+	X, Y        int16
+	Cx, Cy      int16
+	Menu        SzOrOrd
+	Class       SzOrOrd
+	Title       string
+	FontSize    uint16
+	FontWeight  uint16
+	FontItalic  bool
+	FontCharset uint8
+	FontFace    string
+	Controls    []Control
+}
+
+// A DLGITEMTEMPLATEEX within a [Dialog].
+type Control struct {
+	HelpId       uint32
+	ExStyle      uint32
+	Style        uint32
+	X, Y         int16
+	Cx, Cy       int16
+	Id           uint32
+	Class        SzOrOrd
+	Title        SzOrOrd
+	CreationData []byte
+}
+
+// Dialog style which tells the template has a font block.
+const DS_SETFONT uint32 = 0x0040
+
+// Serializes the dialog into a DLGTEMPLATEEX blob, which can be embedded as
+// RT_DIALOG resource or passed to DialogBoxIndirectParam.
+func (me *Dialog) Serialize() ([]byte, error) {
+	if len(me.Controls) > 0xffff {
+		return nil, fmt.Errorf("too many dialog controls: %d", len(me.Controls))
+	}
+
+	style := me.Style
+	if me.FontFace != "" {
+		style |= DS_SETFONT
+	}
+
+	buf := make([]byte, 0, 256) // arbitrary
+
+	buf = binary.LittleEndian.AppendUint16(buf, 1)      // dlgVer
+	buf = binary.LittleEndian.AppendUint16(buf, 0xffff) // signature
+	buf = binary.LittleEndian.AppendUint32(buf, me.HelpId)
+	buf = binary.LittleEndian.AppendUint32(buf, me.ExStyle)
+	buf = binary.LittleEndian.AppendUint32(buf, style)
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(me.Controls)))
+	buf = appendInt16s(buf, me.X, me.Y, me.Cx, me.Cy)
+	buf = appendSzOrOrd(buf, me.Menu)
+	buf = appendSzOrOrd(buf, me.Class)
+	buf = appendSz(buf, me.Title)
+
+	if style&DS_SETFONT != 0 {
+		buf = binary.LittleEndian.AppendUint16(buf, me.FontSize)
+		buf = binary.LittleEndian.AppendUint16(buf, me.FontWeight)
+		if me.FontItalic {
+			buf = append(buf, 1)
+		} else {
+			buf = append(buf, 0)
+		}
+		buf = append(buf, me.FontCharset)
+		buf = appendSz(buf, me.FontFace)
+	}
+
+	for idx := range me.Controls {
+		ctrl := &me.Controls[idx]
+		if len(ctrl.CreationData) > 0xffff {
+			return nil, fmt.Errorf("creation data of control %d too long", ctrl.Id)
+		}
+		buf = padDword(buf)
+		buf = binary.LittleEndian.AppendUint32(buf, ctrl.HelpId)
+		buf = binary.LittleEndian.AppendUint32(buf, ctrl.ExStyle)
+		buf = binary.LittleEndian.AppendUint32(buf, ctrl.Style)
+		buf = appendInt16s(buf, ctrl.X, ctrl.Y, ctrl.Cx, ctrl.Cy)
+		buf = binary.LittleEndian.AppendUint32(buf, ctrl.Id)
+		buf = appendSzOrOrd(buf, ctrl.Class)
+		buf = appendSzOrOrd(buf, ctrl.Title)
+		buf = binary.LittleEndian.AppendUint16(buf, uint16(len(ctrl.CreationData)))
+		buf = append(buf, ctrl.CreationData...)
+	}
+	return buf, nil
+}
+
+func appendInt16s(buf []byte, nums ...int16) []byte {
+	for _, n := range nums {
+		buf = binary.LittleEndian.AppendUint16(buf, uint16(n))
+	}
+	return buf
+}
+
+// Appends a null-terminated UTF-16 string.
+func appendSz(buf []byte, s string) []byte {
+	for _, w := range utf16.Encode([]rune(s)) {
+		buf = binary.LittleEndian.AppendUint16(buf, w)
+	}
+	return binary.LittleEndian.AppendUint16(buf, 0)
+}
+
+// Appends a sz_Or_Ord field: 0x0000 if none, 0xffff plus the ordinal, or a
+// null-terminated string.
+func appendSzOrOrd(buf []byte, field SzOrOrd) []byte {
+	switch {
+	case field.Str != "":
+		return appendSz(buf, field.Str)
+	case field.Ord == 0:
+		return binary.LittleEndian.AppendUint16(buf, 0)
+	default:
+		buf = binary.LittleEndian.AppendUint16(buf, 0xffff)
+		return binary.LittleEndian.AppendUint16(buf, field.Ord)
+	}
+}
+
+func padDword(buf []byte) []byte {
+	for len(buf)%4 != 0 {
+		buf = append(buf, 0)
+	}
+	return buf
+}
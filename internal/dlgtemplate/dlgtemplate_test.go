@@ -0,0 +1,126 @@
+package dlgtemplate
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDialogSerialize(t *testing.T) {
+	const style = 0x80c8_0000 // WS_POPUP | WS_CAPTION | WS_SYSMENU
+
+	tests := []struct {
+		name string
+		dlg  Dialog
+		want []byte
+	}{
+		{
+			name: "no menu, class, title or font",
+			dlg:  Dialog{Style: style, Cx: 100, Cy: 50},
+			want: []byte{
+				0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc8, 0x80, // dlgVer, signature, helpID, exStyle, style
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x64, 0x00, 0x32, 0x00, // cDlgItems, x, y, cx, cy
+				0x00, 0x00, // menu: none
+				0x00, 0x00, // windowClass: none
+				0x00, 0x00, // title: empty
+			},
+		},
+		{
+			name: "ordinal menu and string class",
+			dlg: Dialog{
+				HelpId: 7, ExStyle: 0x100, Style: style,
+				X: 10, Y: -20, Cx: 200, Cy: 100,
+				Menu: SzOrOrd{Ord: 100}, Class: SzOrOrd{Str: "MyDlg"}, Title: "Hi",
+			},
+			want: []byte{
+				0x01, 0x00, 0xff, 0xff, 0x07, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0xc8, 0x80, // dlgVer, signature, helpID, exStyle, style
+				0x00, 0x00, 0x0a, 0x00, 0xec, 0xff, 0xc8, 0x00, 0x64, 0x00, // cDlgItems, x, y, cx, cy
+				0xff, 0xff, 0x64, 0x00, // menu: ordinal 100
+				0x4d, 0x00, 0x79, 0x00, 0x44, 0x00, 0x6c, 0x00, 0x67, 0x00, 0x00, 0x00, // windowClass: "MyDlg"
+				0x48, 0x00, 0x69, 0x00, 0x00, 0x00, // title: "Hi"
+			},
+		},
+		{
+			name: "font block adds DS_SETFONT",
+			dlg: Dialog{
+				Style: style, Cx: 100, Cy: 50, Title: "A",
+				FontSize: 8, FontWeight: 400, FontItalic: true, FontCharset: 1,
+				FontFace: "MS Shell Dlg",
+			},
+			want: []byte{
+				0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x00, 0xc8, 0x80, // dlgVer, signature, helpID, exStyle, style
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x64, 0x00, 0x32, 0x00, // cDlgItems, x, y, cx, cy
+				0x00, 0x00, // menu: none
+				0x00, 0x00, // windowClass: none
+				0x41, 0x00, 0x00, 0x00, // title: "A"
+				0x08, 0x00, 0x90, 0x01, 0x01, 0x01, // pointsize, weight, italic, charset
+				0x4d, 0x00, 0x53, 0x00, 0x20, 0x00, 0x53, 0x00, 0x68, 0x00, 0x65, 0x00, 0x6c, 0x00, 0x6c, 0x00, // typeface: "MS Shell Dlg"
+				0x20, 0x00, 0x44, 0x00, 0x6c, 0x00, 0x67, 0x00, 0x00, 0x00,
+			},
+		},
+		{
+			name: "controls aligned to DWORD",
+			dlg: Dialog{
+				Style: style, Cx: 100, Cy: 50, Title: "Dlg",
+				Controls: []Control{
+					{
+						Style: 0x5001_0001, // WS_CHILD | WS_VISIBLE | WS_TABSTOP | BS_DEFPUSHBUTTON
+						X:     5, Y: 6, Cx: 50, Cy: 14, Id: 1,
+						Class: SzOrOrd{Ord: 0x80}, Title: SzOrOrd{Str: "Yes"},
+					},
+					{
+						ExStyle: 0x200, // WS_EX_CLIENTEDGE
+						Style:   0x5001_0000,
+						X:       5, Y: 24, Cx: 90, Cy: 20, Id: 1000,
+						Class: SzOrOrd{Str: "SysListView32"}, Title: SzOrOrd{Ord: 5},
+						CreationData: []byte{0xaa, 0xbb},
+					},
+				},
+			},
+			want: []byte{
+				0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc8, 0x80, // dlgVer, signature, helpID, exStyle, style
+				0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x64, 0x00, 0x32, 0x00, // cDlgItems, x, y, cx, cy
+				0x00, 0x00, // menu: none
+				0x00, 0x00, // windowClass: none
+				0x44, 0x00, 0x6c, 0x00, 0x67, 0x00, 0x00, 0x00, // title: "Dlg"
+				0x00, 0x00, // padding
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x01, 0x50, // control 1: helpID, exStyle, style
+				0x05, 0x00, 0x06, 0x00, 0x32, 0x00, 0x0e, 0x00, 0x01, 0x00, 0x00, 0x00, // x, y, cx, cy, id
+				0xff, 0xff, 0x80, 0x00, // windowClass: button atom
+				0x59, 0x00, 0x65, 0x00, 0x73, 0x00, 0x00, 0x00, // title: "Yes"
+				0x00, 0x00, // extraCount
+				0x00, 0x00, // padding
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x01, 0x50, // control 2: helpID, exStyle, style
+				0x05, 0x00, 0x18, 0x00, 0x5a, 0x00, 0x14, 0x00, 0xe8, 0x03, 0x00, 0x00, // x, y, cx, cy, id
+				0x53, 0x00, 0x79, 0x00, 0x73, 0x00, 0x4c, 0x00, 0x69, 0x00, 0x73, 0x00, 0x74, 0x00, 0x56, 0x00, // windowClass: "SysListView32"
+				0x69, 0x00, 0x65, 0x00, 0x77, 0x00, 0x33, 0x00, 0x32, 0x00, 0x00, 0x00,
+				0xff, 0xff, 0x05, 0x00, // title: ordinal 5
+				0x02, 0x00, // extraCount
+				0xaa, 0xbb, // creation data
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.dlg.Serialize()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, tc.want) {
+				t.Errorf("Serialize() =\n% x\nwant\n% x", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDialogSerializeErrors(t *testing.T) {
+	tooManyControls := Dialog{Controls: make([]Control, 0x1_0000)}
+	if _, err := tooManyControls.Serialize(); err == nil {
+		t.Error("expected error for too many controls")
+	}
+
+	dataTooLong := Dialog{Controls: []Control{{CreationData: make([]byte, 0x1_0000)}}}
+	if _, err := dataTooLong.Serialize(); err == nil {
+		t.Error("expected error for creation data too long")
+	}
+}
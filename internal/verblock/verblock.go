@@ -0,0 +1,151 @@
+// Package verblock implements the generic block layout of the VS_VERSIONINFO
+// resource. It doesn't depend on any system call, so it builds on any OS.
+package verblock
+
+import (
+	"encoding/binary"
+	"errors"
+	"strings"
+	"unicode/utf16"
+)
+
+// A block of the VS_VERSIONINFO hierarchy: VS_VERSIONINFO itself,
+// StringFileInfo, StringTable, String, VarFileInfo and Var share the same
+// layout.
+type Block struct {
+	Key      string
+	Value    []byte
+	IsText   bool // wType 1, whose wValueLength is in WCHARs
+	Children []Block
+}
+
+var ErrTruncated = errors.New("truncated VS_VERSIONINFO block")
+
+// Parses the root block of a raw VS_VERSIONINFO blob.
+func Parse(blob []byte) (Block, error) {
+	block, _, err := parse(blob, 0)
+	return block, err
+}
+
+// Parses the block at the given offset of the whole blob, whose offsets are
+// used for the 32-bit alignment. Returns the block and its end offset.
+func parse(blob []byte, offset int) (Block, int, error) {
+	if offset+6 > len(blob) {
+		return Block{}, 0, ErrTruncated
+	}
+	wLength := int(binary.LittleEndian.Uint16(blob[offset:]))
+	wValueLength := int(binary.LittleEndian.Uint16(blob[offset+2:]))
+	wType := binary.LittleEndian.Uint16(blob[offset+4:])
+
+	end := offset + wLength
+	if wLength < 6 || end > len(blob) {
+		return Block{}, 0, ErrTruncated
+	}
+
+	block := Block{IsText: wType == 1}
+	pos := offset + 6
+	keyWords := make([]uint16, 0, 16)
+	for {
+		if pos+2 > end {
+			return Block{}, 0, ErrTruncated
+		}
+		w := binary.LittleEndian.Uint16(blob[pos:])
+		pos += 2
+		if w == 0 {
+			break
+		}
+		keyWords = append(keyWords, w)
+	}
+	block.Key = string(utf16.Decode(keyWords))
+	pos = align(pos)
+
+	szValue := wValueLength
+	if block.IsText {
+		szValue *= 2
+	}
+	if pos+szValue > end { // some compilers write the length in bytes for text values
+		szValue = end - pos
+	}
+	if szValue > 0 {
+		block.Value = blob[pos : pos+szValue]
+		pos = align(pos + szValue)
+	}
+
+	for end-pos >= 6 { // smaller remainders are just padding
+		child, childEnd, err := parse(blob, pos)
+		if err != nil {
+			return Block{}, 0, err
+		}
+		block.Children = append(block.Children, child)
+		pos = align(childEnd)
+	}
+	return block, end, nil
+}
+
+// Serializes the block and its children.
+func (me *Block) Serialize() []byte {
+	return me.appendTo(nil)
+}
+
+// Appends the serialized block to buf, which must end at an aligned offset.
+func (me *Block) appendTo(buf []byte) []byte {
+	start := len(buf)
+	wValueLength := len(me.Value)
+	wType := uint16(0)
+	if me.IsText {
+		wValueLength /= 2
+		wType = 1
+	}
+
+	buf = binary.LittleEndian.AppendUint16(buf, 0) // wLength, filled at the end
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(wValueLength))
+	buf = binary.LittleEndian.AppendUint16(buf, wType)
+	for _, w := range utf16.Encode([]rune(me.Key)) {
+		buf = binary.LittleEndian.AppendUint16(buf, w)
+	}
+	buf = binary.LittleEndian.AppendUint16(buf, 0) // terminating null
+	buf = pad(buf)
+	buf = append(buf, me.Value...)
+
+	for idx := range me.Children {
+		buf = pad(buf)
+		buf = me.Children[idx].appendTo(buf)
+	}
+
+	binary.LittleEndian.PutUint16(buf[start:], uint16(len(buf)-start))
+	return buf
+}
+
+// Decodes a null-terminated UTF-16 string value.
+func DecodeStr(data []byte) string {
+	words := make([]uint16, 0, len(data)/2)
+	for i := 0; i+1 < len(data); i += 2 {
+		w := binary.LittleEndian.Uint16(data[i:])
+		if w == 0 {
+			break
+		}
+		words = append(words, w)
+	}
+	return string(utf16.Decode(words))
+}
+
+// Encodes a string value as null-terminated UTF-16.
+func EncodeStr(s string) []byte {
+	words := utf16.Encode([]rune(strings.TrimRight(s, "\x00")))
+	data := make([]byte, 0, (len(words)+1)*2)
+	for _, w := range words {
+		data = binary.LittleEndian.AppendUint16(data, w)
+	}
+	return binary.LittleEndian.AppendUint16(data, 0)
+}
+
+func align(pos int) int {
+	return (pos + 3) &^ 3
+}
+
+func pad(buf []byte) []byte {
+	for len(buf)%4 != 0 {
+		buf = append(buf, 0)
+	}
+	return buf
+}
@@ -0,0 +1,66 @@
+package verblock
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// VS_VERSIONINFO with no StringFileInfo, and one translation.
+var _translationsOnly = []byte{
+	0xa0, 0x00, 0x34, 0x00, 0x00, 0x00, // VS_VERSIONINFO: wLength, wValueLength, wType
+	0x56, 0x00, 0x53, 0x00, 0x5f, 0x00, 0x56, 0x00, 0x45, 0x00, 0x52, 0x00, 0x53, 0x00, 0x49, 0x00, // "VS_VERSION_INFO", padding
+	0x4f, 0x00, 0x4e, 0x00, 0x5f, 0x00, 0x49, 0x00, 0x4e, 0x00, 0x46, 0x00, 0x4f, 0x00, 0x00, 0x00,
+	0x00, 0x00,
+	0xbd, 0x04, 0xef, 0xfe, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // VS_FIXEDFILEINFO
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00,
+	0x44, 0x00, 0x00, 0x00, 0x01, 0x00, // VarFileInfo: wLength, wValueLength, wType
+	0x56, 0x00, 0x61, 0x00, 0x72, 0x00, 0x46, 0x00, 0x69, 0x00, 0x6c, 0x00, 0x65, 0x00, 0x49, 0x00, // "VarFileInfo", padding
+	0x6e, 0x00, 0x66, 0x00, 0x6f, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x24, 0x00, 0x04, 0x00, 0x00, 0x00, // Var: wLength, wValueLength, wType
+	0x54, 0x00, 0x72, 0x00, 0x61, 0x00, 0x6e, 0x00, 0x73, 0x00, 0x6c, 0x00, 0x61, 0x00, 0x74, 0x00, // "Translation", padding
+	0x69, 0x00, 0x6f, 0x00, 0x6e, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x16, 0x04, 0xe4, 0x04, // 0x0416, 1252
+}
+
+func TestVersionInfoSerialize(t *testing.T) {
+	info := VersionInfo{
+		Fixed:        [13]uint32{FFI_SIGNATURE, FFI_STRUCVERSION},
+		Translations: []Translation{{0x0416, 1252}},
+	}
+	if got := info.Serialize(); !bytes.Equal(got, _translationsOnly) {
+		t.Errorf("Serialize() =\n% x\nwant\n% x", got, _translationsOnly)
+	}
+}
+
+func TestParse(t *testing.T) {
+	root, err := Parse(_translationsOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Block{
+		Key:   "VS_VERSION_INFO",
+		Value: _translationsOnly[40:92],
+		Children: []Block{{
+			Key:      "VarFileInfo",
+			IsText:   true,
+			Children: []Block{{Key: "Translation", Value: []byte{0x16, 0x04, 0xe4, 0x04}}},
+		}},
+	}
+	if !reflect.DeepEqual(root, want) {
+		t.Errorf("Parse() = %+v, want %+v", root, want)
+	}
+	if got := root.Serialize(); !bytes.Equal(got, _translationsOnly) {
+		t.Errorf("round trip =\n% x\nwant\n% x", got, _translationsOnly)
+	}
+}
+
+func TestParseTruncated(t *testing.T) {
+	for _, n := range []int{0, 5, 40, len(_translationsOnly) - 1} {
+		if _, err := Parse(_translationsOnly[:n]); err != ErrTruncated {
+			t.Errorf("Parse(%d bytes) error = %v, want %v", n, err, ErrTruncated)
+		}
+	}
+}
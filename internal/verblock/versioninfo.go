@@ -0,0 +1,86 @@
+package verblock
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Contents of a whole VS_VERSIONINFO resource, shared by the rc and win
+// packages, which convert their own types into it.
+type VersionInfo struct {
+	// VS_FIXEDFILEINFO fields, in order, including signature and struct
+	// version, which are written as they are.
+	Fixed        [13]uint32
+	StringTables []StringTable
+	Translations []Translation
+}
+
+// A table within the StringFileInfo block.
+type StringTable struct {
+	LangId   uint16
+	CodePage uint16
+	Strings  []String
+}
+
+// A string within a [StringTable].
+type String struct {
+	Key   string
+	Value string
+}
+
+// A language and code page pair of the VarFileInfo\Translation block.
+type Translation struct {
+	LangId   uint16
+	CodePage uint16
+}
+
+// Signature and struct version of VS_FIXEDFILEINFO.
+const (
+	FFI_SIGNATURE    uint32 = 0xfeef_04bd
+	FFI_STRUCVERSION uint32 = 0x0001_0000
+)
+
+// Builds the block hierarchy and serializes it into a raw VS_VERSIONINFO blob.
+// The StringFileInfo and VarFileInfo blocks are written only if not empty.
+func (me *VersionInfo) Serialize() []byte {
+	fixed := make([]byte, 0, len(me.Fixed)*4)
+	for _, n := range me.Fixed {
+		fixed = binary.LittleEndian.AppendUint32(fixed, n)
+	}
+
+	root := Block{Key: "VS_VERSION_INFO", Value: fixed}
+
+	if len(me.StringTables) > 0 {
+		stringFileInfo := Block{Key: "StringFileInfo", IsText: true}
+		for _, table := range me.StringTables {
+			tableBlock := Block{
+				Key:    fmt.Sprintf("%04x%04x", table.LangId, table.CodePage),
+				IsText: true,
+			}
+			for _, str := range table.Strings {
+				tableBlock.Children = append(tableBlock.Children, Block{
+					Key:    str.Key,
+					Value:  EncodeStr(str.Value),
+					IsText: true,
+				})
+			}
+			stringFileInfo.Children = append(stringFileInfo.Children, tableBlock)
+		}
+		root.Children = append(root.Children, stringFileInfo)
+	}
+
+	if len(me.Translations) > 0 {
+		value := make([]byte, 0, len(me.Translations)*4)
+		for _, tr := range me.Translations {
+			value = binary.LittleEndian.AppendUint16(value, tr.LangId)
+			value = binary.LittleEndian.AppendUint16(value, tr.CodePage)
+		}
+		root.Children = append(root.Children, Block{
+			Key:      "VarFileInfo",
+			IsText:   true,
+			Children: []Block{{Key: "Translation", Value: value}},
+		})
+	}
+
+	return root.Serialize()
+}
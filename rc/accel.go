@@ -0,0 +1,46 @@
+package rc
+
+import (
+	"encoding/binary"
+)
+
+// Flags of an [Accel], same values of the ACCEL struct.
+const (
+	FVIRTKEY  uint8 = 0x01
+	FNOINVERT uint8 = 0x02
+	FSHIFT    uint8 = 0x04
+	FCONTROL  uint8 = 0x08
+	FALT      uint8 = 0x10
+)
+
+// An accelerator, within an accelerator table.
+type Accel struct {
+	Flags uint8  // FVIRTKEY, FSHIFT, FCONTROL, FALT, FNOINVERT.
+	Key   uint16 // Virtual key code if FVIRTKEY is set, otherwise a character.
+	Cmd   uint16 // Command ID sent in WM_COMMAND.
+}
+
+// Adds an accelerator table, as RT_ACCELERATOR, to be loaded with
+// LoadAccelerators.
+//
+// # Example
+//
+//	res := rc.New()
+//	res.AddAccelerators(rc.Num(101), rc.LANG_EN_US, []rc.Accel{
+//		{rc.FVIRTKEY | rc.FCONTROL, 'S', 2001}, // Ctrl+S
+//		{rc.FVIRTKEY, 0x70, 2002},              // F1
+//	})
+func (me *Resources) AddAccelerators(name Id, langId uint16, accels []Accel) {
+	data := make([]byte, 0, len(accels)*8)
+	for idx, accel := range accels {
+		flags := uint16(accel.Flags)
+		if idx == len(accels)-1 {
+			flags |= 0x80 // last entry of the table
+		}
+		data = binary.LittleEndian.AppendUint16(data, flags)
+		data = binary.LittleEndian.AppendUint16(data, accel.Key)
+		data = binary.LittleEndian.AppendUint16(data, accel.Cmd)
+		data = binary.LittleEndian.AppendUint16(data, 0) // padding
+	}
+	me.add(Num(RT_ACCELERATOR), name, langId, _MEM_MOVEABLE|_MEM_PURE, data)
+}
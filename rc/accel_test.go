@@ -0,0 +1,51 @@
+package rc
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestAddAccelerators(t *testing.T) {
+	tests := []struct {
+		name   string
+		accels []Accel
+		want   []byte
+	}{
+		{
+			name:   "empty",
+			accels: []Accel{},
+			want:   []byte{},
+		},
+		{
+			name:   "single entry",
+			accels: []Accel{{FVIRTKEY, 0x70, 2002}},
+			want: []byte{
+				0x81, 0x00, 0x70, 0x00, 0xd2, 0x07, 0x00, 0x00, // F1, last entry
+			},
+		},
+		{
+			name: "only the last entry flagged",
+			accels: []Accel{
+				{FVIRTKEY | FCONTROL, 'S', 2001},
+				{FSHIFT | FALT | FNOINVERT, 'a', 2003},
+				{FVIRTKEY, 0x70, 2002},
+			},
+			want: []byte{
+				0x09, 0x00, 0x53, 0x00, 0xd1, 0x07, 0x00, 0x00, // fVirt, key, cmd, padding
+				0x16, 0x00, 0x61, 0x00, 0xd3, 0x07, 0x00, 0x00,
+				0x81, 0x00, 0x70, 0x00, 0xd2, 0x07, 0x00, 0x00,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := New()
+			res.AddAccelerators(Num(101), LANG_EN_US, tc.accels)
+			entry := res.Entries()[0]
+			if entry.Type != Num(RT_ACCELERATOR) || entry.Name != Num(101) || !bytes.Equal(entry.Data, tc.want) {
+				t.Errorf("got %v %v % x, want % x", entry.Type, entry.Name, entry.Data, tc.want)
+			}
+		})
+	}
+}
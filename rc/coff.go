@@ -0,0 +1,273 @@
+package rc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unicode/utf16"
+)
+
+// Target architecture of a COFF object, which is the IMAGE_FILE_MACHINE value.
+type ARCH uint16
+
+const (
+	ARCH_386   ARCH = 0x014c
+	ARCH_AMD64 ARCH = 0x8664
+	ARCH_ARM64 ARCH = 0xaa64
+)
+
+// Parses an architecture name, as in GOARCH.
+func ParseArch(goarch string) (ARCH, error) {
+	switch goarch {
+	case "386":
+		return ARCH_386, nil
+	case "amd64":
+		return ARCH_AMD64, nil
+	case "arm64":
+		return ARCH_ARM64, nil
+	default:
+		return 0, fmt.Errorf("unsupported architecture: %s", goarch)
+	}
+}
+
+// Relocation type for a 32-bit RVA, relative to the image base.
+func (arch ARCH) addr32nb() (uint16, error) {
+	switch arch {
+	case ARCH_386:
+		return 0x0007, nil // IMAGE_REL_I386_DIR32NB
+	case ARCH_AMD64:
+		return 0x0003, nil // IMAGE_REL_AMD64_ADDR32NB
+	case ARCH_ARM64:
+		return 0x0002, nil // IMAGE_REL_ARM64_ADDR32NB
+	default:
+		return 0, fmt.Errorf("unsupported architecture: 0x%04x", uint16(arch))
+	}
+}
+
+// Serializes the resources into a COFF object with a single .rsrc section,
+// which is linked by "go build" when saved as a .syso file in the package
+// folder. Name the file with the architecture suffix, like
+// "rsrc_windows_amd64.syso", so each build links only its own object.
+//
+// # Example
+//
+//	res := rc.New()
+//	res.AddManifest(rc.LANG_EN_US, manifest)
+//
+//	syso, _ := res.Coff(rc.ARCH_AMD64)
+//	_ = os.WriteFile("rsrc_windows_amd64.syso", syso, 0o644)
+func (me *Resources) Coff(arch ARCH) ([]byte, error) {
+	relocType, err := arch.addr32nb()
+	if err != nil {
+		return nil, err
+	}
+
+	section, relocs := me.rsrcSection()
+
+	const szFileHeader, szSectionHeader, szReloc = 20, 40, 10
+	ptrRawData := szFileHeader + szSectionHeader
+	ptrRelocs := ptrRawData + len(section)
+	ptrSymbols := ptrRelocs + len(relocs)*szReloc
+
+	characteristics := uint16(0)
+	if arch == ARCH_386 {
+		characteristics = 0x0100 // IMAGE_FILE_32BIT_MACHINE
+	}
+
+	buf := make([]byte, 0, ptrSymbols+18+4)
+
+	// IMAGE_FILE_HEADER
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(arch))
+	buf = binary.LittleEndian.AppendUint16(buf, 1) // NumberOfSections
+	buf = binary.LittleEndian.AppendUint32(buf, 0) // TimeDateStamp, zero for reproducible builds
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(ptrSymbols))
+	buf = binary.LittleEndian.AppendUint32(buf, 1) // NumberOfSymbols
+	buf = binary.LittleEndian.AppendUint16(buf, 0) // SizeOfOptionalHeader
+	buf = binary.LittleEndian.AppendUint16(buf, characteristics)
+
+	// IMAGE_SECTION_HEADER
+	buf = append(buf, ".rsrc\x00\x00\x00"...)
+	buf = binary.LittleEndian.AppendUint32(buf, 0) // VirtualSize
+	buf = binary.LittleEndian.AppendUint32(buf, 0) // VirtualAddress
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(section)))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(ptrRawData))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(ptrRelocs))
+	buf = binary.LittleEndian.AppendUint32(buf, 0) // PointerToLinenumbers
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(relocs)))
+	buf = binary.LittleEndian.AppendUint16(buf, 0)           // NumberOfLinenumbers
+	buf = binary.LittleEndian.AppendUint32(buf, 0x4000_0040) // IMAGE_SCN_CNT_INITIALIZED_DATA | IMAGE_SCN_MEM_READ
+
+	buf = append(buf, section...)
+
+	for _, reloc := range relocs { // IMAGE_RELOCATION
+		buf = binary.LittleEndian.AppendUint32(buf, reloc)
+		buf = binary.LittleEndian.AppendUint32(buf, 0) // SymbolTableIndex of .rsrc
+		buf = binary.LittleEndian.AppendUint16(buf, relocType)
+	}
+
+	// IMAGE_SYMBOL for the section itself
+	buf = append(buf, ".rsrc\x00\x00\x00"...)
+	buf = binary.LittleEndian.AppendUint32(buf, 0) // Value
+	buf = binary.LittleEndian.AppendUint16(buf, 1) // SectionNumber
+	buf = binary.LittleEndian.AppendUint16(buf, 0) // Type
+	buf = append(buf, 3)                           // StorageClass: IMAGE_SYM_CLASS_STATIC
+	buf = append(buf, 0)                           // NumberOfAuxSymbols
+
+	buf = binary.LittleEndian.AppendUint32(buf, 4) // empty string table
+	return buf, nil
+}
+
+// Builds the contents of the .rsrc section: the three-level resource directory
+// (type, name and language), the directory strings, the data entries and the
+// data itself. Returns the section and the offsets of the data entry fields
+// which must be relocated.
+func (me *Resources) rsrcSection() ([]byte, []uint32) {
+	type Lang struct {
+		res *Resource
+	}
+	type Name struct {
+		id    Id
+		langs []Lang
+	}
+	type Type struct {
+		id    Id
+		names []Name
+	}
+
+	sorted := me.sorted()
+	types := make([]Type, 0)
+	for idx := range sorted {
+		res := &sorted[idx]
+		if len(types) == 0 || types[len(types)-1].id != res.Type {
+			types = append(types, Type{id: res.Type})
+		}
+		typ := &types[len(types)-1]
+		if len(typ.names) == 0 || typ.names[len(typ.names)-1].id != res.Name {
+			typ.names = append(typ.names, Name{id: res.Name})
+		}
+		name := &typ.names[len(typ.names)-1]
+		name.langs = append(name.langs, Lang{res})
+	}
+
+	// Compute the layout: all directory tables, level by level, then strings,
+	// data entries and data.
+	const szTable, szEntry, szDataEntry = 16, 8, 16
+	szTables := szTable + len(types)*szEntry
+	numData := 0
+	for _, typ := range types {
+		szTables += szTable + len(typ.names)*szEntry
+		for _, name := range typ.names {
+			szTables += szTable + len(name.langs)*szEntry
+			numData += len(name.langs)
+		}
+	}
+
+	strOffs := make(map[string]int)
+	strs := make([]byte, 0)
+	addStr := func(s string) {
+		if _, has := strOffs[s]; !has {
+			strOffs[s] = szTables + len(strs)
+			words := utf16.Encode([]rune(s))
+			strs = binary.LittleEndian.AppendUint16(strs, uint16(len(words)))
+			for _, w := range words {
+				strs = binary.LittleEndian.AppendUint16(strs, w)
+			}
+		}
+	}
+	for _, typ := range types {
+		if typ.id.IsStr() {
+			addStr(typ.id.str)
+		}
+		for _, name := range typ.names {
+			if name.id.IsStr() {
+				addStr(name.id.str)
+			}
+		}
+	}
+	for len(strs)%8 != 0 { // so the data is 8-byte aligned
+		strs = append(strs, 0)
+	}
+
+	dataEntriesOff := szTables + len(strs)
+	dataOff := dataEntriesOff + numData*szDataEntry
+
+	buf := make([]byte, 0, dataOff) // directory tables
+	dataEntries := make([]byte, 0, numData*szDataEntry)
+	data := make([]byte, 0)
+	relocs := make([]uint32, 0, numData)
+
+	appendTable := func(numNamed, numIds int) {
+		buf = binary.LittleEndian.AppendUint32(buf, 0) // Characteristics
+		buf = binary.LittleEndian.AppendUint32(buf, 0) // TimeDateStamp
+		buf = binary.LittleEndian.AppendUint16(buf, 0) // MajorVersion
+		buf = binary.LittleEndian.AppendUint16(buf, 0) // MinorVersion
+		buf = binary.LittleEndian.AppendUint16(buf, uint16(numNamed))
+		buf = binary.LittleEndian.AppendUint16(buf, uint16(numIds))
+	}
+	appendEntry := func(id Id, target uint32) {
+		if id.IsStr() {
+			buf = binary.LittleEndian.AppendUint32(buf, 0x8000_0000|uint32(strOffs[id.str]))
+		} else {
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(id.num))
+		}
+		buf = binary.LittleEndian.AppendUint32(buf, target)
+	}
+	countNamed := func(ids []Id) int {
+		n := 0
+		for _, id := range ids {
+			if id.IsStr() {
+				n++
+			}
+		}
+		return n
+	}
+
+	// Level 1: types.
+	typeIds := make([]Id, 0, len(types))
+	for _, typ := range types {
+		typeIds = append(typeIds, typ.id)
+	}
+	appendTable(countNamed(typeIds), len(types)-countNamed(typeIds))
+	nextTable := szTable + len(types)*szEntry
+	for _, typ := range types {
+		appendEntry(typ.id, 0x8000_0000|uint32(nextTable))
+		nextTable += szTable + len(typ.names)*szEntry
+	}
+
+	// Level 2: names.
+	for _, typ := range types {
+		nameIds := make([]Id, 0, len(typ.names))
+		for _, name := range typ.names {
+			nameIds = append(nameIds, name.id)
+		}
+		appendTable(countNamed(nameIds), len(typ.names)-countNamed(nameIds))
+		for _, name := range typ.names {
+			appendEntry(name.id, 0x8000_0000|uint32(nextTable))
+			nextTable += szTable + len(name.langs)*szEntry
+		}
+	}
+
+	// Level 3: languages, pointing to the data entries.
+	for _, typ := range types {
+		for _, name := range typ.names {
+			appendTable(0, len(name.langs))
+			for _, lang := range name.langs {
+				appendEntry(Num(lang.res.LangId), uint32(dataEntriesOff+len(dataEntries)))
+
+				for len(data)%8 != 0 {
+					data = append(data, 0)
+				}
+				relocs = append(relocs, uint32(dataEntriesOff+len(dataEntries)))
+				dataEntries = binary.LittleEndian.AppendUint32(dataEntries, uint32(dataOff+len(data))) // OffsetToData, relocated to RVA
+				dataEntries = binary.LittleEndian.AppendUint32(dataEntries, uint32(len(lang.res.Data)))
+				dataEntries = binary.LittleEndian.AppendUint32(dataEntries, 0) // CodePage
+				dataEntries = binary.LittleEndian.AppendUint32(dataEntries, 0) // Reserved
+				data = append(data, lang.res.Data...)
+			}
+		}
+	}
+
+	buf = append(buf, strs...)
+	buf = append(buf, dataEntries...)
+	buf = append(buf, data...)
+	return padDword(buf), relocs
+}
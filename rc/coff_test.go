@@ -0,0 +1,119 @@
+package rc
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCoff(t *testing.T) {
+	res := New()
+	res.AddManifest(LANG_EN_US, []byte("<a/>"))
+	res.Add(Num(RT_RCDATA), Str("b"), LANG_NEUTRAL, []byte{0xbb, 0xbb})
+	res.Add(Num(RT_RCDATA), Num(2), LANG_EN_US, []byte{0x09})
+	res.Add(Num(RT_RCDATA), Num(2), 0x0407, []byte{0x07})
+	res.Add(Num(RT_RCDATA), Str("A"), LANG_NEUTRAL, []byte{0xaa})
+
+	// Types in ascending order; names with strings first, case-insensitively,
+	// then numbers; languages in ascending order.
+	section := []byte{
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x00, // 0x00 types: 0 named, 2 IDs
+		0x0a, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x80, // RT_RCDATA -> table 0x20
+		0x18, 0x00, 0x00, 0x00, 0x48, 0x00, 0x00, 0x80, // RT_MANIFEST -> table 0x48
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 0x01, 0x00, // 0x20 RT_RCDATA names: 2 named, 1 ID
+		0xc8, 0x00, 0x00, 0x80, 0x60, 0x00, 0x00, 0x80, // "A" at 0xc8 -> table 0x60
+		0xcc, 0x00, 0x00, 0x80, 0x78, 0x00, 0x00, 0x80, // "b" at 0xcc -> table 0x78
+		0x02, 0x00, 0x00, 0x00, 0x90, 0x00, 0x00, 0x80, // 2 -> table 0x90
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, // 0x48 RT_MANIFEST names: 1 ID
+		0x01, 0x00, 0x00, 0x00, 0xb0, 0x00, 0x00, 0x80, // 1 -> table 0xb0
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, // 0x60 "A" languages
+		0x00, 0x00, 0x00, 0x00, 0xd0, 0x00, 0x00, 0x00, // LANG_NEUTRAL -> data entry 0xd0
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, // 0x78 "b" languages
+		0x00, 0x00, 0x00, 0x00, 0xe0, 0x00, 0x00, 0x00, // LANG_NEUTRAL -> data entry 0xe0
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x00, // 0x90 2 languages
+		0x07, 0x04, 0x00, 0x00, 0xf0, 0x00, 0x00, 0x00, // 0x0407 -> data entry 0xf0
+		0x09, 0x04, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, // 0x0409 -> data entry 0x100
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, // 0xb0 1 languages
+		0x09, 0x04, 0x00, 0x00, 0x10, 0x01, 0x00, 0x00, // 0x0409 -> data entry 0x110
+		0x01, 0x00, 0x41, 0x00, 0x01, 0x00, 0x62, 0x00, // 0xc8 strings: "A", "b"
+		0x20, 0x01, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // 0xd0 data entries: OffsetToData, Size, CodePage, Reserved
+		0x28, 0x01, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x30, 0x01, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x38, 0x01, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x40, 0x01, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0xaa, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // 0x120 data, 8-byte aligned
+		0xbb, 0xbb, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x3c, 0x61, 0x2f, 0x3e,
+	}
+
+	want := []byte{
+		0x64, 0x86, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0xb2, 0x01, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, // IMAGE_FILE_HEADER
+		0x00, 0x00, 0x00, 0x00,
+		0x2e, 0x72, 0x73, 0x72, 0x63, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // IMAGE_SECTION_HEADER
+		0x44, 0x01, 0x00, 0x00, 0x3c, 0x00, 0x00, 0x00, 0x80, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x05, 0x00, 0x00, 0x00, 0x40, 0x00, 0x00, 0x40,
+	}
+	want = append(want, section...)
+	want = append(want,
+		0xd0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x00, // IMAGE_RELOCATION of each data entry
+		0xe0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x00,
+		0xf0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x00,
+		0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x00,
+		0x10, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x00,
+		0x2e, 0x72, 0x73, 0x72, 0x63, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, // IMAGE_SYMBOL
+		0x03, 0x00,
+		0x04, 0x00, 0x00, 0x00, // string table
+	)
+
+	got, err := res.Coff(ARCH_AMD64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Coff() =\n% x\nwant\n% x", got, want)
+	}
+}
+
+func TestCoffArch(t *testing.T) {
+	tests := []struct {
+		goarch              string
+		wantMachine         []byte
+		wantCharacteristics []byte
+		wantRelocType       []byte
+	}{
+		{"386", []byte{0x4c, 0x01}, []byte{0x00, 0x01}, []byte{0x07, 0x00}},
+		{"amd64", []byte{0x64, 0x86}, []byte{0x00, 0x00}, []byte{0x03, 0x00}},
+		{"arm64", []byte{0x64, 0xaa}, []byte{0x00, 0x00}, []byte{0x02, 0x00}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.goarch, func(t *testing.T) {
+			arch, err := ParseArch(tc.goarch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := New()
+			res.Add(Num(RT_RCDATA), Num(1), LANG_NEUTRAL, []byte{1, 2, 3, 4})
+			got, err := res.Coff(arch)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// One resource: 3 tables and a data entry, 88 bytes, then its data.
+			const relocOff = 20 + 40 + 88 + 4
+			if !bytes.Equal(got[0:2], tc.wantMachine) ||
+				!bytes.Equal(got[18:20], tc.wantCharacteristics) ||
+				!bytes.Equal(got[relocOff+8:relocOff+10], tc.wantRelocType) {
+				t.Errorf("got % x", got)
+			}
+		})
+	}
+
+	if _, err := ParseArch("mips"); err == nil {
+		t.Error("expected error for unsupported architecture")
+	}
+	if _, err := New().Coff(ARCH(0x1234)); err == nil {
+		t.Error("expected error for unsupported machine")
+	}
+}
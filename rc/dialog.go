@@ -0,0 +1,114 @@
+package rc
+
+import (
+	"github.com/rodrigocfd/windigo/internal/dlgtemplate"
+)
+
+type (
+	// A dialog template, serialized in the DLGTEMPLATEEX format.
+	//
+	// Coordinates and sizes are in dialog units.
+	Dialog struct {
+		HelpId  uint32
+		ExStyle uint32 // WS_EX window extended styles.
+		// WS window styles and DS dialog styles. DS_SETFONT is added
+		// automatically if FontFace is not empty.
+		Style uint32
+		X, Y  int16
+		Cx    int16
+		Cy    int16
+		Menu  Id // Optional menu resource.
+		Class Id // Optional window class; zero for the dialog box class.
+		Title string
+
+		FontSize    uint16 // Point size.
+		FontWeight  uint16
+		FontItalic  bool
+		FontCharset uint8
+		FontFace    string // Like "MS Shell Dlg".
+
+		Controls []DialogControl
+	}
+
+	// A control within a [Dialog].
+	DialogControl struct {
+		HelpId  uint32
+		ExStyle uint32
+		Style   uint32 // WS window styles and control styles; usually has WS_CHILD and WS_VISIBLE.
+		X, Y    int16
+		Cx      int16
+		Cy      int16
+		Id      uint32
+		// Window class: one of the Class* predefined atoms, or the name of a
+		// registered class, like "SysListView32".
+		Class Id
+		// Text of the control, or the ID of a resource, like an icon for a
+		// static control.
+		Title Id
+		// Creation data passed in the lParam of WM_CREATE.
+		CreationData []byte
+	}
+)
+
+// Predefined control class atoms, to be used in [DialogControl].
+var (
+	ClassButton    = Num(0x0080)
+	ClassEdit      = Num(0x0081)
+	ClassStatic    = Num(0x0082)
+	ClassListBox   = Num(0x0083)
+	ClassScrollBar = Num(0x0084)
+	ClassComboBox  = Num(0x0085)
+)
+
+// Adds a dialog template, as RT_DIALOG.
+func (me *Resources) AddDialog(name Id, langId uint16, dlg *Dialog) error {
+	data, err := dlg.Serialize()
+	if err != nil {
+		return err
+	}
+	me.add(Num(RT_DIALOG), name, langId, _MEM_MOVEABLE|_MEM_PURE|_MEM_DISCARDABLE, data)
+	return nil
+}
+
+// Serializes the dialog in the DLGTEMPLATEEX format, which can be embedded as
+// RT_DIALOG resource or passed to DialogBoxIndirectParam.
+func (me *Dialog) Serialize() ([]byte, error) {
+	tmpl := dlgtemplate.Dialog{
+		HelpId:      me.HelpId,
+		ExStyle:     me.ExStyle,
+		Style:       me.Style,
+		X:           me.X,
+		Y:           me.Y,
+		Cx:          me.Cx,
+		Cy:          me.Cy,
+		Menu:        me.Menu.szOrOrd(),
+		Class:       me.Class.szOrOrd(),
+		Title:       me.Title,
+		FontSize:    me.FontSize,
+		FontWeight:  me.FontWeight,
+		FontItalic:  me.FontItalic,
+		FontCharset: me.FontCharset,
+		FontFace:    me.FontFace,
+		Controls:    make([]dlgtemplate.Control, 0, len(me.Controls)),
+	}
+	for _, ctrl := range me.Controls {
+		tmpl.Controls = append(tmpl.Controls, dlgtemplate.Control{
+			HelpId:       ctrl.HelpId,
+			ExStyle:      ctrl.ExStyle,
+			Style:        ctrl.Style,
+			X:            ctrl.X,
+			Y:            ctrl.Y,
+			Cx:           ctrl.Cx,
+			Cy:           ctrl.Cy,
+			Id:           ctrl.Id,
+			Class:        ctrl.Class.szOrOrd(),
+			Title:        ctrl.Title.szOrOrd(),
+			CreationData: ctrl.CreationData,
+		})
+	}
+	return tmpl.Serialize()
+}
+
+func (id Id) szOrOrd() dlgtemplate.SzOrOrd {
+	return dlgtemplate.SzOrOrd{Ord: id.num, Str: id.str}
+}
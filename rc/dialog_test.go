@@ -0,0 +1,80 @@
+package rc
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/internal/dlgtemplate"
+)
+
+func TestDialogSerialize(t *testing.T) {
+	dlg := &Dialog{
+		HelpId: 7, ExStyle: 0x100, Style: 0x80c8_0000,
+		X: 10, Y: -20, Cx: 200, Cy: 100,
+		Menu: Num(100), Class: Str("MyDlg"), Title: "Hi",
+		FontSize: 8, FontWeight: 400, FontItalic: true, FontCharset: 1, FontFace: "MS Shell Dlg",
+		Controls: []DialogControl{
+			{
+				HelpId: 3, ExStyle: 0x200, Style: 0x5001_0001,
+				X: 5, Y: 6, Cx: 50, Cy: 14, Id: 1,
+				Class: ClassButton, Title: Str("Yes"),
+			},
+			{
+				Style: 0x5001_0000,
+				X:     5, Y: 24, Cx: 90, Cy: 20, Id: 1000,
+				Class: Str("SysListView32"), Title: Num(5),
+				CreationData: []byte{0xaa, 0xbb},
+			},
+		},
+	}
+
+	// The same template, written directly in the serializer types.
+	tmpl := &dlgtemplate.Dialog{
+		HelpId: 7, ExStyle: 0x100, Style: 0x80c8_0000,
+		X: 10, Y: -20, Cx: 200, Cy: 100,
+		Menu: dlgtemplate.SzOrOrd{Ord: 100}, Class: dlgtemplate.SzOrOrd{Str: "MyDlg"}, Title: "Hi",
+		FontSize: 8, FontWeight: 400, FontItalic: true, FontCharset: 1, FontFace: "MS Shell Dlg",
+		Controls: []dlgtemplate.Control{
+			{
+				HelpId: 3, ExStyle: 0x200, Style: 0x5001_0001,
+				X: 5, Y: 6, Cx: 50, Cy: 14, Id: 1,
+				Class: dlgtemplate.SzOrOrd{Ord: 0x80}, Title: dlgtemplate.SzOrOrd{Str: "Yes"},
+			},
+			{
+				Style: 0x5001_0000,
+				X:     5, Y: 24, Cx: 90, Cy: 20, Id: 1000,
+				Class: dlgtemplate.SzOrOrd{Str: "SysListView32"}, Title: dlgtemplate.SzOrOrd{Ord: 5},
+				CreationData: []byte{0xaa, 0xbb},
+			},
+		},
+	}
+
+	got, err := dlg.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := tmpl.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Serialize() =\n% x\nwant\n% x", got, want)
+	}
+
+	res := New()
+	if err := res.AddDialog(Str("ABOUT"), LANG_EN_US, dlg); err != nil {
+		t.Fatal(err)
+	}
+	entry := res.Entries()[0]
+	if entry.Type != Num(RT_DIALOG) || entry.Name != Str("ABOUT") || entry.MemoryFlags != 0x1030 ||
+		!bytes.Equal(entry.Data, want) {
+		t.Errorf("got %+v", entry)
+	}
+}
+
+func TestDialogSerializeErrors(t *testing.T) {
+	dataTooLong := &Dialog{Controls: []DialogControl{{CreationData: make([]byte, 0x1_0000)}}}
+	if err := New().AddDialog(Num(1), LANG_NEUTRAL, dataTooLong); err == nil {
+		t.Error("expected error for creation data too long")
+	}
+}
@@ -0,0 +1,74 @@
+package rc
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Adds an icon from the contents of an .ico file. Each image of the file is
+// added as an RT_ICON resource, with sequential IDs, and the icon itself is
+// added as an RT_GROUP_ICON resource with the given name, which is the ID to
+// be passed to LoadIcon.
+//
+// # Example
+//
+//	ico, _ := os.ReadFile("app.ico")
+//
+//	res := rc.New()
+//	_ = res.AddIcon(rc.Num(101), rc.LANG_EN_US, ico)
+func (me *Resources) AddIcon(name Id, langId uint16, ico []byte) error {
+	images, err := icoParse(ico)
+	if err != nil {
+		return err
+	}
+
+	group := make([]byte, 0, 6+len(images)*14)
+	group = binary.LittleEndian.AppendUint16(group, 0) // reserved
+	group = binary.LittleEndian.AppendUint16(group, 1) // type: icon
+	group = binary.LittleEndian.AppendUint16(group, uint16(len(images)))
+
+	for _, img := range images {
+		iconId := me.nextIconId
+		me.nextIconId++
+		me.add(Num(RT_ICON), Num(iconId), langId, _MEM_MOVEABLE|_MEM_DISCARDABLE, img.data)
+
+		group = append(group, img.dirEntry[:12]...) // up to dwBytesInRes
+		group = binary.LittleEndian.AppendUint16(group, iconId)
+	}
+
+	me.add(Num(RT_GROUP_ICON), name, langId, _MEM_MOVEABLE|_MEM_PURE|_MEM_DISCARDABLE, group)
+	return nil
+}
+
+type _IcoImage struct {
+	dirEntry []byte // ICONDIRENTRY, 16 bytes
+	data     []byte
+}
+
+var errIcoInvalid = errors.New("invalid .ico file")
+
+// Parses the ICONDIR header and its images.
+func icoParse(ico []byte) ([]_IcoImage, error) {
+	if len(ico) < 6 ||
+		binary.LittleEndian.Uint16(ico[0:]) != 0 ||
+		binary.LittleEndian.Uint16(ico[2:]) != 1 {
+		return nil, errIcoInvalid
+	}
+	count := int(binary.LittleEndian.Uint16(ico[4:]))
+	if count == 0 || len(ico) < 6+count*16 {
+		return nil, errIcoInvalid
+	}
+
+	images := make([]_IcoImage, 0, count)
+	for i := 0; i < count; i++ {
+		dirEntry := ico[6+i*16 : 6+(i+1)*16]
+		size := int(binary.LittleEndian.Uint32(dirEntry[8:]))
+		offset := int(binary.LittleEndian.Uint32(dirEntry[12:]))
+		if offset < 0 || size < 0 || offset+size > len(ico) {
+			return nil, fmt.Errorf("%w: image %d out of bounds", errIcoInvalid, i)
+		}
+		images = append(images, _IcoImage{dirEntry, ico[offset : offset+size]})
+	}
+	return images, nil
+}
@@ -0,0 +1,76 @@
+package rc
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAddIcon(t *testing.T) {
+	ico := []byte{
+		0x00, 0x00, 0x01, 0x00, 0x02, 0x00, // ICONDIR: reserved, type, count
+		0x10, 0x10, 0x00, 0x00, 0x01, 0x00, 0x20, 0x00, 0x03, 0x00, 0x00, 0x00, 0x26, 0x00, 0x00, 0x00, // 16x16, 32 bpp, 3 bytes at 0x26
+		0x20, 0x20, 0x00, 0x00, 0x01, 0x00, 0x08, 0x00, 0x02, 0x00, 0x00, 0x00, 0x29, 0x00, 0x00, 0x00, // 32x32, 8 bpp, 2 bytes at 0x29
+		0xa1, 0xa2, 0xa3, // first image
+		0xb1, 0xb2, // second image
+	}
+
+	res := New()
+	if err := res.AddIcon(Num(101), LANG_EN_US, ico); err != nil {
+		t.Fatal(err)
+	}
+	if err := res.AddIcon(Str("SMALL"), LANG_EN_US, ico[:6+16+3]); err == nil {
+		t.Fatal("expected error for truncated .ico")
+	}
+	ico[4] = 1 // now only the first image
+	if err := res.AddIcon(Str("SMALL"), LANG_EN_US, ico); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Resource{
+		{Num(RT_ICON), Num(1), LANG_EN_US, 0x1010, []byte{0xa1, 0xa2, 0xa3}},
+		{Num(RT_ICON), Num(2), LANG_EN_US, 0x1010, []byte{0xb1, 0xb2}},
+		{Num(RT_GROUP_ICON), Num(101), LANG_EN_US, 0x1030, []byte{
+			0x00, 0x00, 0x01, 0x00, 0x02, 0x00, // GRPICONDIR: reserved, type, count
+			0x10, 0x10, 0x00, 0x00, 0x01, 0x00, 0x20, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, 0x00, // GRPICONDIRENTRY, RT_ICON 1
+			0x20, 0x20, 0x00, 0x00, 0x01, 0x00, 0x08, 0x00, 0x02, 0x00, 0x00, 0x00, 0x02, 0x00, // GRPICONDIRENTRY, RT_ICON 2
+		}},
+		{Num(RT_ICON), Num(3), LANG_EN_US, 0x1010, []byte{0xa1, 0xa2, 0xa3}}, // IDs continue from the previous icon
+		{Num(RT_GROUP_ICON), Str("SMALL"), LANG_EN_US, 0x1030, []byte{
+			0x00, 0x00, 0x01, 0x00, 0x01, 0x00,
+			0x10, 0x10, 0x00, 0x00, 0x01, 0x00, 0x20, 0x00, 0x03, 0x00, 0x00, 0x00, 0x03, 0x00,
+		}},
+	}
+	if got := res.Entries(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestAddIconErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		ico  []byte
+	}{
+		{"empty", []byte{}},
+		{"cursor", []byte{0x00, 0x00, 0x02, 0x00, 0x00, 0x00}},
+		{"no images", []byte{0x00, 0x00, 0x01, 0x00, 0x00, 0x00}},
+		{"missing entry", []byte{0x00, 0x00, 0x01, 0x00, 0x01, 0x00, 0x10, 0x10}},
+		{"image out of bounds", []byte{
+			0x00, 0x00, 0x01, 0x00, 0x01, 0x00,
+			0x10, 0x10, 0x00, 0x00, 0x01, 0x00, 0x20, 0x00, 0x04, 0x00, 0x00, 0x00, 0x16, 0x00, 0x00, 0x00,
+			0xa1, 0xa2, 0xa3,
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := New()
+			if err := res.AddIcon(Num(1), LANG_NEUTRAL, tc.ico); !errors.Is(err, errIcoInvalid) {
+				t.Errorf("error = %v, want %v", err, errIcoInvalid)
+			}
+			if len(res.Entries()) != 0 {
+				t.Errorf("resources added: %+v", res.Entries())
+			}
+		})
+	}
+}
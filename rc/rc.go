@@ -0,0 +1,189 @@
+// Package rc is a resource compiler written in pure Go, which builds icons,
+// manifests, version information, string tables, dialogs and accelerators into
+// a .res file or into a COFF .syso object, which is linked automatically by
+// "go build" when placed in the package folder.
+//
+// It doesn't depend on any system call, so it runs on any OS, and its output
+// is deterministic: the same input always produces the same bytes.
+//
+// # Example
+//
+//	ico, _ := os.ReadFile("app.ico")
+//	manifest, _ := os.ReadFile("app.exe.manifest")
+//
+//	res := rc.New()
+//	_ = res.AddIcon(rc.Num(101), rc.LANG_EN_US, ico)
+//	res.AddManifest(rc.LANG_EN_US, manifest)
+//
+//	syso, _ := res.Coff(rc.ARCH_AMD64)
+//	_ = os.WriteFile("rsrc_windows_amd64.syso", syso, 0o644)
+package rc
+
+import (
+	"sort"
+	"strings"
+)
+
+// Identifies a resource type or name, or a dialog class, menu or title, which
+// can be either a number or a string. Created with [Num] or [Str].
+//
+// The zero value means "none".
+type Id struct {
+	num uint16
+	str string
+}
+
+// Creates a numeric [Id].
+func Num(n uint16) Id {
+	return Id{num: n}
+}
+
+// Creates a string [Id].
+func Str(s string) Id {
+	return Id{str: s}
+}
+
+// Tells whether the [Id] is a string.
+func (id Id) IsStr() bool {
+	return id.str != ""
+}
+
+// Tells whether the [Id] is the zero value.
+func (id Id) IsZero() bool {
+	return id.num == 0 && id.str == ""
+}
+
+// Returns the number of a numeric [Id]; zero for string ones.
+func (id Id) Num() uint16 {
+	return id.num
+}
+
+// Returns the string of a string [Id]; empty for numeric ones.
+func (id Id) Str() string {
+	return id.str
+}
+
+// Predefined resource types, to be used with [Resources.Add].
+const (
+	RT_CURSOR       uint16 = 1
+	RT_BITMAP       uint16 = 2
+	RT_ICON         uint16 = 3
+	RT_MENU         uint16 = 4
+	RT_DIALOG       uint16 = 5
+	RT_STRING       uint16 = 6
+	RT_FONTDIR      uint16 = 7
+	RT_FONT         uint16 = 8
+	RT_ACCELERATOR  uint16 = 9
+	RT_RCDATA       uint16 = 10
+	RT_MESSAGETABLE uint16 = 11
+	RT_GROUP_CURSOR uint16 = 12
+	RT_GROUP_ICON   uint16 = 14
+	RT_VERSION      uint16 = 16
+	RT_DLGINCLUDE   uint16 = 17
+	RT_PLUGPLAY     uint16 = 19
+	RT_VXD          uint16 = 20
+	RT_ANICURSOR    uint16 = 21
+	RT_ANIICON      uint16 = 22
+	RT_HTML         uint16 = 23
+	RT_MANIFEST     uint16 = 24
+)
+
+// Commonly used language identifiers.
+const (
+	LANG_NEUTRAL uint16 = 0x0000
+	LANG_EN_US   uint16 = 0x0409
+)
+
+// Resource memory flags, kept in .res files for compatibility.
+const (
+	_MEM_MOVEABLE    uint16 = 0x0010
+	_MEM_PURE        uint16 = 0x0020
+	_MEM_DISCARDABLE uint16 = 0x1000
+)
+
+// A single resource, which is a block of data identified by its type, name
+// and language.
+type Resource struct {
+	Type        Id
+	Name        Id
+	LangId      uint16
+	MemoryFlags uint16 // Only written to .res files.
+	Data        []byte
+}
+
+// A set of resources to be compiled, created with [New].
+type Resources struct {
+	entries    []Resource
+	nextIconId uint16 // IDs of individual RT_ICON images
+}
+
+// Creates an empty set of resources.
+func New() *Resources {
+	return &Resources{
+		entries:    make([]Resource, 0),
+		nextIconId: 1,
+	}
+}
+
+// Returns the resources added so far, in the order they were added.
+func (me *Resources) Entries() []Resource {
+	return me.entries
+}
+
+// Adds a resource with raw data. If a resource with the same type, name and
+// language already exists, it's replaced.
+//
+// # Example
+//
+//	res := rc.New()
+//	res.Add(rc.Num(rc.RT_RCDATA), rc.Str("CONFIG"), rc.LANG_NEUTRAL, data)
+func (me *Resources) Add(typ, name Id, langId uint16, data []byte) {
+	me.add(typ, name, langId, _MEM_MOVEABLE|_MEM_PURE, data)
+}
+
+func (me *Resources) add(typ, name Id, langId, memoryFlags uint16, data []byte) {
+	newRes := Resource{typ, name, langId, memoryFlags, data}
+	for idx := range me.entries {
+		res := &me.entries[idx]
+		if res.Type == typ && res.Name == name && res.LangId == langId {
+			*res = newRes
+			return
+		}
+	}
+	me.entries = append(me.entries, newRes)
+}
+
+// Adds an application manifest, as RT_MANIFEST with ID 1.
+func (me *Resources) AddManifest(langId uint16, manifest []byte) {
+	me.add(Num(RT_MANIFEST), Num(1), langId, _MEM_MOVEABLE|_MEM_PURE, manifest)
+}
+
+// Returns the entries sorted the way the resource directory requires: string
+// IDs first, case-insensitively, then numeric IDs in ascending order.
+func (me *Resources) sorted() []Resource {
+	entries := make([]Resource, len(me.entries))
+	copy(entries, me.entries)
+	sort.SliceStable(entries, func(a, b int) bool {
+		if c := idCompare(entries[a].Type, entries[b].Type); c != 0 {
+			return c < 0
+		}
+		if c := idCompare(entries[a].Name, entries[b].Name); c != 0 {
+			return c < 0
+		}
+		return entries[a].LangId < entries[b].LangId
+	})
+	return entries
+}
+
+func idCompare(a, b Id) int {
+	switch {
+	case a.IsStr() && b.IsStr():
+		return strings.Compare(strings.ToUpper(a.str), strings.ToUpper(b.str))
+	case a.IsStr():
+		return -1
+	case b.IsStr():
+		return 1
+	default:
+		return int(a.num) - int(b.num)
+	}
+}
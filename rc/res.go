@@ -0,0 +1,63 @@
+package rc
+
+import (
+	"encoding/binary"
+	"unicode/utf16"
+)
+
+// Serializes the resources into the contents of a .res file, the format
+// written by the rc.exe resource compiler, in the order they were added.
+//
+// # Example
+//
+//	res := rc.New()
+//	res.AddManifest(rc.LANG_EN_US, manifest)
+//	_ = os.WriteFile("app.res", res.Res(), 0o644)
+func (me *Resources) Res() []byte {
+	buf := make([]byte, 0, 1024) // arbitrary
+
+	buf = appendResEntry(buf, &Resource{Type: Num(0), Name: Num(0)}) // empty header, which identifies a 32-bit .res
+	for idx := range me.entries {
+		buf = appendResEntry(buf, &me.entries[idx])
+	}
+	return buf
+}
+
+// Appends a RESOURCEHEADER and its data, padded to 32 bits.
+func appendResEntry(buf []byte, res *Resource) []byte {
+	header := make([]byte, 0, 64) // arbitrary
+	header = appendResId(header, res.Type)
+	header = appendResId(header, res.Name)
+	header = padDword(header)
+	header = binary.LittleEndian.AppendUint32(header, 0) // DataVersion
+	header = binary.LittleEndian.AppendUint16(header, res.MemoryFlags)
+	header = binary.LittleEndian.AppendUint16(header, res.LangId)
+	header = binary.LittleEndian.AppendUint32(header, 0) // Version
+	header = binary.LittleEndian.AppendUint32(header, 0) // Characteristics
+
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(res.Data)))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(8+len(header))) // HeaderSize
+	buf = append(buf, header...)
+	buf = append(buf, res.Data...)
+	return padDword(buf)
+}
+
+// Appends a type or name of a .res header: 0xffff plus the ordinal, or a
+// null-terminated string.
+func appendResId(buf []byte, id Id) []byte {
+	if id.IsStr() {
+		for _, w := range utf16.Encode([]rune(id.str)) {
+			buf = binary.LittleEndian.AppendUint16(buf, w)
+		}
+		return binary.LittleEndian.AppendUint16(buf, 0)
+	}
+	buf = binary.LittleEndian.AppendUint16(buf, 0xffff)
+	return binary.LittleEndian.AppendUint16(buf, id.num)
+}
+
+func padDword(buf []byte) []byte {
+	for len(buf)%4 != 0 {
+		buf = append(buf, 0)
+	}
+	return buf
+}
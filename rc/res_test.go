@@ -0,0 +1,41 @@
+package rc
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRes(t *testing.T) {
+	res := New()
+	res.Add(Str("AB"), Num(1), LANG_NEUTRAL, []byte{1, 2, 3})
+	res.Add(Num(RT_RCDATA), Str("X"), LANG_EN_US, []byte{4, 5, 6, 7})
+
+	want := []byte{
+		0x00, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, // empty header entry
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x03, 0x00, 0x00, 0x00, 0x24, 0x00, 0x00, 0x00, // DataSize, HeaderSize
+		0x41, 0x00, 0x42, 0x00, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0x00, 0x00, // type "AB", name 1, padding
+		0x00, 0x00, 0x00, 0x00, 0x30, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // DataVersion, MemoryFlags, LangId, Version, Characteristics
+		0x01, 0x02, 0x03, 0x00, // data, padding
+		0x04, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, // DataSize, HeaderSize
+		0xff, 0xff, 0x0a, 0x00, 0x58, 0x00, 0x00, 0x00, // type RT_RCDATA, name "X"
+		0x00, 0x00, 0x00, 0x00, 0x30, 0x00, 0x09, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // DataVersion, MemoryFlags, LangId, Version, Characteristics
+		0x04, 0x05, 0x06, 0x07, // data
+	}
+
+	if got := res.Res(); !bytes.Equal(got, want) {
+		t.Errorf("Res() =\n% x\nwant\n% x", got, want)
+	}
+}
+
+func TestResReplace(t *testing.T) {
+	res := New()
+	res.Add(Num(RT_RCDATA), Num(1), LANG_NEUTRAL, []byte{1})
+	res.Add(Num(RT_RCDATA), Num(2), LANG_NEUTRAL, []byte{2})
+	res.Add(Num(RT_RCDATA), Num(1), LANG_NEUTRAL, []byte{3})
+
+	entries := res.Entries()
+	if len(entries) != 2 || entries[0].Name != Num(1) || !bytes.Equal(entries[0].Data, []byte{3}) {
+		t.Errorf("got %+v", entries)
+	}
+}
@@ -0,0 +1,47 @@
+package rc
+
+import (
+	"encoding/binary"
+	"sort"
+	"unicode/utf16"
+)
+
+// Adds a string table, whose strings are loaded with LoadString. The strings
+// are grouped in RT_STRING blocks of 16, as the system requires.
+//
+// # Example
+//
+//	res := rc.New()
+//	res.AddStringTable(rc.LANG_EN_US, map[uint16]string{
+//		1001: "File not found.",
+//		1002: "Access denied.",
+//	})
+func (me *Resources) AddStringTable(langId uint16, strs map[uint16]string) {
+	blocks := make(map[uint16]*[16]string)
+	for id, str := range strs {
+		blockId := id/16 + 1
+		if blocks[blockId] == nil {
+			blocks[blockId] = &[16]string{}
+		}
+		blocks[blockId][id%16] = str
+	}
+
+	blockIds := make([]int, 0, len(blocks))
+	for blockId := range blocks {
+		blockIds = append(blockIds, int(blockId))
+	}
+	sort.Ints(blockIds) // deterministic order
+
+	for _, blockId := range blockIds {
+		data := make([]byte, 0, 16*2)
+		for _, str := range blocks[uint16(blockId)] {
+			words := utf16.Encode([]rune(str))
+			data = binary.LittleEndian.AppendUint16(data, uint16(len(words)))
+			for _, w := range words {
+				data = binary.LittleEndian.AppendUint16(data, w)
+			}
+		}
+		me.add(Num(RT_STRING), Num(uint16(blockId)), langId,
+			_MEM_MOVEABLE|_MEM_PURE|_MEM_DISCARDABLE, data)
+	}
+}
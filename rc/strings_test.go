@@ -0,0 +1,44 @@
+package rc
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestAddStringTable(t *testing.T) {
+	res := New()
+	res.AddStringTable(LANG_EN_US, map[uint16]string{
+		33: "D",
+		0:  "A",
+		16: "C",
+		15: "B",
+		17: "",  // same as absent
+		18: "é", // UTF-16 length, not bytes
+	})
+
+	empty := func(n int) []byte { return bytes.Repeat([]byte{0x00, 0x00}, n) }
+	concat := func(parts ...[]byte) []byte { return bytes.Join(parts, nil) }
+
+	want := []Resource{
+		{Num(RT_STRING), Num(1), LANG_EN_US, 0x1030, concat( // IDs 0 to 15
+			[]byte{0x01, 0x00, 0x41, 0x00}, // 0: "A"
+			empty(14),
+			[]byte{0x01, 0x00, 0x42, 0x00}, // 15: "B"
+		)},
+		{Num(RT_STRING), Num(2), LANG_EN_US, 0x1030, concat( // IDs 16 to 31
+			[]byte{0x01, 0x00, 0x43, 0x00}, // 16: "C"
+			empty(1),
+			[]byte{0x01, 0x00, 0xe9, 0x00}, // 18: "é"
+			empty(13),
+		)},
+		{Num(RT_STRING), Num(3), LANG_EN_US, 0x1030, concat( // IDs 32 to 47
+			empty(1),
+			[]byte{0x01, 0x00, 0x44, 0x00}, // 33: "D"
+			empty(14),
+		)},
+	}
+	if got := res.Entries(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v\nwant %+v", got, want)
+	}
+}
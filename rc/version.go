@@ -0,0 +1,100 @@
+package rc
+
+import (
+	"github.com/rodrigocfd/windigo/internal/verblock"
+)
+
+type (
+	// Version information, to be added with [Resources.AddVersion].
+	VersionInfo struct {
+		FileVersion    [4]uint16 // Major, minor, patch and build.
+		ProductVersion [4]uint16 // Major, minor, patch and build.
+		FileFlagsMask  uint32    // Usually 0x3f.
+		FileFlags      uint32    // VS_FF flags, like VS_FF_DEBUG.
+		FileOS         uint32    // Usually VOS_NT_WINDOWS32, 0x0004_0004.
+		FileType       uint32    // VFT_APP is 1, VFT_DLL is 2.
+		FileSubtype    uint32
+		FileDate       uint64
+		// Tables of the StringFileInfo block, one for each language.
+		StringTables []VersionStringTable
+		// Language and code page pairs of the VarFileInfo\Translation block.
+		Translations []VersionTranslation
+	}
+
+	// A table within the StringFileInfo block of a [VersionInfo].
+	VersionStringTable struct {
+		LangId   uint16
+		CodePage uint16
+		// Strings of the table, like "CompanyName", in the order they are
+		// written.
+		Strings []VersionString
+	}
+
+	// A string within a [VersionStringTable].
+	VersionString struct {
+		Key   string
+		Value string
+	}
+
+	// A language and code page pair, within a [VersionInfo].
+	VersionTranslation struct {
+		LangId   uint16
+		CodePage uint16
+	}
+)
+
+// Adds the version information, as RT_VERSION with ID 1.
+//
+// # Example
+//
+//	res := rc.New()
+//	res.AddVersion(rc.LANG_EN_US, &rc.VersionInfo{
+//		FileVersion:    [4]uint16{1, 0, 0, 0},
+//		ProductVersion: [4]uint16{1, 0, 0, 0},
+//		FileFlagsMask:  0x3f,
+//		FileOS:         0x0004_0004,
+//		FileType:       1,
+//		StringTables: []rc.VersionStringTable{{
+//			LangId:   rc.LANG_EN_US,
+//			CodePage: 1200,
+//			Strings: []rc.VersionString{
+//				{"CompanyName", "Foo Inc."},
+//				{"ProductName", "Foo"},
+//			},
+//		}},
+//		Translations: []rc.VersionTranslation{{rc.LANG_EN_US, 1200}},
+//	})
+func (me *Resources) AddVersion(langId uint16, info *VersionInfo) {
+	me.add(Num(RT_VERSION), Num(1), langId, _MEM_MOVEABLE|_MEM_PURE, info.Serialize())
+}
+
+// Serializes the version information into a raw VS_VERSIONINFO block.
+func (me *VersionInfo) Serialize() []byte {
+	info := verblock.VersionInfo{
+		Fixed: [13]uint32{
+			verblock.FFI_SIGNATURE,
+			verblock.FFI_STRUCVERSION,
+			uint32(me.FileVersion[0])<<16 | uint32(me.FileVersion[1]),
+			uint32(me.FileVersion[2])<<16 | uint32(me.FileVersion[3]),
+			uint32(me.ProductVersion[0])<<16 | uint32(me.ProductVersion[1]),
+			uint32(me.ProductVersion[2])<<16 | uint32(me.ProductVersion[3]),
+			me.FileFlagsMask, me.FileFlags, me.FileOS, me.FileType, me.FileSubtype,
+			uint32(me.FileDate >> 32), uint32(me.FileDate),
+		},
+		StringTables: make([]verblock.StringTable, 0, len(me.StringTables)),
+		Translations: make([]verblock.Translation, 0, len(me.Translations)),
+	}
+	for _, table := range me.StringTables {
+		strs := make([]verblock.String, 0, len(table.Strings))
+		for _, str := range table.Strings {
+			strs = append(strs, verblock.String{Key: str.Key, Value: str.Value})
+		}
+		info.StringTables = append(info.StringTables,
+			verblock.StringTable{LangId: table.LangId, CodePage: table.CodePage, Strings: strs})
+	}
+	for _, tr := range me.Translations {
+		info.Translations = append(info.Translations,
+			verblock.Translation{LangId: tr.LangId, CodePage: tr.CodePage})
+	}
+	return info.Serialize()
+}
@@ -0,0 +1,60 @@
+package rc
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestVersionInfoRes(t *testing.T) {
+	res := New()
+	res.AddVersion(LANG_EN_US, &VersionInfo{
+		FileVersion:    [4]uint16{1, 2, 3, 4},
+		ProductVersion: [4]uint16{1, 2, 0, 0},
+		FileFlagsMask:  0x3f,
+		FileOS:         0x0004_0004,
+		FileType:       1,
+		StringTables: []VersionStringTable{{
+			LangId:   LANG_EN_US,
+			CodePage: 1200,
+			Strings:  []VersionString{{"CompanyName", "Foo"}},
+		}},
+		Translations: []VersionTranslation{{LANG_EN_US, 1200}},
+	})
+
+	want := []byte{
+		0x00, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, // empty header entry
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x04, 0x01, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, // DataSize, HeaderSize
+		0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x01, 0x00, // type RT_VERSION, name 1
+		0x00, 0x00, 0x00, 0x00, 0x30, 0x00, 0x09, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // DataVersion, MemoryFlags, LangId, Version, Characteristics
+		0x04, 0x01, 0x34, 0x00, 0x00, 0x00, // VS_VERSIONINFO: wLength, wValueLength, wType
+		0x56, 0x00, 0x53, 0x00, 0x5f, 0x00, 0x56, 0x00, 0x45, 0x00, 0x52, 0x00, 0x53, 0x00, 0x49, 0x00, // "VS_VERSION_INFO", padding
+		0x4f, 0x00, 0x4e, 0x00, 0x5f, 0x00, 0x49, 0x00, 0x4e, 0x00, 0x46, 0x00, 0x4f, 0x00, 0x00, 0x00,
+		0x00, 0x00,
+		0xbd, 0x04, 0xef, 0xfe, 0x00, 0x00, 0x01, 0x00, 0x02, 0x00, 0x01, 0x00, 0x04, 0x00, 0x03, 0x00, // VS_FIXEDFILEINFO
+		0x02, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x04, 0x00, 0x04, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+		0x64, 0x00, 0x00, 0x00, 0x01, 0x00, // StringFileInfo: wLength, wValueLength, wType
+		0x53, 0x00, 0x74, 0x00, 0x72, 0x00, 0x69, 0x00, 0x6e, 0x00, 0x67, 0x00, 0x46, 0x00, 0x69, 0x00, // "StringFileInfo"
+		0x6c, 0x00, 0x65, 0x00, 0x49, 0x00, 0x6e, 0x00, 0x66, 0x00, 0x6f, 0x00, 0x00, 0x00,
+		0x40, 0x00, 0x00, 0x00, 0x01, 0x00, // StringTable: wLength, wValueLength, wType
+		0x30, 0x00, 0x34, 0x00, 0x30, 0x00, 0x39, 0x00, 0x30, 0x00, 0x34, 0x00, 0x62, 0x00, 0x30, 0x00, // "040904b0"
+		0x00, 0x00,
+		0x28, 0x00, 0x04, 0x00, 0x01, 0x00, // String: wLength, wValueLength, wType
+		0x43, 0x00, 0x6f, 0x00, 0x6d, 0x00, 0x70, 0x00, 0x61, 0x00, 0x6e, 0x00, 0x79, 0x00, 0x4e, 0x00, // "CompanyName", padding
+		0x61, 0x00, 0x6d, 0x00, 0x65, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x46, 0x00, 0x6f, 0x00, 0x6f, 0x00, 0x00, 0x00, // "Foo"
+		0x44, 0x00, 0x00, 0x00, 0x01, 0x00, // VarFileInfo: wLength, wValueLength, wType
+		0x56, 0x00, 0x61, 0x00, 0x72, 0x00, 0x46, 0x00, 0x69, 0x00, 0x6c, 0x00, 0x65, 0x00, 0x49, 0x00, // "VarFileInfo", padding
+		0x6e, 0x00, 0x66, 0x00, 0x6f, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x24, 0x00, 0x04, 0x00, 0x00, 0x00, // Var: wLength, wValueLength, wType
+		0x54, 0x00, 0x72, 0x00, 0x61, 0x00, 0x6e, 0x00, 0x73, 0x00, 0x6c, 0x00, 0x61, 0x00, 0x74, 0x00, // "Translation", padding
+		0x69, 0x00, 0x6f, 0x00, 0x6e, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x09, 0x04, 0xb0, 0x04, // LANG_EN_US, 1200
+	}
+
+	if got := res.Res(); !bytes.Equal(got, want) {
+		t.Errorf("Res() =\n% x\nwant\n% x", got, want)
+	}
+}
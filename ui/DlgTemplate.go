@@ -0,0 +1,94 @@
+package ui
+
+// This file has no knowledge of windows, so the dialog template conversion can
+// be tested on any OS.
+
+import (
+	"github.com/rodrigocfd/windigo/internal/dlgtemplate"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+type (
+	// An in-memory dialog template, which creates a dialog-based window
+	// without a dialog resource. Passed to [NewModalDlgTemplate] and to the
+	// Template option of [VarOptsMainDlg], [VarOptsControlDlg] and
+	// [VarOptsPropertySheetPage].
+	//
+	// Coordinates and sizes are in dialog units.
+	DlgTemplate struct {
+		HelpId  uint32
+		ExStyle co.WS_EX
+		// Window styles, combined with co.DS dialog styles. co.DS_SETFONT is
+		// added automatically if FontFace is not empty.
+		Style     co.WS
+		X, Y      int16
+		Cx, Cy    int16
+		MenuId    uint16 // Optional menu resource.
+		ClassName string // Optional window class; empty for the dialog box class.
+		Title     string
+
+		FontSize    uint16 // Point size.
+		FontWeight  uint16
+		FontItalic  bool
+		FontCharset uint8
+		FontFace    string // Like "MS Shell Dlg".
+
+		Controls []DlgTemplateCtrl
+	}
+
+	// A control within a [DlgTemplate].
+	DlgTemplateCtrl struct {
+		HelpId  uint32
+		ExStyle co.WS_EX
+		Style   co.WS // Usually has WS_CHILD and WS_VISIBLE, combined with the control styles.
+		X, Y    int16
+		Cx, Cy  int16
+		Id      uint16
+		// Window class, like "Button", "Edit", "Static" or "SysListView32".
+		ClassName string
+		Title     string
+		// Resource ID used instead of Title, like an icon for a static
+		// control.
+		TitleId uint16
+		// Creation data passed in the lParam of WM_CREATE.
+		CreationData []byte
+	}
+)
+
+// Serializes the template in the DLGTEMPLATEEX format.
+func (me *DlgTemplate) serialize() ([]byte, error) {
+	tmpl := dlgtemplate.Dialog{
+		HelpId:      me.HelpId,
+		ExStyle:     uint32(me.ExStyle),
+		Style:       uint32(me.Style),
+		X:           me.X,
+		Y:           me.Y,
+		Cx:          me.Cx,
+		Cy:          me.Cy,
+		Menu:        dlgtemplate.SzOrOrd{Ord: me.MenuId},
+		Class:       dlgtemplate.SzOrOrd{Str: me.ClassName},
+		Title:       me.Title,
+		FontSize:    me.FontSize,
+		FontWeight:  me.FontWeight,
+		FontItalic:  me.FontItalic,
+		FontCharset: me.FontCharset,
+		FontFace:    me.FontFace,
+		Controls:    make([]dlgtemplate.Control, 0, len(me.Controls)),
+	}
+	for _, ctrl := range me.Controls {
+		tmpl.Controls = append(tmpl.Controls, dlgtemplate.Control{
+			HelpId:       ctrl.HelpId,
+			ExStyle:      uint32(ctrl.ExStyle),
+			Style:        uint32(ctrl.Style),
+			X:            ctrl.X,
+			Y:            ctrl.Y,
+			Cx:           ctrl.Cx,
+			Cy:           ctrl.Cy,
+			Id:           uint32(ctrl.Id),
+			Class:        dlgtemplate.SzOrOrd{Str: ctrl.ClassName},
+			Title:        dlgtemplate.SzOrOrd{Ord: ctrl.TitleId, Str: ctrl.Title},
+			CreationData: ctrl.CreationData,
+		})
+	}
+	return tmpl.Serialize()
+}
@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/internal/dlgtemplate"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+func TestDlgTemplateSerialize(t *testing.T) {
+	tmpl := &DlgTemplate{
+		HelpId: 7, ExStyle: co.WS_EX_TOPMOST, Style: co.WS_POPUP | co.WS(co.DS_MODALFRAME),
+		X: 10, Y: -20, Cx: 200, Cy: 100,
+		MenuId: 100, ClassName: "MyDlg", Title: "Hi",
+		FontSize: 8, FontWeight: 400, FontItalic: true, FontCharset: 1, FontFace: "MS Shell Dlg",
+		Controls: []DlgTemplateCtrl{
+			{
+				HelpId: 3, ExStyle: co.WS_EX_CLIENTEDGE, Style: co.WS_CHILD | co.WS_VISIBLE,
+				X: 5, Y: 6, Cx: 50, Cy: 14, Id: 1,
+				ClassName: "Button", Title: "Yes",
+			},
+			{
+				Style: co.WS_CHILD | co.WS_VISIBLE | co.WS(co.SS_ICON),
+				X:     5, Y: 24, Cx: 20, Cy: 20, Id: 1000,
+				ClassName: "Static", TitleId: 5,
+				CreationData: []byte{0xaa, 0xbb},
+			},
+		},
+	}
+
+	// The same template, written directly in the serializer types.
+	raw := &dlgtemplate.Dialog{
+		HelpId: 7, ExStyle: uint32(co.WS_EX_TOPMOST), Style: uint32(co.WS_POPUP) | uint32(co.DS_MODALFRAME),
+		X: 10, Y: -20, Cx: 200, Cy: 100,
+		Menu: dlgtemplate.SzOrOrd{Ord: 100}, Class: dlgtemplate.SzOrOrd{Str: "MyDlg"}, Title: "Hi",
+		FontSize: 8, FontWeight: 400, FontItalic: true, FontCharset: 1, FontFace: "MS Shell Dlg",
+		Controls: []dlgtemplate.Control{
+			{
+				HelpId: 3, ExStyle: uint32(co.WS_EX_CLIENTEDGE), Style: uint32(co.WS_CHILD | co.WS_VISIBLE),
+				X: 5, Y: 6, Cx: 50, Cy: 14, Id: 1,
+				Class: dlgtemplate.SzOrOrd{Str: "Button"}, Title: dlgtemplate.SzOrOrd{Str: "Yes"},
+			},
+			{
+				Style: uint32(co.WS_CHILD|co.WS_VISIBLE) | uint32(co.SS_ICON),
+				X:     5, Y: 24, Cx: 20, Cy: 20, Id: 1000,
+				Class: dlgtemplate.SzOrOrd{Str: "Static"}, Title: dlgtemplate.SzOrOrd{Ord: 5},
+				CreationData: []byte{0xaa, 0xbb},
+			},
+		},
+	}
+
+	got, err := tmpl.serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := raw.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("serialize() =\n% x\nwant\n% x", got, want)
+	}
+}
@@ -0,0 +1,55 @@
+package ui
+
+// This file has no knowledge of windows, so the DPI scaling maths can be
+// tested on any OS.
+
+// Converts a value, in pixels, from one DPI to another, rounding to the nearest
+// integer, like [MulDiv] does.
+//
+// This is a pure function, useful when handling [WM_DPICHANGED] manually.
+//
+// # Example
+//
+//	ui.DpiScale(20, 96, 144) // 30
+//
+// [MulDiv]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-muldiv
+// [WM_DPICHANGED]: https://learn.microsoft.com/en-us/windows/win32/hidpi/wm-dpichanged
+func DpiScale(value, fromDpi, toDpi int) int {
+	if fromDpi == toDpi || fromDpi == 0 {
+		return value
+	}
+
+	num := int64(value) * int64(toDpi)
+	half := int64(fromDpi) / 2
+	if num < 0 {
+		return int((num - half) / int64(fromDpi)) // round half away from zero
+	}
+	return int((num + half) / int64(fromDpi))
+}
+
+func dpiScale32(value int32, fromDpi, toDpi int) int32 {
+	return int32(DpiScale(int(value), fromDpi, toDpi))
+}
+
+// Scales each edge of the rectangle independently, so adjacent rectangles
+// remain adjacent after the conversion. Accepts win.RECT, whose fields are
+// matched by the underlying struct type.
+func dpiScaleRect[R ~struct{ Left, Top, Right, Bottom int32 }](rc R, fromDpi, toDpi int) R {
+	raw := struct{ Left, Top, Right, Bottom int32 }(rc)
+	return R{
+		Left:   dpiScale32(raw.Left, fromDpi, toDpi),
+		Top:    dpiScale32(raw.Top, fromDpi, toDpi),
+		Right:  dpiScale32(raw.Right, fromDpi, toDpi),
+		Bottom: dpiScale32(raw.Bottom, fromDpi, toDpi),
+	}
+}
+
+// Scales both dimensions. Accepts win.SIZE, whose fields are matched by the
+// underlying struct type.
+func dpiScaleSize[S ~struct{ Cx, Cy int32 }](sz S, fromDpi, toDpi int) S {
+	raw := struct{ Cx, Cy int32 }(sz)
+	return S{
+		Cx: dpiScale32(raw.Cx, fromDpi, toDpi),
+		Cy: dpiScale32(raw.Cy, fromDpi, toDpi),
+	}
+}
@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"testing"
+)
+
+func TestDpiScale(t *testing.T) {
+	tests := []struct {
+		name                  string
+		value, fromDpi, toDpi int
+		want                  int
+	}{
+		{"equal dpi", 37, 120, 120, 37},
+		{"zero source dpi", 37, 0, 144, 37},
+		{"96 to 144", 20, 96, 144, 30},
+		{"96 to 192", 20, 96, 192, 40},
+		{"144 to 96", 30, 144, 96, 20},
+		{"192 to 96", 40, 192, 96, 20},
+		{"half rounds up", 41, 192, 96, 21},
+		{"above half rounds up", 1, 144, 96, 1},
+		{"below half rounds down", 1, 192, 48, 0},
+		{"negative", -20, 96, 144, -30},
+		{"negative half rounds away from zero", -41, 192, 96, -21},
+		{"negative below half", -1, 192, 48, 0},
+		{"zero", 0, 96, 144, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := DpiScale(tc.value, tc.fromDpi, tc.toDpi); got != tc.want {
+				t.Errorf("DpiScale(%d, %d, %d) = %d, want %d",
+					tc.value, tc.fromDpi, tc.toDpi, got, tc.want)
+			}
+		})
+	}
+}
+
+// Same layouts of win.RECT and win.SIZE, which are not available on all OSes.
+type (
+	_TestRect struct{ Left, Top, Right, Bottom int32 }
+	_TestSize struct{ Cx, Cy int32 }
+)
+
+func TestDpiScaleRect(t *testing.T) {
+	rc := _TestRect{Left: -10, Top: 5, Right: 75, Bottom: 101}
+	if got, want := dpiScaleRect(rc, 96, 144), (_TestRect{-15, 8, 113, 152}); got != want {
+		t.Errorf("96 to 144: got %v, want %v", got, want)
+	}
+	if got := dpiScaleRect(rc, 96, 96); got != rc {
+		t.Errorf("equal dpi: got %v, want %v", got, rc)
+	}
+
+	// Adjacent rectangles must remain adjacent.
+	left := dpiScaleRect(_TestRect{0, 0, 33, 10}, 96, 120)
+	right := dpiScaleRect(_TestRect{33, 0, 67, 10}, 96, 120)
+	if left.Right != right.Left {
+		t.Errorf("adjacent edges differ: %d and %d", left.Right, right.Left)
+	}
+}
+
+func TestDpiScaleSize(t *testing.T) {
+	sz := _TestSize{Cx: 300, Cy: 201}
+	if got, want := dpiScaleSize(sz, 96, 192), (_TestSize{600, 402}); got != want {
+		t.Errorf("96 to 192: got %v, want %v", got, want)
+	}
+	if got, want := dpiScaleSize(sz, 144, 96), (_TestSize{200, 134}); got != want {
+		t.Errorf("144 to 96: got %v, want %v", got, want)
+	}
+}
@@ -9,6 +9,8 @@ import (
 
 // Horizontal and vertical behavior for the control layout, when the parent
 // window is resized.
+//
+// For declarative layouts with rows and columns, see [Grid].
 type LAY uint8
 
 const (
@@ -92,6 +94,16 @@ func (me *_Layout) Add(parent Parent, hCtrl win.HWND, layout LAY) {
 	me.ctrls = append(me.ctrls, _LayoutCtrl{hCtrl, rcOrig, layout})
 }
 
+// Converts the original sizes and positions to a new DPI, so the next
+// rearrangement keeps the proportions. To be called during WM_DPICHANGED
+// processing.
+func (me *_Layout) rescale(oldDpi, newDpi int) {
+	me.szOrig = dpiScaleSize(me.szOrig, oldDpi, newDpi)
+	for i := range me.ctrls {
+		me.ctrls[i].rcOrig = dpiScaleRect(me.ctrls[i].rcOrig, oldDpi, newDpi)
+	}
+}
+
 // Rearrange all children. To be called during WM_SIZE processing.
 func (me *_Layout) Rearrange(parm WmSize) {
 	if len(me.ctrls) == 0 || parm.Request() == co.SIZE_REQ_MINIMIZED {
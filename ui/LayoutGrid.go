@@ -0,0 +1,420 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Declarative layout manager which arranges child controls in rows and
+// columns. Each row and column is a [GridTrack], which can have a fixed size,
+// an automatic size, or a proportional share of the remaining space.
+//
+// The children are rearranged with [BeginDeferWindowPos] whenever the parent
+// receives [WM_SIZE]. All sizes are rescaled when the parent is moved to a
+// monitor with a different DPI.
+//
+// # Example
+//
+//	var wnd ui.Parent // initialized somewhere
+//	var lbl *ui.Static
+//	var txt *ui.Edit
+//	var list *ui.ListView
+//
+//	grid := ui.NewGrid(wnd,
+//		ui.OptsGrid().
+//			Cols(ui.TrackAuto(), ui.TrackStar(1)).
+//			Rows(ui.TrackAuto(), ui.TrackStar(1)).
+//			Padding(ui.DpiX(8)).
+//			Spacing(ui.DpiX(6), ui.DpiY(6)),
+//	)
+//	grid.Add(lbl, ui.OptsGridCell().Cell(0, 0).VAlign(ui.ALIGN_CENTER))
+//	grid.Add(txt, ui.OptsGridCell().Cell(0, 1))
+//	grid.Add(list, ui.OptsGridCell().Cell(1, 0).Span(1, 2))
+//
+// [BeginDeferWindowPos]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-begindeferwindowpos
+// [WM_SIZE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-size
+type Grid struct {
+	parent   Parent
+	geometry _GridGeometry
+	children []_GridChild
+	dpi      int // DPI of the sizes in geometry and children
+}
+
+type _GridChild struct {
+	ctrl      Window
+	item      _GridItem
+	prefGiven bool // preferred size was explicitly informed in the options
+	captured  bool // preferred size was already read from the HWND
+}
+
+// Placement of a child within the grid, as seen by the solver.
+type _GridItem struct {
+	row, col         int
+	rowSpan, colSpan int
+	margin           win.RECT // left, top, right and bottom margins, in pixels
+	hAlign, vAlign   ALIGN
+	pref             win.SIZE // preferred size, used by auto tracks and non-stretched alignments
+	min, max         win.SIZE // zero max means unlimited
+}
+
+// Pure geometry of a grid: tracks, spacing and padding. Has no knowledge of
+// windows, so it can be computed anywhere.
+type _GridGeometry struct {
+	rows, cols []GridTrack
+	padding    win.RECT // left, top, right and bottom padding of the whole grid
+	spacingX   int      // gap between columns
+	spacingY   int      // gap between rows
+}
+
+// Computes the rectangle of each item, in the same order, for the given client
+// area size.
+func (me *_GridGeometry) solve(items []_GridItem, client win.SIZE) []win.RECT {
+	colPos, colLen := solveTracks(me.cols, axisNeeds(items, true),
+		int(client.Cx), int(me.padding.Left), int(me.padding.Right), me.spacingX)
+	rowPos, rowLen := solveTracks(me.rows, axisNeeds(items, false),
+		int(client.Cy), int(me.padding.Top), int(me.padding.Bottom), me.spacingY)
+
+	rects := make([]win.RECT, 0, len(items))
+	for i := range items {
+		it := &items[i]
+		x, cx := spanExtent(colPos, colLen, it.col, it.colSpan)
+		y, cy := spanExtent(rowPos, rowLen, it.row, it.rowSpan)
+
+		x += int(it.margin.Left)
+		cx -= int(it.margin.Left + it.margin.Right)
+		y += int(it.margin.Top)
+		cy -= int(it.margin.Top + it.margin.Bottom)
+
+		x, cx = alignInCell(x, cx, int(it.pref.Cx), int(it.min.Cx), int(it.max.Cx), it.hAlign)
+		y, cy = alignInCell(y, cy, int(it.pref.Cy), int(it.min.Cy), int(it.max.Cy), it.vAlign)
+
+		rects = append(rects, win.RECT{
+			Left:   int32(x),
+			Top:    int32(y),
+			Right:  int32(x + cx),
+			Bottom: int32(y + cy),
+		})
+	}
+	return rects
+}
+
+// Returns the first track, the span and the needed length of each item along
+// one axis.
+func axisNeeds(items []_GridItem, horz bool) []_TrackNeed {
+	needs := make([]_TrackNeed, 0, len(items))
+	for i := range items {
+		it := &items[i]
+		if horz {
+			needs = append(needs, _TrackNeed{it.col, it.colSpan,
+				int(it.pref.Cx + it.margin.Left + it.margin.Right)})
+		} else {
+			needs = append(needs, _TrackNeed{it.row, it.rowSpan,
+				int(it.pref.Cy + it.margin.Top + it.margin.Bottom)})
+		}
+	}
+	return needs
+}
+
+// Creates a new [Grid] bound to the given parent.
+//
+// Can be created before or after the parent window.
+func NewGrid(parent Parent, opts *VarOptsGrid) *Grid {
+	me := &Grid{
+		parent: parent,
+		geometry: _GridGeometry{
+			rows:     opts.rows,
+			cols:     opts.cols,
+			padding:  opts.padding,
+			spacingX: opts.spacingX,
+			spacingY: opts.spacingY,
+		},
+		children: make([]_GridChild, 0, 8), // arbitrary
+		dpi:      parent.base().dpi,
+	}
+
+	if parent.Hwnd() == 0 {
+		// Children are created in beforeUserEvents, so after the user events
+		// all of them exist, and the first arrangement can be made.
+		parent.base().afterUserEvents.Wm(parent.base().wndTy.initMsg(), func(_ Wm) uintptr {
+			me.Arrange()
+			return 0 // ignored
+		})
+	}
+
+	parent.base().beforeUserEvents.WmSize(func(p WmSize) {
+		if p.Request() != co.SIZE_REQ_MINIMIZED { // no need to resize if window is minimized
+			me.arrange(p.ClientAreaSize())
+		}
+	})
+
+	for _, msg := range []co.WM{co.WM_DPICHANGED, co.WM_DPICHANGED_AFTERPARENT} {
+		parent.base().afterUserEvents.Wm(msg, func(_ Wm) uintptr {
+			me.Arrange() // sizes are rescaled within
+			return 0     // ignored
+		})
+	}
+
+	return me
+}
+
+// Adds a child control to the grid, at the given cell.
+//
+// If the parent window already exists, the grid is immediately rearranged.
+func (me *Grid) Add(ctrl Window, opts *VarOptsGridCell) *Grid {
+	me.children = append(me.children, _GridChild{
+		ctrl:      ctrl,
+		item:      opts.item,
+		prefGiven: opts.prefGiven,
+	})
+
+	if me.parent.Hwnd() != 0 && ctrl.Hwnd() != 0 {
+		me.Arrange()
+	}
+	return me
+}
+
+// Removes the child control from the grid, if present. The control itself is
+// not destroyed, and it will no longer be resized.
+func (me *Grid) Remove(ctrl Window) *Grid {
+	for i := range me.children {
+		if me.children[i].ctrl == ctrl {
+			me.children = append(me.children[:i], me.children[i+1:]...)
+			break
+		}
+	}
+	return me
+}
+
+// Immediately recomputes the position of all children, according to the
+// current size of the parent's client area.
+//
+// There's no need to call this method upon [WM_SIZE], which is automatically
+// handled.
+//
+// [WM_SIZE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-size
+func (me *Grid) Arrange() {
+	if me.parent.Hwnd() == 0 {
+		return // parent not created yet
+	}
+	rc, _ := me.parent.Hwnd().GetClientRect()
+	me.arrange(win.SIZE{Cx: rc.Right, Cy: rc.Bottom})
+}
+
+func (me *Grid) arrange(client win.SIZE) {
+	if newDpi := me.parent.base().dpi; newDpi != me.dpi {
+		me.rescale(me.dpi, newDpi)
+		me.dpi = newDpi
+	}
+
+	items := make([]_GridItem, 0, len(me.children))
+	hWnds := make([]win.HWND, 0, len(me.children))
+
+	for i := range me.children {
+		child := &me.children[i]
+		hCtrl := child.ctrl.Hwnd()
+		if hCtrl == 0 {
+			continue // child not created yet
+		}
+
+		if !child.prefGiven && !child.captured { // original size becomes the preferred one
+			rcCtrl, _ := hCtrl.GetWindowRect()
+			child.item.pref = win.SIZE{
+				Cx: rcCtrl.Right - rcCtrl.Left,
+				Cy: rcCtrl.Bottom - rcCtrl.Top,
+			}
+			child.captured = true
+		}
+
+		items = append(items, child.item)
+		hWnds = append(hWnds, hCtrl)
+	}
+
+	if len(items) == 0 {
+		return
+	}
+
+	rects := me.geometry.solve(items, client)
+
+	hdwp, _ := win.BeginDeferWindowPos(uint(len(rects)))
+	defer hdwp.EndDeferWindowPos()
+
+	for i, rc := range rects {
+		hdwp.DeferWindowPos(hWnds[i], win.HWND(0), int(rc.Left), int(rc.Top),
+			int(rc.Right-rc.Left), int(rc.Bottom-rc.Top), co.SWP_NOZORDER)
+	}
+}
+
+// Converts all sizes, which are in pixels, to a new DPI.
+func (me *Grid) rescale(oldDpi, newDpi int) {
+	scale := func(v int) int { return DpiScale(v, oldDpi, newDpi) }
+	scaleTracks := func(tracks []GridTrack) {
+		for i := range tracks {
+			if tracks[i].kind == TRACK_FIXED { // star size is a weight
+				tracks[i].size = scale(tracks[i].size)
+			}
+			tracks[i].min = scale(tracks[i].min)
+			tracks[i].max = scale(tracks[i].max)
+		}
+	}
+
+	scaleTracks(me.geometry.rows)
+	scaleTracks(me.geometry.cols)
+	me.geometry.padding = dpiScaleRect(me.geometry.padding, oldDpi, newDpi)
+	me.geometry.spacingX = scale(me.geometry.spacingX)
+	me.geometry.spacingY = scale(me.geometry.spacingY)
+
+	for i := range me.children {
+		it := &me.children[i].item
+		it.margin = dpiScaleRect(it.margin, oldDpi, newDpi)
+		it.pref = dpiScaleSize(it.pref, oldDpi, newDpi)
+		it.min = dpiScaleSize(it.min, oldDpi, newDpi)
+		it.max = dpiScaleSize(it.max, oldDpi, newDpi)
+	}
+}
+
+// Options for [NewGrid]; returned by [OptsGrid].
+type VarOptsGrid struct {
+	rows     []GridTrack
+	cols     []GridTrack
+	padding  win.RECT
+	spacingX int
+	spacingY int
+}
+
+// Options for [NewGrid].
+func OptsGrid() *VarOptsGrid {
+	return &VarOptsGrid{}
+}
+
+// Rows of the grid, from top to bottom.
+//
+// Defaults to a single ui.TrackStar(1) row.
+func (o *VarOptsGrid) Rows(t ...GridTrack) *VarOptsGrid { o.rows = t; return o }
+
+// Columns of the grid, from left to right.
+//
+// Defaults to a single ui.TrackStar(1) column.
+func (o *VarOptsGrid) Cols(t ...GridTrack) *VarOptsGrid { o.cols = t; return o }
+
+// Same padding, in pixels, between the grid and all edges of the parent's
+// client area.
+//
+// Defaults to zero.
+func (o *VarOptsGrid) Padding(p int) *VarOptsGrid {
+	return o.PaddingEach(p, p, p, p)
+}
+
+// Padding, in pixels, between the grid and each edge of the parent's client
+// area.
+//
+// Defaults to zero.
+func (o *VarOptsGrid) PaddingEach(left, top, right, bottom int) *VarOptsGrid {
+	o.padding = win.RECT{
+		Left:   int32(left),
+		Top:    int32(top),
+		Right:  int32(right),
+		Bottom: int32(bottom),
+	}
+	return o
+}
+
+// Gap, in pixels, between adjacent columns and between adjacent rows.
+//
+// Defaults to zero.
+func (o *VarOptsGrid) Spacing(horz, vert int) *VarOptsGrid {
+	o.spacingX = horz
+	o.spacingY = vert
+	return o
+}
+
+// Options for [Grid.Add]; returned by [OptsGridCell].
+type VarOptsGridCell struct {
+	item      _GridItem
+	prefGiven bool
+}
+
+// Options for [Grid.Add].
+func OptsGridCell() *VarOptsGridCell {
+	return &VarOptsGridCell{
+		item: _GridItem{
+			rowSpan: 1,
+			colSpan: 1,
+		},
+	}
+}
+
+// Zero-based row and column of the cell.
+//
+// Defaults to 0, 0.
+func (o *VarOptsGridCell) Cell(row, col int) *VarOptsGridCell {
+	o.item.row = row
+	o.item.col = col
+	return o
+}
+
+// Number of rows and columns spanned by the child.
+//
+// Defaults to 1, 1.
+func (o *VarOptsGridCell) Span(rows, cols int) *VarOptsGridCell {
+	o.item.rowSpan = rows
+	o.item.colSpan = cols
+	return o
+}
+
+// Same margin, in pixels, around all edges of the child.
+//
+// Defaults to zero.
+func (o *VarOptsGridCell) Margin(m int) *VarOptsGridCell {
+	return o.MarginEach(m, m, m, m)
+}
+
+// Margin, in pixels, around each edge of the child.
+//
+// Defaults to zero.
+func (o *VarOptsGridCell) MarginEach(left, top, right, bottom int) *VarOptsGridCell {
+	o.item.margin = win.RECT{
+		Left:   int32(left),
+		Top:    int32(top),
+		Right:  int32(right),
+		Bottom: int32(bottom),
+	}
+	return o
+}
+
+// Horizontal alignment of the child within the cell.
+//
+// Defaults to ui.ALIGN_STRETCH.
+func (o *VarOptsGridCell) HAlign(a ALIGN) *VarOptsGridCell { o.item.hAlign = a; return o }
+
+// Vertical alignment of the child within the cell.
+//
+// Defaults to ui.ALIGN_STRETCH.
+func (o *VarOptsGridCell) VAlign(a ALIGN) *VarOptsGridCell { o.item.vAlign = a; return o }
+
+// Preferred size of the child, in pixels, used by auto tracks and by
+// non-stretched alignments.
+//
+// Defaults to the size the child had when it was created.
+func (o *VarOptsGridCell) Size(cx, cy int) *VarOptsGridCell {
+	o.item.pref = win.SIZE{Cx: int32(cx), Cy: int32(cy)}
+	o.prefGiven = true
+	return o
+}
+
+// Minimum size of the child, in pixels.
+//
+// Defaults to zero.
+func (o *VarOptsGridCell) MinSize(cx, cy int) *VarOptsGridCell {
+	o.item.min = win.SIZE{Cx: int32(cx), Cy: int32(cy)}
+	return o
+}
+
+// Maximum size of the child, in pixels. Zero means unlimited.
+//
+// Defaults to zero.
+func (o *VarOptsGridCell) MaxSize(cx, cy int) *VarOptsGridCell {
+	o.item.max = win.SIZE{Cx: int32(cx), Cy: int32(cy)}
+	return o
+}
@@ -0,0 +1,265 @@
+package ui
+
+// This file has no knowledge of windows, so the layout maths can be tested on
+// any OS.
+
+// Sizing behavior of a [GridTrack], which is a row or a column of a [Grid].
+type TRACK uint8
+
+const (
+	TRACK_FIXED TRACK = iota // Track has a fixed size, in pixels.
+	TRACK_AUTO               // Track is as large as its largest child.
+	TRACK_STAR               // Track takes a proportional share of the remaining space.
+)
+
+// Alignment of a child control within its [Grid] cell.
+type ALIGN uint8
+
+const (
+	ALIGN_STRETCH ALIGN = iota // Child fills the whole cell.
+	ALIGN_START                // Child is anchored at left or top.
+	ALIGN_CENTER               // Child is centered.
+	ALIGN_END                  // Child is anchored at right or bottom.
+)
+
+// A row or a column of a [Grid].
+//
+// Created with [TrackFixed], [TrackAuto] or [TrackStar].
+type GridTrack struct {
+	kind TRACK
+	size int // pixels for TRACK_FIXED, weight for TRACK_STAR
+	min  int
+	max  int // zero means unlimited
+}
+
+// Creates a track with a fixed size, in pixels.
+//
+// # Example
+//
+//	ui.TrackFixed(ui.DpiY(30))
+func TrackFixed(size int) GridTrack {
+	return GridTrack{kind: TRACK_FIXED, size: size}
+}
+
+// Creates a track which is as large as its largest child, considering the
+// child's original size plus its margins.
+func TrackAuto() GridTrack {
+	return GridTrack{kind: TRACK_AUTO}
+}
+
+// Creates a track which takes a proportional share of the space left by the
+// fixed and auto tracks. A track with weight 2 will be twice as large as a
+// track with weight 1.
+//
+// Panics if weight is not positive.
+func TrackStar(weight int) GridTrack {
+	if weight <= 0 {
+		panic("TrackStar weight must be positive.")
+	}
+	return GridTrack{kind: TRACK_STAR, size: weight}
+}
+
+// Returns a copy of the track with the given minimum size, in pixels.
+func (t GridTrack) Min(size int) GridTrack { t.min = size; return t }
+
+// Returns a copy of the track with the given maximum size, in pixels. Zero
+// means unlimited.
+func (t GridTrack) Max(size int) GridTrack { t.max = size; return t }
+
+// Returns the kind of the track.
+func (t GridTrack) Kind() TRACK { return t.kind }
+
+func (t GridTrack) clamp(size int) int {
+	if t.max > 0 && size > t.max {
+		size = t.max
+	}
+	if size < t.min {
+		size = t.min
+	}
+	return size
+}
+
+// Length required by a child along one axis, starting at a track and spanning
+// one or more tracks.
+type _TrackNeed struct {
+	start, span, need int
+}
+
+// Computes the start position and the length of each track along one axis.
+func solveTracks(
+	tracks []GridTrack,
+	needs []_TrackNeed,
+	total, padStart, padEnd, spacing int,
+) (pos, length []int) {
+	n := len(tracks)
+	if n == 0 {
+		return []int{padStart}, []int{maxInt(0, total-padStart-padEnd)} // implicit single star track
+	}
+
+	length = make([]int, n)
+	avail := total - padStart - padEnd - spacing*(n-1)
+
+	// Fixed tracks.
+	for i, t := range tracks {
+		if t.kind == TRACK_FIXED {
+			length[i] = t.clamp(t.size)
+		}
+	}
+
+	// Auto tracks, first considering children which span a single track.
+	for _, nd := range needs {
+		if nd.span == 1 && nd.start < n && tracks[nd.start].kind == TRACK_AUTO && nd.need > length[nd.start] {
+			length[nd.start] = nd.need
+		}
+	}
+	for i, t := range tracks {
+		if t.kind == TRACK_AUTO {
+			length[i] = t.clamp(length[i])
+		}
+	}
+
+	// Children spanning many tracks grow the last auto track of their span,
+	// if the whole span is not large enough.
+	for _, nd := range needs {
+		if nd.span <= 1 || nd.start >= n {
+			continue
+		}
+		end := minInt(nd.start+nd.span, n)
+		have, lastAuto, hasStar := spacing*(end-nd.start-1), -1, false
+		for j := nd.start; j < end; j++ {
+			have += length[j]
+			if tracks[j].kind == TRACK_AUTO {
+				lastAuto = j
+			} else if tracks[j].kind == TRACK_STAR {
+				hasStar = true
+			}
+		}
+		if !hasStar && lastAuto != -1 && nd.need > have {
+			length[lastAuto] = tracks[lastAuto].clamp(length[lastAuto] + nd.need - have)
+		}
+	}
+
+	// Star tracks share what is left, honoring their min/max constraints.
+	used := 0
+	for i, t := range tracks {
+		if t.kind != TRACK_STAR {
+			used += length[i]
+		}
+	}
+	distributeStars(tracks, length, maxInt(0, avail-used))
+
+	pos = make([]int, n)
+	cur := padStart
+	for i := range tracks {
+		pos[i] = cur
+		cur += length[i] + spacing
+	}
+	return pos, length
+}
+
+// Distributes the remaining space among the star tracks. Tracks whose share
+// would violate their constraints are clamped and removed from the pool, and
+// the process is repeated until all shares are stable.
+func distributeStars(tracks []GridTrack, length []int, remaining int) {
+	pending := make([]bool, len(tracks))
+	for i, t := range tracks {
+		pending[i] = t.kind == TRACK_STAR
+	}
+
+	for {
+		weights := 0
+		for i, t := range tracks {
+			if pending[i] {
+				weights += t.size
+			}
+		}
+		if weights == 0 {
+			return // no star tracks left
+		}
+
+		clamped := false
+		for i, t := range tracks {
+			if !pending[i] {
+				continue
+			}
+			share := remaining * t.size / weights
+			if fixed := t.clamp(share); fixed != share {
+				length[i] = fixed
+				remaining = maxInt(0, remaining-fixed)
+				pending[i] = false
+				clamped = true
+			}
+		}
+		if clamped {
+			continue // pool changed, compute the shares again
+		}
+
+		given := 0
+		for i, t := range tracks {
+			if pending[i] {
+				length[i] = remaining * t.size / weights
+				given += length[i]
+			}
+		}
+		for i := range tracks { // leftover pixels due to rounding, one to each track
+			if given >= remaining {
+				break
+			}
+			if pending[i] {
+				length[i]++
+				given++
+			}
+		}
+		return
+	}
+}
+
+// Returns the start position and the length of a span of tracks, including the
+// spacing between them.
+func spanExtent(pos, length []int, start, span int) (int, int) {
+	n := len(pos)
+	if start >= n {
+		start = n - 1
+	}
+	end := minInt(start+maxInt(span, 1), n) - 1
+	return pos[start], pos[end] + length[end] - pos[start]
+}
+
+// Returns the position and the length of a child within the available space of
+// its cell, according to the alignment.
+func alignInCell(start, avail, pref, min, max int, align ALIGN) (int, int) {
+	avail = maxInt(0, avail)
+	size := avail
+	if align != ALIGN_STRETCH {
+		size = minInt(pref, avail)
+	}
+	if max > 0 && size > max {
+		size = max
+	}
+	if size < min {
+		size = min
+	}
+
+	switch align {
+	case ALIGN_CENTER:
+		return start + (avail-size)/2, size
+	case ALIGN_END:
+		return start + avail - size, size
+	default:
+		return start, size
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,208 @@
+package ui
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSolveTracks(t *testing.T) {
+	tests := []struct {
+		name       string
+		tracks     []GridTrack
+		needs      []_TrackNeed
+		total      int
+		padStart   int
+		padEnd     int
+		spacing    int
+		wantPos    []int
+		wantLength []int
+	}{
+		{
+			name:       "no tracks is a single star",
+			total:      100,
+			padStart:   10,
+			padEnd:     5,
+			wantPos:    []int{10},
+			wantLength: []int{85},
+		},
+		{
+			name:       "fixed",
+			tracks:     []GridTrack{TrackFixed(50), TrackFixed(30)},
+			total:      200,
+			wantPos:    []int{0, 50},
+			wantLength: []int{50, 30},
+		},
+		{
+			name:       "fixed with padding and spacing",
+			tracks:     []GridTrack{TrackFixed(50), TrackFixed(30)},
+			total:      200,
+			padStart:   10,
+			padEnd:     10,
+			spacing:    5,
+			wantPos:    []int{10, 65},
+			wantLength: []int{50, 30},
+		},
+		{
+			name:       "fixed clamped by min and max",
+			tracks:     []GridTrack{TrackFixed(10).Min(20), TrackFixed(50).Max(40)},
+			total:      200,
+			wantPos:    []int{0, 20},
+			wantLength: []int{20, 40},
+		},
+		{
+			name:       "auto takes largest child",
+			tracks:     []GridTrack{TrackAuto(), TrackStar(1)},
+			needs:      []_TrackNeed{{0, 1, 40}, {0, 1, 25}},
+			total:      100,
+			wantPos:    []int{0, 40},
+			wantLength: []int{40, 60},
+		},
+		{
+			name:       "auto clamped by max",
+			tracks:     []GridTrack{TrackAuto().Max(30), TrackStar(1)},
+			needs:      []_TrackNeed{{0, 1, 40}},
+			total:      100,
+			wantPos:    []int{0, 30},
+			wantLength: []int{30, 70},
+		},
+		{
+			name:       "empty auto clamped by min",
+			tracks:     []GridTrack{TrackAuto().Min(25), TrackStar(1)},
+			total:      100,
+			wantPos:    []int{0, 25},
+			wantLength: []int{25, 75},
+		},
+		{
+			name:       "span grows last auto track",
+			tracks:     []GridTrack{TrackAuto(), TrackAuto()},
+			needs:      []_TrackNeed{{0, 1, 20}, {1, 1, 10}, {0, 2, 50}},
+			total:      100,
+			spacing:    4,
+			wantPos:    []int{0, 24},
+			wantLength: []int{20, 26},
+		},
+		{
+			name:       "span over star does not grow auto",
+			tracks:     []GridTrack{TrackAuto(), TrackStar(1)},
+			needs:      []_TrackNeed{{0, 2, 500}},
+			total:      100,
+			wantPos:    []int{0, 0},
+			wantLength: []int{0, 100},
+		},
+		{
+			name:       "stars by weight",
+			tracks:     []GridTrack{TrackStar(1), TrackStar(2)},
+			total:      90,
+			wantPos:    []int{0, 30},
+			wantLength: []int{30, 60},
+		},
+		{
+			name:       "star leftover pixels from rounding",
+			tracks:     []GridTrack{TrackStar(1), TrackStar(1), TrackStar(1)},
+			total:      100,
+			wantPos:    []int{0, 34, 67},
+			wantLength: []int{34, 33, 33},
+		},
+		{
+			name:       "star share above max",
+			tracks:     []GridTrack{TrackStar(1).Max(20), TrackStar(1)},
+			total:      100,
+			wantPos:    []int{0, 20},
+			wantLength: []int{20, 80},
+		},
+		{
+			name:       "star share below min",
+			tracks:     []GridTrack{TrackStar(1).Min(70), TrackStar(1)},
+			total:      100,
+			wantPos:    []int{0, 70},
+			wantLength: []int{70, 30},
+		},
+		{
+			name:       "star gets nothing when fixed overflows",
+			tracks:     []GridTrack{TrackFixed(80), TrackStar(1)},
+			total:      50,
+			wantPos:    []int{0, 80},
+			wantLength: []int{80, 0},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pos, length := solveTracks(tc.tracks, tc.needs,
+				tc.total, tc.padStart, tc.padEnd, tc.spacing)
+			if !reflect.DeepEqual(pos, tc.wantPos) {
+				t.Errorf("pos = %v, want %v", pos, tc.wantPos)
+			}
+			if !reflect.DeepEqual(length, tc.wantLength) {
+				t.Errorf("length = %v, want %v", length, tc.wantLength)
+			}
+		})
+	}
+}
+
+func TestDistributeStars(t *testing.T) {
+	tests := []struct {
+		name      string
+		tracks    []GridTrack
+		length    []int
+		remaining int
+		want      []int
+	}{
+		{
+			name:      "non-star tracks untouched",
+			tracks:    []GridTrack{TrackFixed(10), TrackStar(1), TrackStar(3)},
+			length:    []int{10, 0, 0},
+			remaining: 10,
+			want:      []int{10, 3, 7},
+		},
+		{
+			name:      "clamped track leaves the pool",
+			tracks:    []GridTrack{TrackStar(1).Max(10), TrackStar(1), TrackStar(1)},
+			length:    []int{0, 0, 0},
+			remaining: 91,
+			want:      []int{10, 41, 40},
+		},
+		{
+			name:      "nothing remaining",
+			tracks:    []GridTrack{TrackStar(1), TrackStar(1)},
+			length:    []int{0, 0},
+			remaining: 0,
+			want:      []int{0, 0},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			distributeStars(tc.tracks, tc.length, tc.remaining)
+			if !reflect.DeepEqual(tc.length, tc.want) {
+				t.Errorf("length = %v, want %v", tc.length, tc.want)
+			}
+		})
+	}
+}
+
+func TestAlignInCell(t *testing.T) {
+	tests := []struct {
+		name                       string
+		start, avail, pref, mn, mx int
+		align                      ALIGN
+		wantPos, wantSize          int
+	}{
+		{"stretch", 10, 100, 30, 0, 0, ALIGN_STRETCH, 10, 100},
+		{"stretch clamped by max", 10, 100, 30, 0, 60, ALIGN_STRETCH, 10, 60},
+		{"start", 10, 100, 30, 0, 0, ALIGN_START, 10, 30},
+		{"center", 10, 100, 30, 0, 0, ALIGN_CENTER, 45, 30},
+		{"end", 10, 100, 30, 0, 0, ALIGN_END, 80, 30},
+		{"pref larger than cell", 10, 20, 30, 0, 0, ALIGN_START, 10, 20},
+		{"min larger than cell", 10, 20, 30, 25, 0, ALIGN_START, 10, 25},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pos, size := alignInCell(tc.start, tc.avail, tc.pref, tc.mn, tc.mx, tc.align)
+			if pos != tc.wantPos || size != tc.wantSize {
+				t.Errorf("got (%d, %d), want (%d, %d)", pos, size, tc.wantPos, tc.wantSize)
+			}
+		})
+	}
+}
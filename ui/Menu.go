@@ -0,0 +1,553 @@
+//go:build windows
+
+package ui
+
+import (
+	"fmt"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// Declarative menu, written as a tree of [MenuItem] objects, which builds the
+// underlying [HMENU] and the matching accelerator table.
+//
+// Each item runs its own callback, wired to the window with [Menu.Bind]
+// through [EventsWindow.WmCommandAccelMenu]. The enabled and checked states are
+// refreshed right before a popup menu is shown, on [WM_INITMENUPOPUP].
+//
+// # Example
+//
+//	var wrap *ui.MenuItem
+//
+//	menu := ui.NewMenuBar(
+//		ui.MenuPopup("&File",
+//			ui.MenuCmd("&Open...", func() { openFile() }).
+//				Accel(co.ACCELF_CONTROL, co.VK_O),
+//			ui.MenuCmd("&Save", func() { saveFile() }).
+//				Accel(co.ACCELF_CONTROL, co.VK_S).
+//				EnabledWhen(func() bool { return isModified() }),
+//			ui.MenuSeparator(),
+//			ui.MenuCmd("E&xit", func() { closeApp() }),
+//		),
+//		ui.MenuPopup("&View",
+//			ui.MenuCheck("&Word wrap", func() { setWordWrap(wrap.IsChecked()) }).
+//				Assign(&wrap),
+//			ui.MenuSeparator(),
+//			ui.MenuRadio("&Small icons", func() { setIconSize(16) }).SetChecked(true),
+//			ui.MenuRadio("&Large icons", func() { setIconSize(32) }),
+//		),
+//	)
+//	defer menu.Destroy()
+//
+//	wnd := ui.NewMain(
+//		ui.OptsMain().
+//			Menu(menu.HMenu()).
+//			AccelTable(menu.HAccel()),
+//	)
+//	menu.Bind(wnd)
+//
+// [HMENU]: https://learn.microsoft.com/en-us/windows/win32/menurc/menus
+// [WM_INITMENUPOPUP]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-initmenupopup
+type Menu struct {
+	hMenu  win.HMENU
+	hAccel win.HACCEL
+	popup  bool
+	items  []*MenuItem               // top-level items
+	popups map[win.HMENU][]*MenuItem // items of each menu, including the top-level one
+}
+
+// Creates a new menu bar with [CreateMenu], to be passed to
+// [VarOptsMain.Menu].
+//
+// The menu bar is destroyed by its window; the accelerator table must be
+// released with [Menu.Destroy].
+//
+// Panics on error.
+//
+// [CreateMenu]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createmenu
+func NewMenuBar(items ...*MenuItem) *Menu {
+	hMenu, err := win.CreateMenu()
+	if err != nil {
+		panic(err)
+	}
+	return newMenu(hMenu, false, items)
+}
+
+// Creates a new popup menu with [CreatePopupMenu], to be shown with
+// [Menu.ShowAtPoint], usually as a context menu.
+//
+// ⚠️ You must defer [Menu.Destroy].
+//
+// Panics on error.
+//
+// [CreatePopupMenu]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createpopupmenu
+func NewMenuPopup(items ...*MenuItem) *Menu {
+	hMenu, err := win.CreatePopupMenu()
+	if err != nil {
+		panic(err)
+	}
+	return newMenu(hMenu, true, items)
+}
+
+func newMenu(hMenu win.HMENU, popup bool, items []*MenuItem) *Menu {
+	me := &Menu{
+		hMenu:  hMenu,
+		popup:  popup,
+		items:  items,
+		popups: make(map[win.HMENU][]*MenuItem),
+	}
+	me.build(hMenu, items)
+
+	accels := make([]win.ACCEL, 0)
+	me.eachCmd(func(item *MenuItem) {
+		if item.accel.Key != 0 {
+			accels = append(accels, item.accel)
+		}
+	})
+	if len(accels) > 0 {
+		var err error
+		if me.hAccel, err = win.CreateAcceleratorTable(accels); err != nil {
+			panic(err)
+		}
+	}
+	return me
+}
+
+// Inserts the items into the menu, recursively creating the submenus.
+func (me *Menu) build(hMenu win.HMENU, items []*MenuItem) {
+	me.popups[hMenu] = items
+
+	for i, item := range items {
+		if item.owner != nil {
+			panic(fmt.Sprintf("Menu item \"%s\" added twice.", item.text))
+		}
+		item.owner = me
+		item.hMenu = hMenu
+		item.index = uint(i)
+
+		if item.kind == _MENUITEM_POPUP {
+			var err error
+			if item.hSubMenu, err = win.CreatePopupMenu(); err != nil {
+				panic(err)
+			}
+			me.build(item.hSubMenu, item.subItems)
+		} else if item.kind != _MENUITEM_SEPARATOR {
+			setUniqueCtrlId(&item.cmdId)
+			if item.accel.Key != 0 {
+				item.accel.Cmd = item.cmdId
+			}
+		}
+
+		var mii win.MENUITEMINFO
+		mii.SetCbSize()
+		mii.FMask = co.MIIM_FTYPE | co.MIIM_STATE
+		mii.FType = item.fType()
+		mii.FState = item.fState()
+
+		if item.kind != _MENUITEM_SEPARATOR {
+			mii.FMask |= co.MIIM_STRING
+			mii.DwTypeData = wstr.EncodeToPtr(item.fullText())
+		}
+		if item.kind == _MENUITEM_POPUP {
+			mii.FMask |= co.MIIM_SUBMENU
+			mii.HSubMenu = item.hSubMenu
+		} else {
+			mii.FMask |= co.MIIM_ID
+			mii.WId = uint32(item.cmdId)
+		}
+		if item.hBmp != 0 {
+			mii.FMask |= co.MIIM_BITMAP
+			mii.HBmpItem = item.hBmp
+		}
+
+		if err := hMenu.InsertMenuItemByPos(uint(i), &mii); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Calls the function for each command item, recursively.
+func (me *Menu) eachCmd(fun func(item *MenuItem)) {
+	var walk func(items []*MenuItem)
+	walk = func(items []*MenuItem) {
+		for _, item := range items {
+			switch item.kind {
+			case _MENUITEM_POPUP:
+				walk(item.subItems)
+			case _MENUITEM_SEPARATOR:
+			default:
+				fun(item)
+			}
+		}
+	}
+	walk(me.items)
+}
+
+// Wires the commands of the menu items to the given window, with
+// [EventsWindow.WmCommandAccelMenu], and refreshes the item states on
+// [WM_INITMENUPOPUP].
+//
+// A popup menu may be bound to more than one window.
+//
+// [WM_INITMENUPOPUP]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-initmenupopup
+func (me *Menu) Bind(parent Parent) {
+	events := &parent.base().beforeUserEvents
+
+	me.eachCmd(func(item *MenuItem) {
+		events.WmCommandAccelMenu(item.cmdId, func() {
+			item.click()
+		})
+	})
+
+	events.WmInitMenuPopup(func(p WmInitMenuPopup) {
+		if items, ok := me.popups[p.HMenu()]; ok {
+			for _, item := range items {
+				item.refresh()
+			}
+		}
+	})
+}
+
+// Releases the accelerator table with [HACCEL.DestroyAcceleratorTable]. If
+// this is a popup menu, the menu itself is also destroyed with
+// [HMENU.DestroyMenu].
+//
+// A menu bar is destroyed by the window it's attached to, so it's not touched.
+func (me *Menu) Destroy() {
+	if me.hAccel != 0 {
+		me.hAccel.DestroyAcceleratorTable()
+		me.hAccel = win.HACCEL(0)
+	}
+	if me.popup && me.hMenu != 0 {
+		me.hMenu.DestroyMenu()
+		me.hMenu = win.HMENU(0)
+	}
+}
+
+// Returns the accelerator table built with [CreateAcceleratorTable] from the
+// items which have an accelerator, or zero if none has.
+//
+// [CreateAcceleratorTable]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createacceleratortablew
+func (me *Menu) HAccel() win.HACCEL {
+	return me.hAccel
+}
+
+// Returns the underlying HMENU handle.
+func (me *Menu) HMenu() win.HMENU {
+	return me.hMenu
+}
+
+// Returns the command item with the given command ID, searching recursively,
+// or nil if not found.
+func (me *Menu) Item(cmdId uint16) *MenuItem {
+	var found *MenuItem
+	me.eachCmd(func(item *MenuItem) {
+		if found == nil && item.cmdId == cmdId {
+			found = item
+		}
+	})
+	return found
+}
+
+// Returns the top-level items, in order.
+func (me *Menu) Items() []*MenuItem {
+	return me.items
+}
+
+// Shows the popup menu anchored at the given coordinates, with
+// [HMENU.ShowAtPoint]. The window must have been passed to [Menu.Bind].
+//
+// If hCoordsRelativeTo is zero, coordinates must be relative to hParent.
+//
+// This method will block until the menu disappears.
+//
+// Panics if this is not a popup menu.
+func (me *Menu) ShowAtPoint(pos win.POINT, hParent, hCoordsRelativeTo win.HWND) {
+	if !me.popup {
+		panic("Only popup menus can be shown at a point.")
+	}
+	me.hMenu.ShowAtPoint(pos, hParent, hCoordsRelativeTo)
+}
+
+type _MENUITEM uint8
+
+const (
+	_MENUITEM_CMD _MENUITEM = iota
+	_MENUITEM_CHECK
+	_MENUITEM_RADIO
+	_MENUITEM_SEPARATOR
+	_MENUITEM_POPUP
+)
+
+// An item of a [Menu]: a command, a check item, a radio item, a separator or
+// a submenu.
+//
+// The item is configured with chained methods, before being passed to
+// [NewMenuBar], [NewMenuPopup] or [MenuPopup].
+type MenuItem struct {
+	kind        _MENUITEM
+	text        string
+	cmdId       uint16
+	accel       win.ACCEL
+	hBmp        win.HBITMAP
+	enabled     bool
+	checked     bool
+	fun         func()
+	enabledWhen func() bool
+	checkedWhen func() bool
+	subItems    []*MenuItem
+
+	owner    *Menu
+	hMenu    win.HMENU // menu which contains the item
+	index    uint      // position within hMenu
+	hSubMenu win.HMENU // if a popup
+}
+
+func newMenuItem(kind _MENUITEM, text string, fun func()) *MenuItem {
+	return &MenuItem{
+		kind:    kind,
+		text:    text,
+		enabled: true,
+		fun:     fun,
+	}
+}
+
+// Creates a command item, which runs the function when clicked.
+func MenuCmd(text string, fun func()) *MenuItem {
+	return newMenuItem(_MENUITEM_CMD, text, fun)
+}
+
+// Creates a check item, which toggles its checked state when clicked, right
+// before running the function.
+func MenuCheck(text string, fun func()) *MenuItem {
+	return newMenuItem(_MENUITEM_CHECK, text, fun)
+}
+
+// Creates a radio item, shown with a bullet. Adjacent radio items form a
+// group, delimited by any other kind of item: when clicked, the item is
+// checked and the others in its group are unchecked, right before running the
+// function.
+func MenuRadio(text string, fun func()) *MenuItem {
+	return newMenuItem(_MENUITEM_RADIO, text, fun)
+}
+
+// Creates a separator.
+func MenuSeparator() *MenuItem {
+	return newMenuItem(_MENUITEM_SEPARATOR, "", nil)
+}
+
+// Creates an item which opens a submenu with the given items.
+func MenuPopup(text string, items ...*MenuItem) *MenuItem {
+	me := newMenuItem(_MENUITEM_POPUP, text, nil)
+	me.subItems = items
+	return me
+}
+
+// Sets the accelerator of a command, check or radio item. The key combination
+// is appended to the item text, formatted with [Shortcut.String], and it will
+// be included in [Menu.HAccel].
+//
+// Must be called before the menu is built.
+//
+// # Example
+//
+//	ui.MenuCmd("&Save", func() {}).
+//		Accel(co.ACCELF_CONTROL, co.VK_S)
+func (me *MenuItem) Accel(modifiers co.ACCELF, key co.VK) *MenuItem {
+	me.accel = win.ACCEL{
+		FVirt: modifiers | co.ACCELF_VIRTKEY,
+		Key:   key,
+	}
+	return me
+}
+
+// Stores the pointer to the item in the given variable, so it can be used
+// later, while the menu tree is still being declared.
+func (me *MenuItem) Assign(dest **MenuItem) *MenuItem {
+	*dest = me
+	return me
+}
+
+// Sets the bitmap shown beside the item text. For proper transparency, it
+// should be a 32-bit bitmap with premultiplied alpha.
+//
+// The bitmap is not destroyed automatically.
+//
+// Must be called before the menu is built.
+func (me *MenuItem) Bitmap(hBmp win.HBITMAP) *MenuItem {
+	me.hBmp = hBmp
+	return me
+}
+
+// Sets a function to compute whether a check or radio item is checked, called
+// right before the item is shown.
+func (me *MenuItem) CheckedWhen(fun func() bool) *MenuItem {
+	me.checkedWhen = fun
+	return me
+}
+
+// Sets a function to compute whether the item is enabled, called right before
+// the item is shown, and also before an accelerator runs the command.
+func (me *MenuItem) EnabledWhen(fun func() bool) *MenuItem {
+	me.enabledWhen = fun
+	return me
+}
+
+// Sets the command ID of the item, which otherwise is automatically assigned.
+// Useful when the command must also be handled elsewhere, like in a toolbar.
+//
+// Must be called before the menu is built.
+func (me *MenuItem) Id(cmdId uint16) *MenuItem {
+	me.cmdId = cmdId
+	return me
+}
+
+// Returns the command ID of the item, or zero if it's a separator or a
+// submenu.
+func (me *MenuItem) CmdId() uint16 {
+	return me.cmdId
+}
+
+// Returns whether the item is checked.
+func (me *MenuItem) IsChecked() bool {
+	return me.checked
+}
+
+// Returns whether the item is enabled.
+func (me *MenuItem) IsEnabled() bool {
+	return me.enabled
+}
+
+// Returns the submenu items, if this item is a submenu.
+func (me *MenuItem) SubItems() []*MenuItem {
+	return me.subItems
+}
+
+// Returns the text of the item, without the accelerator.
+func (me *MenuItem) Text() string {
+	return me.text
+}
+
+// Sets the checked state of the item. If it's a radio item, the others in its
+// group are unchecked.
+func (me *MenuItem) SetChecked(checked bool) *MenuItem {
+	if checked && me.kind == _MENUITEM_RADIO && me.owner != nil {
+		for _, sibling := range me.radioGroup() {
+			if sibling != me && sibling.checked {
+				sibling.checked = false
+				sibling.updateState()
+			}
+		}
+	}
+	me.checked = checked
+	me.updateState()
+	return me
+}
+
+// Sets the enabled state of the item.
+func (me *MenuItem) SetEnabled(enabled bool) *MenuItem {
+	me.enabled = enabled
+	me.updateState()
+	return me
+}
+
+// Sets the text of the item, without the accelerator.
+func (me *MenuItem) SetText(text string) *MenuItem {
+	me.text = text
+	if me.owner != nil {
+		var mii win.MENUITEMINFO
+		mii.SetCbSize()
+		mii.FMask = co.MIIM_STRING
+		mii.DwTypeData = wstr.EncodeToPtr(me.fullText())
+		me.hMenu.SetMenuItemInfoByPos(me.index, &mii)
+	}
+	return me
+}
+
+// Runs the item command, if enabled.
+func (me *MenuItem) click() {
+	if me.enabledWhen != nil {
+		me.enabled = me.enabledWhen()
+	}
+	if !me.enabled {
+		return
+	}
+
+	switch me.kind {
+	case _MENUITEM_CHECK:
+		me.SetChecked(!me.checked)
+	case _MENUITEM_RADIO:
+		me.SetChecked(true)
+	}
+	if me.fun != nil {
+		me.fun()
+	}
+}
+
+// Evaluates the state functions, and updates the item.
+func (me *MenuItem) refresh() {
+	if me.enabledWhen != nil {
+		me.enabled = me.enabledWhen()
+	}
+	if me.checkedWhen != nil {
+		me.SetChecked(me.checkedWhen())
+	} else {
+		me.updateState()
+	}
+}
+
+// Returns the adjacent radio items, including this one.
+func (me *MenuItem) radioGroup() []*MenuItem {
+	siblings := me.owner.popups[me.hMenu]
+	first, last := int(me.index), int(me.index)
+	for first > 0 && siblings[first-1].kind == _MENUITEM_RADIO {
+		first--
+	}
+	for last < len(siblings)-1 && siblings[last+1].kind == _MENUITEM_RADIO {
+		last++
+	}
+	return siblings[first : last+1]
+}
+
+// Writes the enabled and checked states into the menu, if already built.
+func (me *MenuItem) updateState() {
+	if me.owner != nil {
+		var mii win.MENUITEMINFO
+		mii.SetCbSize()
+		mii.FMask = co.MIIM_STATE
+		mii.FState = me.fState()
+		me.hMenu.SetMenuItemInfoByPos(me.index, &mii)
+	}
+}
+
+func (me *MenuItem) fType() co.MFT {
+	switch me.kind {
+	case _MENUITEM_SEPARATOR:
+		return co.MFT_SEPARATOR
+	case _MENUITEM_RADIO:
+		return co.MFT_STRING | co.MFT_RADIOCHECK
+	default:
+		return co.MFT_STRING
+	}
+}
+
+func (me *MenuItem) fState() co.MFS {
+	state := co.MFS_ENABLED
+	if !me.enabled {
+		state = co.MFS_GRAYED
+	}
+	if me.checked {
+		state |= co.MFS_CHECKED
+	}
+	return state
+}
+
+// Returns the text with the accelerator appended after a tab.
+func (me *MenuItem) fullText() string {
+	if me.accel.Key == 0 {
+		return me.text
+	}
+	return me.text + "\t" + ShortcutFromAccel(me.accel).String()
+}
@@ -0,0 +1,58 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Converts an [ACCEL] struct into a Shortcut. The ACCEL must have the
+// co.ACCELF_VIRTKEY flag.
+//
+// [ACCEL]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-accel
+func ShortcutFromAccel(accel win.ACCEL) Shortcut {
+	return Shortcut{
+		Modifiers: accel.FVirt & _ACCELF_MODIFIERS,
+		Key:       accel.Key,
+	}
+}
+
+// Returns an [ACCEL] struct for the shortcut, to be passed to
+// [win.CreateAcceleratorTable].
+//
+// [ACCEL]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-accel
+func (s Shortcut) Accel(cmdId uint16) win.ACCEL {
+	return win.ACCEL{
+		FVirt: (s.Modifiers & _ACCELF_MODIFIERS) | co.ACCELF_VIRTKEY,
+		Key:   s.Key,
+		Cmd:   cmdId,
+	}
+}
+
+const _ACCELF_MODIFIERS = co.ACCELF_CONTROL | co.ACCELF_SHIFT | co.ACCELF_ALT
+
+// Finds the shortcuts which are assigned to more than one command within an
+// accelerator table. Entries repeating the same shortcut and command are not
+// considered conflicts. Entries without the co.ACCELF_VIRTKEY flag are ignored.
+//
+// The conflicts are returned in the order they first appear in the table.
+//
+// # Example
+//
+//	accels := []win.ACCEL{
+//		{FVirt: co.ACCELF_VIRTKEY | co.ACCELF_CONTROL, Key: co.VK_S, Cmd: ID_SAVE},
+//		{FVirt: co.ACCELF_VIRTKEY | co.ACCELF_CONTROL, Key: co.VK_S, Cmd: ID_SEARCH},
+//	}
+//	for _, c := range ui.FindShortcutConflicts(accels) {
+//		println(c.Shortcut.String(), len(c.CmdIds)) // "Ctrl+S 2"
+//	}
+func FindShortcutConflicts(accels []win.ACCEL) []ShortcutConflict {
+	entries := make([]_ShortcutCmd, 0, len(accels))
+	for _, accel := range accels {
+		if (accel.FVirt & co.ACCELF_VIRTKEY) != 0 {
+			entries = append(entries, _ShortcutCmd{ShortcutFromAccel(accel), accel.Cmd})
+		}
+	}
+	return findShortcutConflicts(entries)
+}
@@ -0,0 +1,417 @@
+package ui
+
+// This file has no knowledge of windows, so the shortcut parsing and
+// formatting can be tested on any OS.
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// A keyboard shortcut, which can be parsed from and formatted to strings like
+// "Ctrl+Shift+S", "Alt+F4" and "Ctrl+Num +".
+//
+// Strings are parsed and formatted with the default English key names; for
+// other languages, see [ShortcutNames].
+//
+// # Example
+//
+//	sc, err := ui.ParseShortcut("Ctrl+Shift+S")
+//	if err != nil {
+//		panic(err)
+//	}
+//	accel := sc.Accel(ID_SAVE_AS)
+//	println(sc.String()) // "Ctrl+Shift+S"
+type Shortcut struct {
+	Modifiers co.ACCELF // Combination of co.ACCELF_CONTROL, co.ACCELF_SHIFT and co.ACCELF_ALT.
+	Key       co.VK
+}
+
+// Parses a string like "Ctrl+Shift+S" with the default English key names.
+//
+// The names are case-insensitive, and common aliases like "Control", "Escape"
+// and "PageUp" are also accepted.
+func ParseShortcut(text string) (Shortcut, error) {
+	return _shortcutNamesDefault.Parse(text)
+}
+
+// Returns true if no key is set.
+func (s Shortcut) IsEmpty() bool {
+	return s.Key == 0
+}
+
+// Formats the shortcut with the default English key names, like
+// "Ctrl+Shift+S".
+//
+// Implements [fmt.Stringer].
+func (s Shortcut) String() string {
+	return _shortcutNamesDefault.Format(s)
+}
+
+// Key names used to parse and format a [Shortcut], which can be translated.
+//
+// The names must be set before the first call to [ShortcutNames.Parse], which
+// builds and caches the lookup table of the key names.
+//
+// # Example
+//
+//	names := ui.DefaultShortcutNames()
+//	names.Ctrl = "Strg"
+//	names.Shift = "Umschalt"
+//	names.Keys[co.VK_DELETE] = "Entf"
+//
+//	sc, _ := names.Parse("Strg+Umschalt+Entf")
+//	println(names.Format(sc)) // "Strg+Umschalt+Entf"
+//	println(sc.String())      // "Ctrl+Shift+Del"
+type ShortcutNames struct {
+	Ctrl  string
+	Shift string
+	Alt   string
+	Keys  map[co.VK]string
+
+	reverseOnce sync.Once
+	reverse     map[string]co.VK // upper case names, built by reverseKeys
+}
+
+// Returns a new copy of the default English key names, which can be modified.
+func DefaultShortcutNames() *ShortcutNames {
+	keys := make(map[co.VK]string, len(_shortcutNamesDefault.Keys))
+	for vk, name := range _shortcutNamesDefault.Keys {
+		keys[vk] = name
+	}
+	return &ShortcutNames{
+		Ctrl:  _shortcutNamesDefault.Ctrl,
+		Shift: _shortcutNamesDefault.Shift,
+		Alt:   _shortcutNamesDefault.Alt,
+		Keys:  keys,
+	}
+}
+
+var _shortcutNamesDefault = newShortcutNamesDefault()
+
+func newShortcutNamesDefault() *ShortcutNames {
+	keys := map[co.VK]string{
+		co.VK_BACK:       "Backspace",
+		co.VK_TAB:        "Tab",
+		co.VK_RETURN:     "Enter",
+		co.VK_PAUSE:      "Pause",
+		co.VK_ESCAPE:     "Esc",
+		co.VK_SPACE:      "Space",
+		co.VK_PRIOR:      "PgUp",
+		co.VK_NEXT:       "PgDn",
+		co.VK_END:        "End",
+		co.VK_HOME:       "Home",
+		co.VK_LEFT:       "Left",
+		co.VK_UP:         "Up",
+		co.VK_RIGHT:      "Right",
+		co.VK_DOWN:       "Down",
+		co.VK_SNAPSHOT:   "PrtSc",
+		co.VK_INSERT:     "Ins",
+		co.VK_DELETE:     "Del",
+		co.VK_APPS:       "Menu",
+		co.VK_MULTIPLY:   "Num *",
+		co.VK_ADD:        "Num +",
+		co.VK_SUBTRACT:   "Num -",
+		co.VK_DECIMAL:    "Num .",
+		co.VK_DIVIDE:     "Num /",
+		co.VK_OEM_1:      ";",
+		co.VK_OEM_PLUS:   "+",
+		co.VK_OEM_COMMA:  ",",
+		co.VK_OEM_MINUS:  "-",
+		co.VK_OEM_PERIOD: ".",
+		co.VK_OEM_2:      "/",
+		co.VK_OEM_3:      "`",
+		co.VK_OEM_4:      "[",
+		co.VK_OEM_5:      "\\",
+		co.VK_OEM_6:      "]",
+		co.VK_OEM_7:      "'",
+	}
+	for vk := co.VK_0; vk <= co.VK_9; vk++ {
+		keys[vk] = string(rune(vk))
+	}
+	for vk := co.VK_A; vk <= co.VK_Z; vk++ {
+		keys[vk] = string(rune(vk))
+	}
+	for vk := co.VK_NUMPAD0; vk <= co.VK_NUMPAD9; vk++ {
+		keys[vk] = fmt.Sprintf("Num %d", vk-co.VK_NUMPAD0)
+	}
+	for vk := co.VK_F1; vk <= co.VK_F24; vk++ {
+		keys[vk] = fmt.Sprintf("F%d", vk-co.VK_F1+1)
+	}
+
+	return &ShortcutNames{
+		Ctrl:  "Ctrl",
+		Shift: "Shift",
+		Alt:   "Alt",
+		Keys:  keys,
+	}
+}
+
+// Alternative names accepted by the parser, besides the default ones.
+var _shortcutAliases = map[string]co.VK{
+	"backspace": co.VK_BACK,
+	"bksp":      co.VK_BACK,
+	"return":    co.VK_RETURN,
+	"escape":    co.VK_ESCAPE,
+	"pageup":    co.VK_PRIOR,
+	"page up":   co.VK_PRIOR,
+	"pagedown":  co.VK_NEXT,
+	"page down": co.VK_NEXT,
+	"pgdown":    co.VK_NEXT,
+	"insert":    co.VK_INSERT,
+	"delete":    co.VK_DELETE,
+	"plus":      co.VK_OEM_PLUS,
+	"minus":     co.VK_OEM_MINUS,
+	"comma":     co.VK_OEM_COMMA,
+	"period":    co.VK_OEM_PERIOD,
+}
+
+// Formats the shortcut, like "Ctrl+Shift+S". Modifiers are always written in
+// the Ctrl, Shift, Alt order. Keys without a name are written as hexadecimal
+// numbers, like "0xE2".
+//
+// Returns an empty string if the shortcut has no key.
+func (me *ShortcutNames) Format(s Shortcut) string {
+	if s.IsEmpty() {
+		return ""
+	}
+
+	keyName, ok := me.Keys[s.Key]
+	if !ok {
+		keyName = fmt.Sprintf("0x%02X", uint16(s.Key))
+	}
+	return joinShortcut(shortcutMods(s.Modifiers),
+		[3]string{me.Ctrl, me.Shift, me.Alt}, keyName)
+}
+
+// Parses a string like "Ctrl+Shift+S". Modifiers can be written in any order,
+// and the key must come last. Since "+" is also a key, "Ctrl++" and "Ctrl+Num +"
+// are valid.
+//
+// The names are case-insensitive. The default English names and their common
+// aliases are accepted as well, as are hexadecimal key codes like "0xE2". If
+// two keys have the same name, the one with the lowest virtual key code is
+// chosen.
+func (me *ShortcutNames) Parse(text string) (Shortcut, error) {
+	mods, keyName, err := splitShortcut(text, [3][]string{
+		_SC_CTRL:  {me.Ctrl, _shortcutNamesDefault.Ctrl, "Control"},
+		_SC_SHIFT: {me.Shift, _shortcutNamesDefault.Shift},
+		_SC_ALT:   {me.Alt, _shortcutNamesDefault.Alt},
+	})
+	if err != nil {
+		return Shortcut{}, err
+	}
+
+	vk, ok := me.lookupKey(keyName)
+	if !ok {
+		return Shortcut{}, fmt.Errorf("shortcut \"%s\" has an unknown key \"%s\"", text, keyName)
+	}
+	return Shortcut{Modifiers: shortcutAccelf(mods), Key: vk}, nil
+}
+
+// Finds the virtual key code of the key name. The names of this object take
+// precedence over the default ones.
+func (me *ShortcutNames) lookupKey(name string) (co.VK, bool) {
+	upperName := strings.ToUpper(name)
+	if vk, ok := me.reverseKeys()[upperName]; ok {
+		return vk, true
+	}
+	if me != _shortcutNamesDefault {
+		if vk, ok := _shortcutNamesDefault.reverseKeys()[upperName]; ok {
+			return vk, true
+		}
+	}
+
+	if vk, ok := _shortcutAliases[strings.ToLower(name)]; ok {
+		return vk, true
+	}
+	if code, ok := parseKeyCode(name); ok {
+		return co.VK(code), true
+	}
+	return 0, false
+}
+
+// Returns the upper case key names mapped to their codes, built on the first
+// call.
+func (me *ShortcutNames) reverseKeys() map[string]co.VK {
+	me.reverseOnce.Do(func() {
+		me.reverse = reverseKeyNames(me.Keys)
+	})
+	return me.reverse
+}
+
+// Converts co.ACCELF flags into the modifiers of splitShortcut.
+func shortcutMods(accelf co.ACCELF) [3]bool {
+	return [3]bool{
+		_SC_CTRL:  (accelf & co.ACCELF_CONTROL) != 0,
+		_SC_SHIFT: (accelf & co.ACCELF_SHIFT) != 0,
+		_SC_ALT:   (accelf & co.ACCELF_ALT) != 0,
+	}
+}
+
+// Converts the modifiers of splitShortcut into co.ACCELF flags.
+func shortcutAccelf(mods [3]bool) co.ACCELF {
+	var accelf co.ACCELF
+	for mod, flag := range [3]co.ACCELF{
+		_SC_CTRL:  co.ACCELF_CONTROL,
+		_SC_SHIFT: co.ACCELF_SHIFT,
+		_SC_ALT:   co.ACCELF_ALT,
+	} {
+		if mods[mod] {
+			accelf |= flag
+		}
+	}
+	return accelf
+}
+
+// Two or more commands sharing the same [Shortcut]; returned by
+// [FindShortcutConflicts].
+type ShortcutConflict struct {
+	Shortcut Shortcut
+	CmdIds   []uint16
+}
+
+// A shortcut and the command it triggers, an entry of an accelerator table.
+type _ShortcutCmd struct {
+	sc    Shortcut
+	cmdId uint16
+}
+
+// Implements [FindShortcutConflicts].
+func findShortcutConflicts(entries []_ShortcutCmd) []ShortcutConflict {
+	order := make([]Shortcut, 0, len(entries))
+	cmdsBySc := make(map[Shortcut][]uint16, len(entries))
+
+	for _, entry := range entries {
+		cmdIds, exists := cmdsBySc[entry.sc]
+		if !exists {
+			order = append(order, entry.sc)
+		}
+
+		repeated := false
+		for _, cmdId := range cmdIds {
+			if cmdId == entry.cmdId {
+				repeated = true
+				break
+			}
+		}
+		if !repeated {
+			cmdsBySc[entry.sc] = append(cmdIds, entry.cmdId)
+		}
+	}
+
+	conflicts := make([]ShortcutConflict, 0)
+	for _, sc := range order {
+		if cmdIds := cmdsBySc[sc]; len(cmdIds) > 1 {
+			conflicts = append(conflicts, ShortcutConflict{sc, cmdIds})
+		}
+	}
+	return conflicts
+}
+
+// Indexes of the modifiers in the arrays used by splitShortcut and
+// joinShortcut.
+const (
+	_SC_CTRL = iota
+	_SC_SHIFT
+	_SC_ALT
+)
+
+// Splits a string like "Ctrl+Shift+S" into its modifiers and the key name.
+// Each modifier accepts any of the given names, case-insensitive. Modifiers can
+// be written in any order, and the key must come last. Since "+" is also a key,
+// "Ctrl++" and "Ctrl+Num +" are valid.
+func splitShortcut(text string, modNames [3][]string) ([3]bool, string, error) {
+	var mods [3]bool
+	rest := strings.TrimSpace(text)
+	if rest == "" {
+		return mods, "", errors.New("empty shortcut")
+	}
+
+	for {
+		mod, after, found := cutModifier(rest, modNames)
+		if !found {
+			break
+		}
+		if mods[mod] {
+			return [3]bool{}, "", fmt.Errorf("shortcut \"%s\" has a repeated modifier", text)
+		}
+		mods[mod] = true
+		rest = after
+	}
+
+	if rest == "" {
+		return [3]bool{}, "", fmt.Errorf("shortcut \"%s\" has no key", text)
+	}
+	return mods, rest, nil
+}
+
+// If the text starts with a modifier followed by "+" and a key, returns the
+// index of the modifier and the remaining text.
+func cutModifier(text string, modNames [3][]string) (int, string, bool) {
+	plus := strings.IndexByte(text, '+')
+	if plus <= 0 || plus == len(text)-1 { // no "+", or "+" is the key itself
+		return 0, text, false
+	}
+
+	name := strings.TrimSpace(text[:plus])
+	for mod, names := range modNames {
+		for _, modName := range names {
+			if modName != "" && strings.EqualFold(name, modName) {
+				return mod, strings.TrimSpace(text[plus+1:]), true
+			}
+		}
+	}
+	return 0, text, false
+}
+
+// Joins the modifiers and the key name, like "Ctrl+Shift+S". Modifiers are
+// always written in the Ctrl, Shift, Alt order.
+func joinShortcut(mods [3]bool, modNames [3]string, key string) string {
+	var buf strings.Builder
+	for mod, isSet := range mods {
+		if isSet {
+			buf.WriteString(modNames[mod])
+			buf.WriteByte('+')
+		}
+	}
+	buf.WriteString(key)
+	return buf.String()
+}
+
+// Builds the reverse of a key name map, with upper case names, so the lookup is
+// case-insensitive. If two keys share the same name, the lowest key code wins,
+// so the result doesn't depend on the map iteration order. Empty names are
+// ignored.
+func reverseKeyNames[K ~uint16](keys map[K]string) map[string]K {
+	reverse := make(map[string]K, len(keys))
+	for code, name := range keys {
+		if name == "" {
+			continue
+		}
+		upperName := strings.ToUpper(name)
+		if prev, exists := reverse[upperName]; !exists || code < prev {
+			reverse[upperName] = code
+		}
+	}
+	return reverse
+}
+
+// Parses a hexadecimal key code like "0xE2", as written by
+// [ShortcutNames.Format] for keys without a name.
+func parseKeyCode(name string) (uint8, bool) {
+	lower := strings.ToLower(name)
+	if !strings.HasPrefix(lower, "0x") {
+		return 0, false
+	}
+	code, err := strconv.ParseUint(lower[2:], 16, 8)
+	if err != nil || code == 0 {
+		return 0, false
+	}
+	return uint8(code), true
+}
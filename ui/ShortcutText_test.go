@@ -0,0 +1,274 @@
+package ui
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+var _testModNames = [3][]string{
+	_SC_CTRL:  {"Ctrl", "Control"},
+	_SC_SHIFT: {"Shift"},
+	_SC_ALT:   {"Alt"},
+}
+
+func TestSplitShortcut(t *testing.T) {
+	tests := []struct {
+		text     string
+		wantMods [3]bool
+		wantKey  string
+		wantErr  bool
+	}{
+		{"S", [3]bool{}, "S", false},
+		{"Ctrl+S", [3]bool{true, false, false}, "S", false},
+		{"ctrl+shift+s", [3]bool{true, true, false}, "s", false},
+		{"Alt+Control+F4", [3]bool{true, false, true}, "F4", false},
+		{" Shift + Alt + Del ", [3]bool{false, true, true}, "Del", false},
+		{"Ctrl++", [3]bool{true, false, false}, "+", false},
+		{"+", [3]bool{}, "+", false},
+		{"Ctrl+Num +", [3]bool{true, false, false}, "Num +", false},
+		{"Ctrl+Shift", [3]bool{true, false, false}, "Shift", false},
+		{"Foo+S", [3]bool{}, "Foo+S", false},
+		{"", [3]bool{}, "", true},
+		{"   ", [3]bool{}, "", true},
+		{"Ctrl+Control+S", [3]bool{}, "", true},
+		{"Ctrl+ ", [3]bool{}, "Ctrl+", false}, // trailing "+" is taken as part of the key
+	}
+
+	for _, tc := range tests {
+		mods, key, err := splitShortcut(tc.text, _testModNames)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("splitShortcut(%q) error = %v, want error %v", tc.text, err, tc.wantErr)
+			continue
+		}
+		if err == nil && (mods != tc.wantMods || key != tc.wantKey) {
+			t.Errorf("splitShortcut(%q) = %v, %q, want %v, %q",
+				tc.text, mods, key, tc.wantMods, tc.wantKey)
+		}
+	}
+}
+
+func TestJoinShortcut(t *testing.T) {
+	names := [3]string{"Strg", "Umschalt", "Alt"}
+	tests := []struct {
+		mods [3]bool
+		key  string
+		want string
+	}{
+		{[3]bool{}, "S", "S"},
+		{[3]bool{true, false, false}, "+", "Strg++"},
+		{[3]bool{false, true, true}, "Entf", "Umschalt+Alt+Entf"},
+		{[3]bool{true, true, true}, "F4", "Strg+Umschalt+Alt+F4"},
+	}
+
+	for _, tc := range tests {
+		if got := joinShortcut(tc.mods, names, tc.key); got != tc.want {
+			t.Errorf("joinShortcut(%v, %q) = %q, want %q", tc.mods, tc.key, got, tc.want)
+		}
+	}
+}
+
+func TestReverseKeyNames(t *testing.T) {
+	tests := []struct {
+		name string
+		keys map[uint16]string
+		want map[string]uint16
+	}{
+		{
+			name: "case-insensitive",
+			keys: map[uint16]string{0x2e: "Del", 0x41: "a"},
+			want: map[string]uint16{"DEL": 0x2e, "A": 0x41},
+		},
+		{
+			name: "lowest code wins on collision",
+			keys: map[uint16]string{0x70: "X", 0x10: "x", 0x40: "X"},
+			want: map[string]uint16{"X": 0x10},
+		},
+		{
+			name: "empty names ignored",
+			keys: map[uint16]string{0x01: "", 0x02: "B"},
+			want: map[string]uint16{"B": 0x02},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := 0; i < 20; i++ { // map iteration order is random
+				if got := reverseKeyNames(tc.keys); !reflect.DeepEqual(got, tc.want) {
+					t.Fatalf("reverseKeyNames() = %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseKeyCode(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   uint8
+		wantOk bool
+	}{
+		{"0xE2", 0xe2, true},
+		{"0x0d", 0x0d, true},
+		{"0X41", 0x41, true},
+		{"0x00", 0, false},
+		{"0x100", 0, false},
+		{"0x", 0, false},
+		{"E2", 0, false},
+		{"0xZZ", 0, false},
+	}
+
+	for _, tc := range tests {
+		got, ok := parseKeyCode(tc.name)
+		if got != tc.want || ok != tc.wantOk {
+			t.Errorf("parseKeyCode(%q) = %#x, %v, want %#x, %v", tc.name, got, ok, tc.want, tc.wantOk)
+		}
+	}
+}
+
+func TestParseShortcut(t *testing.T) {
+	const ctrl, shift, alt = co.ACCELF_CONTROL, co.ACCELF_SHIFT, co.ACCELF_ALT
+
+	tests := []struct {
+		text    string
+		want    Shortcut
+		wantErr bool
+	}{
+		{"S", Shortcut{0, co.VK_S}, false},
+		{"Ctrl+S", Shortcut{ctrl, co.VK_S}, false},
+		{"control+shift+s", Shortcut{ctrl | shift, co.VK_S}, false},
+		{"Alt+Shift+Ctrl+F4", Shortcut{ctrl | shift | alt, co.VK_F4}, false},
+		{"Ctrl+Num +", Shortcut{ctrl, co.VK_ADD}, false},
+		{"Ctrl++", Shortcut{ctrl, co.VK_OEM_PLUS}, false},
+		{"Ctrl+Plus", Shortcut{ctrl, co.VK_OEM_PLUS}, false},
+		{"Num 5", Shortcut{0, co.VK_NUMPAD5}, false},
+		{"F24", Shortcut{0, co.VK_F24}, false},
+		{"Escape", Shortcut{0, co.VK_ESCAPE}, false},
+		{"esc", Shortcut{0, co.VK_ESCAPE}, false},
+		{"Page Down", Shortcut{0, co.VK_NEXT}, false},
+		{"Shift+bksp", Shortcut{shift, co.VK_BACK}, false},
+		{"Shift+0xE2", Shortcut{shift, 0xe2}, false},
+		{"", Shortcut{}, true},
+		{"Ctrl+Foo", Shortcut{}, true},
+		{"Ctrl+Control+S", Shortcut{}, true},
+		{"Ctrl+ ", Shortcut{}, true},
+		{"Strg+S", Shortcut{}, true},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseShortcut(tc.text)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("ParseShortcut(%q) error = %v, want error %v", tc.text, err, tc.wantErr)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("ParseShortcut(%q) = %+v, want %+v", tc.text, got, tc.want)
+		}
+	}
+}
+
+func TestShortcutString(t *testing.T) {
+	tests := []struct {
+		sc   Shortcut
+		want string
+	}{
+		{Shortcut{}, ""},
+		{Shortcut{co.ACCELF_CONTROL, 0}, ""},
+		{Shortcut{co.ACCELF_CONTROL, co.VK_ADD}, "Ctrl+Num +"},
+		{Shortcut{co.ACCELF_ALT | co.ACCELF_SHIFT | co.ACCELF_CONTROL, co.VK_DELETE}, "Ctrl+Shift+Alt+Del"},
+		{Shortcut{co.ACCELF_ALT | co.ACCELF_SHIFT, co.VK_OEM_PLUS}, "Shift+Alt++"},
+		{Shortcut{co.ACCELF_CONTROL, 0xe2}, "Ctrl+0xE2"},
+	}
+
+	for _, tc := range tests {
+		if got := tc.sc.String(); got != tc.want {
+			t.Errorf("%+v.String() = %q, want %q", tc.sc, got, tc.want)
+		}
+	}
+}
+
+func TestShortcutRoundTrip(t *testing.T) {
+	for vk := range _shortcutNamesDefault.Keys {
+		for _, mods := range []co.ACCELF{0, co.ACCELF_CONTROL, co.ACCELF_CONTROL | co.ACCELF_SHIFT | co.ACCELF_ALT} {
+			sc := Shortcut{mods, vk}
+			got, err := ParseShortcut(sc.String())
+			if err != nil {
+				t.Errorf("ParseShortcut(%q): %v", sc.String(), err)
+			} else if got != sc {
+				t.Errorf("ParseShortcut(%q) = %+v, want %+v", sc.String(), got, sc)
+			}
+		}
+	}
+}
+
+func TestShortcutNamesLocalized(t *testing.T) {
+	names := DefaultShortcutNames()
+	names.Ctrl = "Strg"
+	names.Shift = "Umschalt"
+	names.Keys[co.VK_DELETE] = "Entf"
+	names.Keys[co.VK_Y] = "Z" // German keyboard layout
+	names.Keys[co.VK_Z] = "Y"
+
+	want := Shortcut{co.ACCELF_CONTROL | co.ACCELF_SHIFT, co.VK_DELETE}
+	for _, text := range []string{"Strg+Umschalt+Entf", "umschalt+strg+ENTF", "Ctrl+Shift+Del"} {
+		if got, err := names.Parse(text); err != nil || got != want {
+			t.Errorf("Parse(%q) = %+v, %v, want %+v", text, got, err, want)
+		}
+	}
+	if got := names.Format(want); got != "Strg+Umschalt+Entf" {
+		t.Errorf("Format() = %q", got)
+	}
+	if got := want.String(); got != "Ctrl+Shift+Del" {
+		t.Errorf("String() = %q", got)
+	}
+
+	if got, _ := names.Parse("Strg+Y"); got.Key != co.VK_Z { // own names take precedence
+		t.Errorf("Parse(\"Strg+Y\") = %+v, want VK_Z", got)
+	}
+	if got, _ := ParseShortcut("Ctrl+Y"); got.Key != co.VK_Y {
+		t.Errorf("default names were modified: %+v", got)
+	}
+	if _, err := ParseShortcut("Entf"); err == nil {
+		t.Error("default names were modified: \"Entf\" parsed")
+	}
+}
+
+func TestShortcutNamesCache(t *testing.T) {
+	names := DefaultShortcutNames()
+	first := reflect.ValueOf(names.reverseKeys()).Pointer()
+	if _, err := names.Parse("Ctrl+S"); err != nil {
+		t.Fatal(err)
+	}
+	if again := reflect.ValueOf(names.reverseKeys()).Pointer(); again != first {
+		t.Error("reverse key names were rebuilt")
+	}
+}
+
+func TestFindShortcutConflicts(t *testing.T) {
+	ctrlS := Shortcut{co.ACCELF_CONTROL, co.VK_S}
+	ctrlO := Shortcut{co.ACCELF_CONTROL, co.VK_O}
+	f1 := Shortcut{0, co.VK_F1}
+
+	got := findShortcutConflicts([]_ShortcutCmd{
+		{f1, 4},
+		{ctrlO, 2},
+		{ctrlS, 1},
+		{ctrlS, 3},
+		{ctrlS, 1}, // same command again
+		{f1, 4},
+		{Shortcut{co.ACCELF_CONTROL | co.ACCELF_SHIFT, co.VK_S}, 6},
+		{ctrlO, 5},
+	})
+	want := []ShortcutConflict{
+		{ctrlO, []uint16{2, 5}},
+		{ctrlS, []uint16{1, 3}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if got := findShortcutConflicts([]_ShortcutCmd{{ctrlS, 1}, {ctrlO, 1}}); len(got) != 0 {
+		t.Errorf("got %+v, want no conflicts", got)
+	}
+}
@@ -106,12 +106,6 @@ func (me *_BaseCtrl) assignDialog(parent Parent) {
 	me.installSubclass()
 }
 
-func (me *_BaseCtrl) panicIfAddingEventAfterCreated() {
-	if me.hWnd != 0 {
-		panic("Cannot add event handling after the control has been created.")
-	}
-}
-
 func (me *_BaseCtrl) installSubclass() {
 	if me.subclassEvents.hasMessage() {
 		subclassProcCallback()
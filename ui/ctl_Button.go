@@ -73,10 +73,7 @@ func NewButtonDlg(parent Parent, ctrlId uint16, layout LAY) *Button {
 }
 
 // Exposes all the control notifications the can be handled.
-//
-// Panics if called after the control has been created.
 func (me *Button) On() *EventsButton {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
@@ -192,8 +189,8 @@ type EventsButton struct {
 // [BCN_DROPDOWN] message handler.
 //
 // [BCN_DROPDOWN]: https://learn.microsoft.com/en-us/windows/win32/controls/bcn-dropdown
-func (me *EventsButton) BcnDropDown(fun func(p *win.NMBCDROPDOWN)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.BCN_DROPDOWN, func(p unsafe.Pointer) uintptr {
+func (me *EventsButton) BcnDropDown(fun func(p *win.NMBCDROPDOWN)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.BCN_DROPDOWN, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMBCDROPDOWN)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -202,8 +199,8 @@ func (me *EventsButton) BcnDropDown(fun func(p *win.NMBCDROPDOWN)) {
 // [BCN_HOTITEMCHANGE] message handler.
 //
 // [BCN_HOTITEMCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/bcn-hotitemchange
-func (me *EventsButton) BcnHotItemChange(fun func(p *win.NMBCHOTITEM)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.BCN_HOTITEMCHANGE, func(p unsafe.Pointer) uintptr {
+func (me *EventsButton) BcnHotItemChange(fun func(p *win.NMBCHOTITEM)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.BCN_HOTITEMCHANGE, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMBCHOTITEM)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -212,29 +209,29 @@ func (me *EventsButton) BcnHotItemChange(fun func(p *win.NMBCHOTITEM)) {
 // [BN_CLICKED] message handler.
 //
 // [BN_CLICKED]: https://learn.microsoft.com/en-us/windows/win32/controls/bn-clicked
-func (me *EventsButton) BnClicked(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.BN_CLICKED, fun)
+func (me *EventsButton) BnClicked(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.BN_CLICKED, fun)
 }
 
 // [BN_DBLCLK] message handler.
 //
 // [BN_DBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/bn-dblclk
-func (me *EventsButton) BnDblClk(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.BN_DBLCLK, fun)
+func (me *EventsButton) BnDblClk(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.BN_DBLCLK, fun)
 }
 
 // [BN_KILLFOCUS] message handler.
 //
 // [BN_KILLFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/bn-killfocus
-func (me *EventsButton) BnKillFocus(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.BN_KILLFOCUS, fun)
+func (me *EventsButton) BnKillFocus(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.BN_KILLFOCUS, fun)
 }
 
 // [BN_SETFOCUS] message handler.
 //
 // [BN_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/bn-setfocus
-func (me *EventsButton) BnSetFocus(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.BN_SETFOCUS, func() {
+func (me *EventsButton) BnSetFocus(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.BN_SETFOCUS, func() {
 		fun()
 	})
 }
@@ -242,8 +239,8 @@ func (me *EventsButton) BnSetFocus(fun func()) {
 // [NM_CUSTOMDRAW] message handler.
 //
 // [NM_CUSTOMDRAW]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-customdraw-button
-func (me *EventsButton) NmCustomDraw(fun func(p *win.NMCUSTOMDRAW) co.CDRF) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_CUSTOMDRAW, func(p unsafe.Pointer) uintptr {
+func (me *EventsButton) NmCustomDraw(fun func(p *win.NMCUSTOMDRAW) co.CDRF) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_CUSTOMDRAW, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMCUSTOMDRAW)(p)))
 	})
 }
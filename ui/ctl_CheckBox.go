@@ -77,10 +77,7 @@ func NewCheckBoxDlg(parent Parent, ctrlId uint16, layout LAY) *CheckBox {
 }
 
 // Exposes all the control notifications the can be handled.
-//
-// Panics if called after the control has been created.
 func (me *CheckBox) On() *EventsButton {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
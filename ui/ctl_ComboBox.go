@@ -12,8 +12,10 @@ import (
 // [combo box]: https://learn.microsoft.com/en-us/windows/win32/controls/about-combo-boxes
 type ComboBox struct {
 	_BaseCtrl
-	events EventsComboBox
-	Items  CollectionComboBoxItems // Methods to interact with the items collection.
+	events    EventsComboBox
+	itemsData map[uintptr]interface{} // data associated with each item; keyed by the uid stored with CB_SETITEMDATA
+	nextUid   uintptr                 // next uid to be stored with CB_SETITEMDATA; zero means no data
+	Items     CollectionComboBoxItems // Methods to interact with the items collection.
 }
 
 // Creates a new [ComboBox] with [win.CreateWindowEx].
@@ -34,6 +36,7 @@ func NewComboBox(parent Parent, opts *VarOptsComboBox) *ComboBox {
 	me := &ComboBox{
 		_BaseCtrl: newBaseCtrl(opts.ctrlId),
 		events:    EventsComboBox{opts.ctrlId, &parent.base().userEvents},
+		itemsData: make(map[uintptr]interface{}),
 	}
 	me.Items.owner = me
 
@@ -47,6 +50,7 @@ func NewComboBox(parent Parent, opts *VarOptsComboBox) *ComboBox {
 		return 0 // ignored
 	})
 
+	me.defaultMessageHandlers(parent)
 	return me
 }
 
@@ -65,6 +69,7 @@ func NewComboBoxDlg(parent Parent, ctrlId uint16, layout LAY) *ComboBox {
 	me := &ComboBox{
 		_BaseCtrl: newBaseCtrl(ctrlId),
 		events:    EventsComboBox{ctrlId, &parent.base().userEvents},
+		itemsData: make(map[uintptr]interface{}),
 	}
 	me.Items.owner = me
 
@@ -74,14 +79,22 @@ func NewComboBoxDlg(parent Parent, ctrlId uint16, layout LAY) *ComboBox {
 		return true // ignored
 	})
 
+	me.defaultMessageHandlers(parent)
 	return me
 }
 
+func (me *ComboBox) defaultMessageHandlers(parent Parent) {
+	// WM_DELETEITEM is sent for each deleted item with data, including when
+	// the combo box is destroyed.
+	parent.base().afterUserEvents.WmDeleteItem(func(p WmDeleteItem) {
+		if p.ControlId() == int(me.ctrlId) {
+			delete(me.itemsData, p.DeleteItemStruct().ItemData)
+		}
+	})
+}
+
 // Exposes all the control notifications the can be handled.
-//
-// Panics if called after the control has been created.
 func (me *ComboBox) On() *EventsComboBox {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
@@ -182,76 +195,76 @@ type EventsComboBox struct {
 // [CBN_CLOSEUP] message handler.
 //
 // [CBN_CLOSEUP]: https://learn.microsoft.com/en-us/windows/win32/controls/cbn-closeup
-func (me *EventsComboBox) CbnCloseUp(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.CBN_CLOSEUP, fun)
+func (me *EventsComboBox) CbnCloseUp(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.CBN_CLOSEUP, fun)
 }
 
 // [CBN_DBLCLK] message handler.
 //
 // [CBN_DBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/cbn-dblclk
-func (me *EventsComboBox) CbnDblClk(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.CBN_DBLCLK, fun)
+func (me *EventsComboBox) CbnDblClk(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.CBN_DBLCLK, fun)
 }
 
 // [CBN_DROPDOWN] message handler.
 //
 // [CBN_DROPDOWN]: https://learn.microsoft.com/en-us/windows/win32/controls/cbn-dropdown
-func (me *EventsComboBox) CbnDropDown(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.CBN_DROPDOWN, fun)
+func (me *EventsComboBox) CbnDropDown(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.CBN_DROPDOWN, fun)
 }
 
 // [CBN_EDITCHANGE] message handler.
 //
 // [CBN_EDITCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/cbn-editchange
-func (me *EventsComboBox) CbnEditChange(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.CBN_EDITCHANGE, fun)
+func (me *EventsComboBox) CbnEditChange(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.CBN_EDITCHANGE, fun)
 }
 
 // [CBN_EDITUPDATE] message handler.
 //
 // [CBN_EDITUPDATE]: https://learn.microsoft.com/en-us/windows/win32/controls/cbn-editupdate
-func (me *EventsComboBox) CbnEditUpdate(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.CBN_EDITUPDATE, fun)
+func (me *EventsComboBox) CbnEditUpdate(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.CBN_EDITUPDATE, fun)
 }
 
 // [CBN_ERRSPACE] message handler.
 //
 // [CBN_ERRSPACE]: https://learn.microsoft.com/en-us/windows/win32/controls/cbn-errspace
-func (me *EventsComboBox) CbnErrSpace(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.CBN_ERRSPACE, fun)
+func (me *EventsComboBox) CbnErrSpace(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.CBN_ERRSPACE, fun)
 }
 
 // [CBN_KILLFOCUS] message handler.
 //
 // [CBN_KILLFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/cbn-killfocus
-func (me *EventsComboBox) CbnKillFocus(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.CBN_KILLFOCUS, fun)
+func (me *EventsComboBox) CbnKillFocus(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.CBN_KILLFOCUS, fun)
 }
 
 // [CBN_SELCHANGE] message handler.
 //
 // [CBN_SELCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/cbn-selchange
-func (me *EventsComboBox) CbnSelChange(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.CBN_SELCHANGE, fun)
+func (me *EventsComboBox) CbnSelChange(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.CBN_SELCHANGE, fun)
 }
 
 // [CBN_SELENDCANCEL] message handler.
 //
 // [CBN_SELENDCANCEL]: https://learn.microsoft.com/en-us/windows/win32/controls/cbn-selendcancel
-func (me *EventsComboBox) CbnSelEndCancel(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.CBN_SELENDCANCEL, fun)
+func (me *EventsComboBox) CbnSelEndCancel(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.CBN_SELENDCANCEL, fun)
 }
 
 // [CBN_SELENDOK] message handler.
 //
 // [CBN_SELENDOK]: https://learn.microsoft.com/en-us/windows/win32/controls/cbn-selendok
-func (me *EventsComboBox) CbnSelEndOk(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.CBN_SELENDOK, fun)
+func (me *EventsComboBox) CbnSelEndOk(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.CBN_SELENDOK, fun)
 }
 
 // [CBN_SETFOCUS] message handler.
 //
 // [CBN_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/cbn-setfocus
-func (me *EventsComboBox) CbnSetFocus(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.CBN_SETFOCUS, fun)
+func (me *EventsComboBox) CbnSetFocus(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.CBN_SETFOCUS, fun)
 }
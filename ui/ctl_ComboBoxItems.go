@@ -3,6 +3,8 @@
 package ui
 
 import (
+	"fmt"
+
 	"github.com/rodrigocfd/windigo/win"
 	"github.com/rodrigocfd/windigo/win/co"
 	"github.com/rodrigocfd/windigo/win/wstr"
@@ -61,6 +63,18 @@ func (me *CollectionComboBoxItems) Count() uint {
 	return uint(n)
 }
 
+// Returns the user-custom data stored for the item at the given index with
+// [CollectionComboBoxItems.SetData], or nil if none. The item is identified
+// with [CB_GETITEMDATA].
+//
+// [CB_GETITEMDATA]: https://learn.microsoft.com/en-us/windows/win32/controls/cb-getitemdata
+func (me *CollectionComboBoxItems) Data(index uint) interface{} {
+	if data, ok := me.owner.itemsData[me.uid(index)]; ok {
+		return data
+	}
+	return nil
+}
+
 // Deletes all items with [CB_RESETCONTENT].
 //
 // [CB_RESETCONTENT]: https://learn.microsoft.com/en-us/windows/win32/controls/cb-resetcontent
@@ -116,6 +130,40 @@ func (me *CollectionComboBoxItems) Selected() int {
 	return int(n)
 }
 
+// Stores user-custom data for the item at the given index, using
+// [CB_SETITEMDATA]. The data is released when the item is deleted.
+//
+// Panics on error.
+//
+// # Example
+//
+//	type Fruit struct {
+//		Calories int
+//	}
+//
+//	var cmb ui.ComboBox // initialized somewhere
+//
+//	cmb.Items.SetData(0, &Fruit{Calories: 160})
+//
+//	if fruit, ok := cmb.Items.Data(0).(*Fruit); ok {
+//		println(fruit.Calories)
+//	}
+//
+// [CB_SETITEMDATA]: https://learn.microsoft.com/en-us/windows/win32/controls/cb-setitemdata
+func (me *CollectionComboBoxItems) SetData(index uint, data interface{}) {
+	uid := me.uid(index)
+	if uid == 0 { // no data stored yet, create a new uid for this item
+		me.owner.nextUid++
+		uid = me.owner.nextUid
+		ret, _ := me.owner.hWnd.SendMessage(co.CB_SETITEMDATA,
+			win.WPARAM(index), win.LPARAM(uid))
+		if int32(ret) == -1 { // CB_ERR
+			panic(fmt.Sprintf("CB_SETITEMDATA failed at item %d.", index))
+		}
+	}
+	me.owner.itemsData[uid] = data
+}
+
 // Returns the string at the given position with [CB_GETLBTEXT].
 //
 // Panics on error.
@@ -134,3 +182,12 @@ func (me *CollectionComboBoxItems) Text(index uint) string {
 		win.WPARAM(index), win.LPARAM(recvBuf.UnsafePtr()))
 	return recvBuf.String()
 }
+
+// Retrieves the uid stored with CB_SETITEMDATA, which is zero if none.
+func (me *CollectionComboBoxItems) uid(index uint) uintptr {
+	ret, _ := me.owner.hWnd.SendMessage(co.CB_GETITEMDATA, win.WPARAM(index), 0)
+	if int32(ret) == -1 { // CB_ERR
+		return 0
+	}
+	return ret
+}
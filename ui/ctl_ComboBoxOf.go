@@ -0,0 +1,90 @@
+//go:build windows
+
+package ui
+
+import (
+	"fmt"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// A [ComboBox] whose items carry user-custom data of type T, so the data
+// doesn't need to be type-asserted.
+//
+// The data is released automatically when the items are deleted.
+//
+// # Example
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	cmb := ui.NewComboBoxOf[co.LV_VIEW](wndOwner, ui.OptsComboBox())
+//
+//	wndOwner.On().WmCreate(func(_ ui.WmCreate) int {
+//		cmb.AddWithData("Details", co.LV_VIEW_DETAILS)
+//		cmb.AddWithData("Icons", co.LV_VIEW_ICON)
+//		return 0
+//	})
+//
+//	cmb.On().CbnSelChange(func() {
+//		if view, ok := cmb.SelectedData(); ok {
+//			println(view)
+//		}
+//	})
+type ComboBoxOf[T any] struct {
+	*ComboBox
+}
+
+// Creates a new [ComboBoxOf] with [win.CreateWindowEx].
+func NewComboBoxOf[T any](parent Parent, opts *VarOptsComboBox) *ComboBoxOf[T] {
+	return &ComboBoxOf[T]{NewComboBox(parent, opts)}
+}
+
+// Instantiates a new [ComboBoxOf] to be loaded from a dialog resource with
+// [win.HWND.GetDlgItem].
+func NewComboBoxOfDlg[T any](parent Parent, ctrlId uint16, layout LAY) *ComboBoxOf[T] {
+	return &ComboBoxOf[T]{NewComboBoxDlg(parent, ctrlId, layout)}
+}
+
+// Adds a new item with [CB_ADDSTRING], and stores the data for it.
+//
+// Returns the index of the new item, which may not be the last one, if the
+// combo box has the co.CBS_SORT style.
+//
+// Panics on error.
+//
+// [CB_ADDSTRING]: https://learn.microsoft.com/en-us/windows/win32/controls/cb-addstring
+func (me *ComboBoxOf[T]) AddWithData(text string, data T) uint {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	ret, _ := me.hWnd.SendMessage(co.CB_ADDSTRING,
+		0, win.LPARAM(wbuf.PtrAllowEmpty(text)))
+	if int32(ret) < 0 { // CB_ERR or CB_ERRSPACE
+		panic(fmt.Sprintf("CB_ADDSTRING failed for \"%s\".", text))
+	}
+	me.Items.SetData(uint(ret), data)
+	return uint(ret)
+}
+
+// Returns the data stored for the item at the given index, if any.
+func (me *ComboBoxOf[T]) Data(index uint) (T, bool) {
+	data, ok := me.Items.Data(index).(T)
+	return data, ok
+}
+
+// Returns the data stored for the selected item, if any.
+func (me *ComboBoxOf[T]) SelectedData() (T, bool) {
+	if idx := me.Items.Selected(); idx != -1 {
+		return me.Data(uint(idx))
+	}
+	var zero T
+	return zero, false
+}
+
+// Stores the data for the item at the given index, replacing any previous
+// one.
+func (me *ComboBoxOf[T]) SetData(index uint, data T) {
+	me.Items.SetData(index, data)
+}
@@ -77,10 +77,7 @@ func NewDateTimePickerDlg(parent Parent, ctrlId uint16, layout LAY) *DateTimePic
 }
 
 // Exposes all the control notifications the can be handled.
-//
-// Panics if called after the control has been created.
 func (me *DateTimePicker) On() *EventsDateTimePicker {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
@@ -203,8 +200,8 @@ type EventsDateTimePicker struct {
 // [DTN_CLOSEUP] message handler.
 //
 // [DTN_CLOSEUP]: https://learn.microsoft.com/en-us/windows/win32/controls/dtn-closeup
-func (me *EventsDateTimePicker) DtnCloseUp(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.DTN_CLOSEUP, func(_ unsafe.Pointer) uintptr {
+func (me *EventsDateTimePicker) DtnCloseUp(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.DTN_CLOSEUP, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -213,8 +210,8 @@ func (me *EventsDateTimePicker) DtnCloseUp(fun func()) {
 // [DTN_DATETIMECHANGE] message handler.
 //
 // [DTN_DATETIMECHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/dtn-datetimechange
-func (me *EventsDateTimePicker) DtnDateTimeChange(fun func(p *win.NMDATETIMECHANGE)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.DTN_DATETIMECHANGE, func(p unsafe.Pointer) uintptr {
+func (me *EventsDateTimePicker) DtnDateTimeChange(fun func(p *win.NMDATETIMECHANGE)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.DTN_DATETIMECHANGE, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMDATETIMECHANGE)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -223,8 +220,8 @@ func (me *EventsDateTimePicker) DtnDateTimeChange(fun func(p *win.NMDATETIMECHAN
 // [DTN_DROPDOWN] message handler.
 //
 // [DTN_DROPDOWN]: https://learn.microsoft.com/en-us/windows/win32/controls/dtn-dropdown
-func (me *EventsDateTimePicker) DtnDropDown(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.DTN_DROPDOWN, func(_ unsafe.Pointer) uintptr {
+func (me *EventsDateTimePicker) DtnDropDown(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.DTN_DROPDOWN, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -233,8 +230,8 @@ func (me *EventsDateTimePicker) DtnDropDown(fun func()) {
 // [DTN_FORMAT] message handler.
 //
 // [DTN_FORMAT]: https://learn.microsoft.com/en-us/windows/win32/controls/dtn-format
-func (me *EventsDateTimePicker) DtnFormat(fun func(p *win.NMDATETIMEFORMAT)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.DTN_FORMAT, func(p unsafe.Pointer) uintptr {
+func (me *EventsDateTimePicker) DtnFormat(fun func(p *win.NMDATETIMEFORMAT)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.DTN_FORMAT, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMDATETIMEFORMAT)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -243,8 +240,8 @@ func (me *EventsDateTimePicker) DtnFormat(fun func(p *win.NMDATETIMEFORMAT)) {
 // [DTN_FORMATQUERY] message handler.
 //
 // [DTN_FORMATQUERY]: https://learn.microsoft.com/en-us/windows/win32/controls/dtn-formatquery
-func (me *EventsDateTimePicker) DtnFormatQuery(fun func(p *win.NMDATETIMEFORMATQUERY)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.DTN_FORMATQUERY, func(p unsafe.Pointer) uintptr {
+func (me *EventsDateTimePicker) DtnFormatQuery(fun func(p *win.NMDATETIMEFORMATQUERY)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.DTN_FORMATQUERY, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMDATETIMEFORMATQUERY)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -253,8 +250,8 @@ func (me *EventsDateTimePicker) DtnFormatQuery(fun func(p *win.NMDATETIMEFORMATQ
 // [DTN_USERSTRING] message handler.
 //
 // [DTN_USERSTRING]: https://learn.microsoft.com/en-us/windows/win32/controls/dtn-userstring
-func (me *EventsDateTimePicker) DtnUserString(fun func(p *win.NMDATETIMESTRING)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.DTN_USERSTRING, func(p unsafe.Pointer) uintptr {
+func (me *EventsDateTimePicker) DtnUserString(fun func(p *win.NMDATETIMESTRING)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.DTN_USERSTRING, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMDATETIMESTRING)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -263,8 +260,8 @@ func (me *EventsDateTimePicker) DtnUserString(fun func(p *win.NMDATETIMESTRING))
 // [DTN_WMKEYDOWN] message handler.
 //
 // [DTN_WMKEYDOWN]: https://learn.microsoft.com/en-us/windows/win32/controls/dtn-wmkeydown
-func (me *EventsDateTimePicker) DtnWmKeyDown(fun func(p *win.NMDATETIMEWMKEYDOWN)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.DTN_WMKEYDOWN, func(p unsafe.Pointer) uintptr {
+func (me *EventsDateTimePicker) DtnWmKeyDown(fun func(p *win.NMDATETIMEWMKEYDOWN)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.DTN_WMKEYDOWN, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMDATETIMEWMKEYDOWN)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -273,8 +270,8 @@ func (me *EventsDateTimePicker) DtnWmKeyDown(fun func(p *win.NMDATETIMEWMKEYDOWN
 // [NM_KILLFOCUS] message handler.
 //
 // [NM_KILLFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-killfocus-date-time
-func (me *EventsDateTimePicker) NmKillFocus(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_KILLFOCUS, func(_ unsafe.Pointer) uintptr {
+func (me *EventsDateTimePicker) NmKillFocus(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_KILLFOCUS, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -283,8 +280,8 @@ func (me *EventsDateTimePicker) NmKillFocus(fun func()) {
 // [NM_SETFOCUS] message handler.
 //
 // [NM_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-setfocus-date-time-
-func (me *EventsDateTimePicker) NmSetFocus(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_SETFOCUS, func(_ unsafe.Pointer) uintptr {
+func (me *EventsDateTimePicker) NmSetFocus(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_SETFOCUS, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
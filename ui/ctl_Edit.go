@@ -73,10 +73,7 @@ func NewEditDlg(parent Parent, ctrlId uint16, layout LAY) *Edit {
 }
 
 // Exposes all the control notifications the can be handled.
-//
-// Panics if called after the control has been created.
 func (me *Edit) On() *EventsEdit {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
@@ -235,69 +232,69 @@ type EventsEdit struct {
 // [EN_ALIGN_LTR_EC] message handler.
 //
 // [EN_ALIGN_LTR_EC]: https://learn.microsoft.com/en-us/windows/win32/controls/en-align-ltr-ec
-func (me *EventsEdit) EnAlignLtrEc(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.EN_ALIGN_LTR_EC, fun)
+func (me *EventsEdit) EnAlignLtrEc(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_ALIGN_LTR_EC, fun)
 }
 
 // [EN_ALIGN_RTL_EC] message handler.
 //
 // [EN_ALIGN_RTL_EC]: https://learn.microsoft.com/en-us/windows/win32/controls/en-align-rtl-ec
-func (me *EventsEdit) EnAlignRtlEc(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.EN_ALIGN_RTL_EC, fun)
+func (me *EventsEdit) EnAlignRtlEc(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_ALIGN_RTL_EC, fun)
 }
 
 // [EN_CHANGE] message handler.
 //
 // [EN_CHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-change
-func (me *EventsEdit) EnChange(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.EN_CHANGE, fun)
+func (me *EventsEdit) EnChange(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_CHANGE, fun)
 }
 
 // [EN_ERRSPACE] message handler.
 //
 // [EN_ERRSPACE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-errspace
-func (me *EventsEdit) EnErrSpace(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.EN_ERRSPACE, fun)
+func (me *EventsEdit) EnErrSpace(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_ERRSPACE, fun)
 }
 
 // [EN_HSCROLL] message handler.
 //
 // [EN_HSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/en-hscroll
-func (me *EventsEdit) EnHScroll(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.EN_HSCROLL, fun)
+func (me *EventsEdit) EnHScroll(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_HSCROLL, fun)
 }
 
 // [EN_KILLFOCUS] message handler.
 //
 // [EN_KILLFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/en-killfocus
-func (me *EventsEdit) EnKillFocus(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.EN_KILLFOCUS, fun)
+func (me *EventsEdit) EnKillFocus(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_KILLFOCUS, fun)
 }
 
 // [EN_MAXTEXT] message handler.
 //
 // [EN_MAXTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/en-maxtext
-func (me *EventsEdit) EnMaxText(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.EN_MAXTEXT, fun)
+func (me *EventsEdit) EnMaxText(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_MAXTEXT, fun)
 }
 
 // [EN_SETFOCUS] message handler.
 //
 // [EN_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/en-setfocus
-func (me *EventsEdit) EnSetFocus(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.EN_SETFOCUS, fun)
+func (me *EventsEdit) EnSetFocus(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_SETFOCUS, fun)
 }
 
 // [EN_UPDATE] message handler.
 //
 // [EN_UPDATE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-update
-func (me *EventsEdit) EnUpdate(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.EN_UPDATE, fun)
+func (me *EventsEdit) EnUpdate(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_UPDATE, fun)
 }
 
 // [EN_VSCROLL] message handler.
 //
 // [EN_VSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/en-vscroll
-func (me *EventsEdit) EnVScroll(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.EN_VSCROLL, fun)
+func (me *EventsEdit) EnVScroll(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_VSCROLL, fun)
 }
@@ -111,10 +111,7 @@ func (me *Header) defaultMessageHandlers(parent Parent) {
 }
 
 // Exposes all the control notifications the can be handled.
-//
-// Panics if called after the control has been created.
 func (me *Header) On() *EventsHeader {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
@@ -217,8 +214,8 @@ type EventsHeader struct {
 // [HDN_BEGINDRAG] message handler.
 //
 // [HDN_BEGINDRAG]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-begindrag
-func (me *EventsHeader) HdnBeginDrag(fun func(p *win.NMHEADER) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_BEGINDRAG, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnBeginDrag(fun func(p *win.NMHEADER) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_BEGINDRAG, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMHEADER)(p)))
 	})
 }
@@ -226,8 +223,8 @@ func (me *EventsHeader) HdnBeginDrag(fun func(p *win.NMHEADER) bool) {
 // [HDN_BEGINFILTEREDIT] message handler.
 //
 // [HDN_BEGINFILTEREDIT]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-beginfilteredit
-func (me *EventsHeader) HdnBeginFilterEdit(fun func(p *win.NMHEADER)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_BEGINFILTEREDIT, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnBeginFilterEdit(fun func(p *win.NMHEADER)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_BEGINFILTEREDIT, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMHEADER)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -236,8 +233,8 @@ func (me *EventsHeader) HdnBeginFilterEdit(fun func(p *win.NMHEADER)) {
 // [HDN_BEGINTRACK] message handler.
 //
 // [HDN_BEGINTRACK]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-begintrack
-func (me *EventsHeader) HdnBeginTrack(fun func(p *win.NMHEADER) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_BEGINTRACK, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnBeginTrack(fun func(p *win.NMHEADER) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_BEGINTRACK, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMHEADER)(p)))
 	})
 }
@@ -245,8 +242,8 @@ func (me *EventsHeader) HdnBeginTrack(fun func(p *win.NMHEADER) bool) {
 // [HDN_DIVIDERDBLCLICK] message handler.
 //
 // [HDN_DIVIDERDBLCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-dividerdblclick
-func (me *EventsHeader) HdnDividerDblClick(fun func(p *win.NMHEADER)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_DIVIDERDBLCLICK, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnDividerDblClick(fun func(p *win.NMHEADER)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_DIVIDERDBLCLICK, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMHEADER)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -255,8 +252,8 @@ func (me *EventsHeader) HdnDividerDblClick(fun func(p *win.NMHEADER)) {
 // [HDN_DROPDOWN] message handler.
 //
 // [HDN_DROPDOWN]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-dropdown
-func (me *EventsHeader) HdnDropDown(fun func(p *win.NMHEADER)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_DROPDOWN, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnDropDown(fun func(p *win.NMHEADER)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_DROPDOWN, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMHEADER)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -265,8 +262,8 @@ func (me *EventsHeader) HdnDropDown(fun func(p *win.NMHEADER)) {
 // [HDN_ENDDRAG] message handler.
 //
 // [HDN_ENDDRAG]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-enddrag
-func (me *EventsHeader) HdnEndDrag(fun func(p *win.NMHEADER) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_ENDDRAG, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnEndDrag(fun func(p *win.NMHEADER) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_ENDDRAG, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMHEADER)(p)))
 	})
 }
@@ -274,8 +271,8 @@ func (me *EventsHeader) HdnEndDrag(fun func(p *win.NMHEADER) bool) {
 // [HDN_ENDFILTEREDIT] message handler.
 //
 // [HDN_ENDFILTEREDIT]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-endfilteredit
-func (me *EventsHeader) HdnEndFilterEdit(fun func(p *win.NMHEADER)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_ENDFILTEREDIT, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnEndFilterEdit(fun func(p *win.NMHEADER)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_ENDFILTEREDIT, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMHEADER)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -284,8 +281,8 @@ func (me *EventsHeader) HdnEndFilterEdit(fun func(p *win.NMHEADER)) {
 // [HDN_ENDTRACK] message handler.
 //
 // [HDN_ENDTRACK]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-endtrack
-func (me *EventsHeader) HdnEndTrack(fun func(p *win.NMHEADER)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_ENDTRACK, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnEndTrack(fun func(p *win.NMHEADER)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_ENDTRACK, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMHEADER)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -294,8 +291,8 @@ func (me *EventsHeader) HdnEndTrack(fun func(p *win.NMHEADER)) {
 // [HDN_FILTERBTNCLICK] message handler.
 //
 // [HDN_FILTERBTNCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-filterbtnclick
-func (me *EventsHeader) HdnFilterBtnClick(fun func(p *win.NMHDFILTERBTNCLICK) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_FILTERBTNCLICK, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnFilterBtnClick(fun func(p *win.NMHDFILTERBTNCLICK) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_FILTERBTNCLICK, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMHDFILTERBTNCLICK)(p)))
 	})
 }
@@ -303,8 +300,8 @@ func (me *EventsHeader) HdnFilterBtnClick(fun func(p *win.NMHDFILTERBTNCLICK) bo
 // [HDN_FILTERCHANGE] message handler.
 //
 // [HDN_FILTERCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-filterchange
-func (me *EventsHeader) HdnFilterChange(fun func(p *win.NMHEADER)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_FILTERCHANGE, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnFilterChange(fun func(p *win.NMHEADER)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_FILTERCHANGE, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMHEADER)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -313,8 +310,8 @@ func (me *EventsHeader) HdnFilterChange(fun func(p *win.NMHEADER)) {
 // [HDN_GETDISPINFO] message handler.
 //
 // [HDN_GETDISPINFO]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-getdispinfo
-func (me *EventsHeader) HdnGetDispInfo(fun func(p *win.NMHDDISPINFO) uintptr) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_GETDISPINFO, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnGetDispInfo(fun func(p *win.NMHDDISPINFO) uintptr) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_GETDISPINFO, func(p unsafe.Pointer) uintptr {
 		return fun((*win.NMHDDISPINFO)(p))
 	})
 }
@@ -322,8 +319,8 @@ func (me *EventsHeader) HdnGetDispInfo(fun func(p *win.NMHDDISPINFO) uintptr) {
 // [HDN_ITEMCHANGED] message handler.
 //
 // [HDN_ITEMCHANGED]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-itemchanged
-func (me *EventsHeader) HdnItemChanged(fun func(p *win.NMHEADER)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_ITEMCHANGED, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnItemChanged(fun func(p *win.NMHEADER)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_ITEMCHANGED, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMHEADER)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -332,8 +329,8 @@ func (me *EventsHeader) HdnItemChanged(fun func(p *win.NMHEADER)) {
 // [HDN_ITEMCHANGING] message handler.
 //
 // [HDN_ITEMCHANGING]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-itemchanging
-func (me *EventsHeader) HdnItemChanging(fun func(p *win.NMHEADER) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_ITEMCHANGING, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnItemChanging(fun func(p *win.NMHEADER) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_ITEMCHANGING, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMHEADER)(p)))
 	})
 }
@@ -341,8 +338,8 @@ func (me *EventsHeader) HdnItemChanging(fun func(p *win.NMHEADER) bool) {
 // [HDN_ITEMCLICK] message handler.
 //
 // [HDN_ITEMCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-itemclick
-func (me *EventsHeader) HdnItemClick(fun func(p *win.NMHEADER)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_ITEMCLICK, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnItemClick(fun func(p *win.NMHEADER)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_ITEMCLICK, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMHEADER)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -351,8 +348,8 @@ func (me *EventsHeader) HdnItemClick(fun func(p *win.NMHEADER)) {
 // [HDN_ITEMDBLCLICK] message handler.
 //
 // [HDN_ITEMDBLCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-itemdblclick
-func (me *EventsHeader) HdnItemDblClick(fun func(p *win.NMHEADER)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_ITEMDBLCLICK, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnItemDblClick(fun func(p *win.NMHEADER)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_ITEMDBLCLICK, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMHEADER)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -361,8 +358,8 @@ func (me *EventsHeader) HdnItemDblClick(fun func(p *win.NMHEADER)) {
 // [HDN_ITEMKEYDOWN] message handler.
 //
 // [HDN_ITEMKEYDOWN]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-itemkeydown
-func (me *EventsHeader) HdnItemKeyDown(fun func(p *win.NMHEADER)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_ITEMKEYDOWN, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnItemKeyDown(fun func(p *win.NMHEADER)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_ITEMKEYDOWN, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMHEADER)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -371,8 +368,8 @@ func (me *EventsHeader) HdnItemKeyDown(fun func(p *win.NMHEADER)) {
 // [HDN_ITEMSTATEICONCLICK] message handler.
 //
 // [HDN_ITEMSTATEICONCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-itemstateiconclick
-func (me *EventsHeader) HdnItemStateIconClick(fun func(p *win.NMHEADER)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_ITEMSTATEICONCLICK, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnItemStateIconClick(fun func(p *win.NMHEADER)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_ITEMSTATEICONCLICK, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMHEADER)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -381,8 +378,8 @@ func (me *EventsHeader) HdnItemStateIconClick(fun func(p *win.NMHEADER)) {
 // [HDN_OVERFLOWCLICK] message handler.
 //
 // [HDN_OVERFLOWCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-overflowclick
-func (me *EventsHeader) HdnOverflowClick(fun func(p *win.NMHEADER)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_OVERFLOWCLICK, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnOverflowClick(fun func(p *win.NMHEADER)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_OVERFLOWCLICK, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMHEADER)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -391,8 +388,8 @@ func (me *EventsHeader) HdnOverflowClick(fun func(p *win.NMHEADER)) {
 // [HDN_TRACK] message handler.
 //
 // [HDN_TRACK]: https://learn.microsoft.com/en-us/windows/win32/controls/hdn-track
-func (me *EventsHeader) HdnTrack(fun func(p *win.NMHEADER) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.HDN_TRACK, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) HdnTrack(fun func(p *win.NMHEADER) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.HDN_TRACK, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMHEADER)(p)))
 	})
 }
@@ -400,8 +397,8 @@ func (me *EventsHeader) HdnTrack(fun func(p *win.NMHEADER) bool) {
 // [NM_CUSTOMDRAW] message handler.
 //
 // [NM_CUSTOMDRAW]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-customdraw-header
-func (me *EventsHeader) NmCustomDraw(fun func(p *win.NMCUSTOMDRAW) co.CDRF) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_CUSTOMDRAW, func(p unsafe.Pointer) uintptr {
+func (me *EventsHeader) NmCustomDraw(fun func(p *win.NMCUSTOMDRAW) co.CDRF) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_CUSTOMDRAW, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMCUSTOMDRAW)(p)))
 	})
 }
@@ -409,8 +406,8 @@ func (me *EventsHeader) NmCustomDraw(fun func(p *win.NMCUSTOMDRAW) co.CDRF) {
 // [NM_RCLICK] message handler.
 //
 // [NM_RCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-rclick-header
-func (me *EventsHeader) NmRClick(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RCLICK, func(_ unsafe.Pointer) uintptr {
+func (me *EventsHeader) NmRClick(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RCLICK, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -419,8 +416,8 @@ func (me *EventsHeader) NmRClick(fun func()) {
 // [NM_RELEASEDCAPTURE] message handler.
 //
 // [NM_RELEASEDCAPTURE]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-releasedcapture-header-
-func (me *EventsHeader) NmReleasedCapture(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) uintptr {
+func (me *EventsHeader) NmReleasedCapture(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
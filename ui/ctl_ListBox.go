@@ -0,0 +1,280 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Native [list box] control.
+//
+// [list box]: https://learn.microsoft.com/en-us/windows/win32/controls/list-boxes
+type ListBox struct {
+	_BaseCtrl
+	events    EventsListBox
+	itemsData map[uintptr]interface{} // data associated with each item; keyed by the uid stored with LB_SETITEMDATA
+	nextUid   uintptr                 // next uid to be stored with LB_SETITEMDATA; zero means no data
+	Items     CollectionListBoxItems  // Methods to interact with the items collection.
+}
+
+// Creates a new [ListBox] with [win.CreateWindowEx].
+//
+// The selection mode is defined by the control style: co.LBS_MULTIPLESEL or
+// co.LBS_EXTENDEDSEL allow many items to be selected; otherwise, only one item
+// can be selected at a time.
+//
+// # Example
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	lst := ui.NewListBox(
+//		wndOwner,
+//		ui.OptsListBox().
+//			Position(ui.Dpi(20, 92)).
+//			Texts("Avocado", "Banana", "Pineapple").
+//			CtrlStyle(co.LBS_NOTIFY|co.LBS_EXTENDEDSEL),
+//	)
+func NewListBox(parent Parent, opts *VarOptsListBox) *ListBox {
+	setUniqueCtrlId(&opts.ctrlId)
+	me := &ListBox{
+		_BaseCtrl: newBaseCtrl(opts.ctrlId),
+		events:    EventsListBox{ctrlId: opts.ctrlId, parentEvents: &parent.base().userEvents},
+		itemsData: make(map[uintptr]interface{}),
+	}
+	me.Items.owner = me
+
+	ctrlStyle := opts.ctrlStyle
+	if (ctrlStyle & (co.LBS_OWNERDRAWFIXED | co.LBS_OWNERDRAWVARIABLE)) != 0 {
+		ctrlStyle |= co.LBS_HASSTRINGS // item data is used by us, so the texts must be kept
+	}
+
+	parent.base().beforeUserEvents.WmCreate(func(_ WmCreate) int {
+		me.createWindow(opts.wndExStyle, "LISTBOX", "",
+			opts.wndStyle|co.WS(ctrlStyle), opts.position, opts.size, parent, true)
+		parent.base().layout.Add(parent, me.hWnd, opts.layout)
+		me.Items.Add(opts.texts...)
+		return 0 // ignored
+	})
+
+	return me
+}
+
+// Instantiates a new [ListBox] to be loaded from a dialog resource with
+// [win.HWND.GetDlgItem].
+//
+// If the list box is owner-drawn, the resource must also have the
+// LBS_HASSTRINGS style, because the item data is used internally to store the
+// data set with [ListBoxItem.SetData].
+//
+// # Example
+//
+//	const ID_LST uint16 = 0x100
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	lst := ui.NewListBoxDlg(
+//		wndOwner, ID_LST, ui.LAY_NONE_NONE)
+func NewListBoxDlg(parent Parent, ctrlId uint16, layout LAY) *ListBox {
+	me := &ListBox{
+		_BaseCtrl: newBaseCtrl(ctrlId),
+		events:    EventsListBox{ctrlId: ctrlId, parentEvents: &parent.base().userEvents},
+		itemsData: make(map[uintptr]interface{}),
+	}
+	me.Items.owner = me
+
+	parent.base().beforeUserEvents.WmInitDialog(func(_ WmInitDialog) bool {
+		me.assignDialog(parent)
+		parent.base().layout.Add(parent, me.hWnd, layout)
+		return true // ignored
+	})
+
+	return me
+}
+
+// Exposes all the control notifications the can be handled.
+func (me *ListBox) On() *EventsListBox {
+	return &me.events
+}
+
+// Tells whether the list box allows many items to be selected, that is, if it
+// has the co.LBS_MULTIPLESEL or co.LBS_EXTENDEDSEL styles.
+func (me *ListBox) IsMultiSel() bool {
+	stylesRet, _ := me.hWnd.GetWindowLongPtr(co.GWLP_STYLE)
+	return (co.LBS(stylesRet) & (co.LBS_MULTIPLESEL | co.LBS_EXTENDEDSEL)) != 0
+}
+
+// Sets the height of the items with [LB_SETITEMHEIGHT]. For owner-draw
+// variable list boxes, sets the height of the given item only; otherwise the
+// index is ignored.
+//
+// Panics on error.
+//
+// [LB_SETITEMHEIGHT]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-setitemheight
+func (me *ListBox) SetItemHeight(index int, height int) {
+	ret, _ := me.hWnd.SendMessage(co.LB_SETITEMHEIGHT,
+		win.WPARAM(index), win.MAKELPARAM(uint16(height), 0))
+	if int32(ret) == -1 { // LB_ERR
+		panic("LB_SETITEMHEIGHT failed.")
+	}
+}
+
+// Options for [NewListBox]; returned by [OptsListBox].
+type VarOptsListBox struct {
+	ctrlId     uint16
+	layout     LAY
+	position   win.POINT
+	size       win.SIZE
+	ctrlStyle  co.LBS
+	wndStyle   co.WS
+	wndExStyle co.WS_EX
+
+	texts []string
+}
+
+// Options for [NewListBox].
+func OptsListBox() *VarOptsListBox {
+	return &VarOptsListBox{
+		size:       win.SIZE{Cx: int32(DpiX(120)), Cy: int32(DpiY(120))},
+		ctrlStyle:  co.LBS_NOTIFY | co.LBS_NOINTEGRALHEIGHT | co.LBS_HASSTRINGS,
+		wndStyle:   co.WS_CHILD | co.WS_VISIBLE | co.WS_TABSTOP | co.WS_GROUP | co.WS_VSCROLL,
+		wndExStyle: co.WS_EX_CLIENTEDGE,
+	}
+}
+
+// Control ID. Must be unique within a same parent window.
+//
+// Defaults to an auto-generated ID.
+func (o *VarOptsListBox) CtrlId(id uint16) *VarOptsListBox { o.ctrlId = id; return o }
+
+// Horizontal and vertical behavior for the control layout, when the parent
+// window is resized.
+//
+// Defaults to ui.LAY_NONE_NONE.
+func (o *VarOptsListBox) Layout(l LAY) *VarOptsListBox { o.layout = l; return o }
+
+// Position coordinates within parent window client area, in pixels, passed to
+// [win.CreateWindowEx].
+//
+// Defaults to ui.Dpi(0, 0).
+func (o *VarOptsListBox) Position(x, y int) *VarOptsListBox {
+	o.position.X = int32(x)
+	o.position.Y = int32(y)
+	return o
+}
+
+// Control size in pixels, passed to [win.CreateWindowEx].
+//
+// Defaults to ui.Dpi(120, 120).
+func (o *VarOptsListBox) Size(cx int, cy int) *VarOptsListBox {
+	o.size.Cx = int32(cx)
+	o.size.Cy = int32(cy)
+	return o
+}
+
+// List box control [style], passed to [win.CreateWindowEx].
+//
+// Use co.LBS_MULTIPLESEL or co.LBS_EXTENDEDSEL for multiple selection, and
+// co.LBS_OWNERDRAWFIXED or co.LBS_OWNERDRAWVARIABLE for owner-draw; in the
+// latter case, co.LBS_HASSTRINGS is always added.
+//
+// Defaults to co.LBS_NOTIFY | co.LBS_NOINTEGRALHEIGHT | co.LBS_HASSTRINGS.
+//
+// [style]: https://learn.microsoft.com/en-us/windows/win32/controls/list-box-styles
+func (o *VarOptsListBox) CtrlStyle(s co.LBS) *VarOptsListBox { o.ctrlStyle = s; return o }
+
+// Window style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_CHILD | co.WS_VISIBLE | co.WS_TABSTOP | co.WS_GROUP | co.WS_VSCROLL.
+func (o *VarOptsListBox) WndStyle(s co.WS) *VarOptsListBox { o.wndStyle = s; return o }
+
+// Window extended style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_EX_CLIENTEDGE.
+func (o *VarOptsListBox) WndExStyle(s co.WS_EX) *VarOptsListBox { o.wndExStyle = s; return o }
+
+// Texts to be added to the ListBox.
+//
+// Defaults to none.
+func (o *VarOptsListBox) Texts(t ...string) *VarOptsListBox { o.texts = t; return o }
+
+// Native [list box] control events.
+//
+// You cannot create this object directly, it will be created automatically
+// by the owning control.
+//
+// [list box]: https://learn.microsoft.com/en-us/windows/win32/controls/list-boxes
+type EventsListBox struct {
+	ctrlId       uint16
+	parentEvents *EventsWindow
+}
+
+// [LBN_DBLCLK] message handler.
+//
+// [LBN_DBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-dblclk
+func (me *EventsListBox) LbnDblClk(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.LBN_DBLCLK, fun)
+}
+
+// [LBN_ERRSPACE] message handler.
+//
+// [LBN_ERRSPACE]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-errspace
+func (me *EventsListBox) LbnErrSpace(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.LBN_ERRSPACE, fun)
+}
+
+// [LBN_KILLFOCUS] message handler.
+//
+// [LBN_KILLFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-killfocus
+func (me *EventsListBox) LbnKillFocus(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.LBN_KILLFOCUS, fun)
+}
+
+// [LBN_SELCANCEL] message handler.
+//
+// [LBN_SELCANCEL]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-selcancel
+func (me *EventsListBox) LbnSelCancel(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.LBN_SELCANCEL, fun)
+}
+
+// [LBN_SELCHANGE] message handler.
+//
+// [LBN_SELCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-selchange
+func (me *EventsListBox) LbnSelChange(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.LBN_SELCHANGE, fun)
+}
+
+// [LBN_SETFOCUS] message handler.
+//
+// [LBN_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-setfocus
+func (me *EventsListBox) LbnSetFocus(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.LBN_SETFOCUS, fun)
+}
+
+// [WM_DRAWITEM] message handler, sent to the parent window when an item of an
+// owner-draw list box must be painted. Only the messages of this list box are
+// delivered.
+//
+// The data set with [ListBoxItem.SetData] can be retrieved with:
+//
+//	lst.Items.Get(int(p.DrawItemStruct().ItemID)).Data()
+//
+// [WM_DRAWITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-drawitem
+func (me *EventsListBox) WmDrawItem(fun func(p WmDrawItem)) EventToken {
+	return me.parentEvents.wmCtrl(co.WM_DRAWITEM, me.ctrlId, func(p Wm) uintptr {
+		fun(WmDrawItem{Raw: p})
+		return 1
+	})
+}
+
+// [WM_MEASUREITEM] message handler, sent to the parent window when an
+// owner-draw list box is created, and, for co.LBS_OWNERDRAWVARIABLE, when each
+// item is added. Only the messages of this list box are delivered.
+//
+// [WM_MEASUREITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-measureitem
+func (me *EventsListBox) WmMeasureItem(fun func(p WmMeasureItem)) EventToken {
+	return me.parentEvents.wmCtrl(co.WM_MEASUREITEM, me.ctrlId, func(p Wm) uintptr {
+		fun(WmMeasureItem{Raw: p})
+		return 1
+	})
+}
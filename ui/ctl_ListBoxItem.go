@@ -0,0 +1,194 @@
+//go:build windows
+
+package ui
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// An item from a [list box].
+//
+// [list box]: https://learn.microsoft.com/en-us/windows/win32/controls/list-boxes
+type ListBoxItem struct {
+	owner *ListBox
+	index int32
+}
+
+// Returns the user-custom data stored for this item, or nil if none.
+//
+// # Example
+//
+//	type Person struct {
+//		Name string
+//	}
+//
+//	var item ui.ListBoxItem // initialized somewhere
+//
+//	item.SetData(&Person{Name: "foo"})
+//
+//	if person := item.Data().(*Person); person != nil {
+//		println(person.Name)
+//	}
+func (me ListBoxItem) Data() interface{} {
+	if data, ok := me.owner.itemsData[me.uid()]; ok {
+		return data
+	}
+	return nil
+}
+
+// Deletes the item with [LB_DELETESTRING].
+//
+// Panics on error.
+//
+// [LB_DELETESTRING]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-deletestring
+func (me ListBoxItem) Delete() {
+	uid := me.uid()
+	ret, _ := me.owner.hWnd.SendMessage(co.LB_DELETESTRING, win.WPARAM(me.index), 0)
+	if int32(ret) == -1 { // LB_ERR
+		panic(fmt.Sprintf("LB_DELETESTRING %d failed.", me.index))
+	}
+	delete(me.owner.itemsData, uid)
+}
+
+// Makes sure the item is visible with [LB_SETTOPINDEX], scrolling the list
+// box if needed.
+//
+// Returns the same item, so further operations can be chained.
+//
+// [LB_SETTOPINDEX]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-settopindex
+func (me ListBoxItem) EnsureVisible() ListBoxItem {
+	rcItem := me.ItemRect()
+	rcClient, _ := me.owner.hWnd.GetClientRect()
+	if rcItem.Top < 0 || rcItem.Bottom > rcClient.Bottom {
+		me.owner.hWnd.SendMessage(co.LB_SETTOPINDEX, win.WPARAM(me.index), 0)
+	}
+	return me
+}
+
+// Returns the zero-based index of the item.
+func (me ListBoxItem) Index() int {
+	return int(me.index)
+}
+
+// Tells if the item is currently selected with [LB_GETSEL].
+//
+// [LB_GETSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-getsel
+func (me ListBoxItem) IsSelected() bool {
+	ret, _ := me.owner.hWnd.SendMessage(co.LB_GETSEL, win.WPARAM(me.index), 0)
+	return int32(ret) > 0
+}
+
+// Retrieves the coordinates of the item with [LB_GETITEMRECT], relative to
+// the list box.
+//
+// Panics on error.
+//
+// [LB_GETITEMRECT]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-getitemrect
+func (me ListBoxItem) ItemRect() win.RECT {
+	var rc win.RECT
+	ret, _ := me.owner.hWnd.SendMessage(co.LB_GETITEMRECT,
+		win.WPARAM(me.index), win.LPARAM(unsafe.Pointer(&rc)))
+	if int32(ret) == -1 { // LB_ERR
+		panic(fmt.Sprintf("LB_GETITEMRECT %d failed.", me.index))
+	}
+	return rc
+}
+
+// Selects or deselects the item. In a multiple-selection list box, uses
+// [LB_SETSEL], keeping the other items untouched; in a single-selection list
+// box, uses [LB_SETCURSEL].
+//
+// Returns the same item, so further operations can be chained.
+//
+// [LB_SETSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-setsel
+// [LB_SETCURSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-setcursel
+func (me ListBoxItem) Select(isSelected bool) ListBoxItem {
+	if me.owner.IsMultiSel() {
+		me.owner.hWnd.SendMessage(co.LB_SETSEL,
+			win.WPARAM(utl.BoolToUintptr(isSelected)), win.LPARAM(me.index))
+	} else if isSelected {
+		me.owner.hWnd.SendMessage(co.LB_SETCURSEL, win.WPARAM(me.index), 0)
+	} else if me.IsSelected() {
+		me.owner.hWnd.SendMessage(co.LB_SETCURSEL, win.WPARAM(^uintptr(0)), 0) // -1 clears selection
+	}
+	return me
+}
+
+// Stores user-custom data for this item. The data is kept internally, so any
+// Go value can be stored.
+func (me ListBoxItem) SetData(data interface{}) {
+	uid := me.uid()
+	if uid == 0 { // no data stored yet, create a new uid for this item
+		me.owner.nextUid++
+		uid = me.owner.nextUid
+		ret, _ := me.owner.hWnd.SendMessage(co.LB_SETITEMDATA,
+			win.WPARAM(me.index), win.LPARAM(uid))
+		if int32(ret) == -1 { // LB_ERR
+			panic(fmt.Sprintf("LB_SETITEMDATA %d failed.", me.index))
+		}
+	}
+	me.owner.itemsData[uid] = data
+}
+
+// Replaces the text of the item, by deleting it with [LB_DELETESTRING] and
+// inserting it again with [LB_INSERTSTRING], keeping its data and selection
+// state.
+//
+// Returns the same item, so further operations can be chained.
+//
+// Panics on error.
+//
+// [LB_DELETESTRING]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-deletestring
+// [LB_INSERTSTRING]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-insertstring
+func (me ListBoxItem) SetText(text string) ListBoxItem {
+	uid := me.uid()
+	wasSelected := me.IsSelected()
+
+	me.owner.hWnd.SendMessage(co.LB_DELETESTRING, win.WPARAM(me.index), 0)
+	newItem := me.owner.Items.Insert(int(me.index), text)
+	if uid != 0 {
+		me.owner.hWnd.SendMessage(co.LB_SETITEMDATA,
+			win.WPARAM(newItem.index), win.LPARAM(uid))
+	}
+	if wasSelected {
+		newItem.Select(true)
+	}
+	return newItem
+}
+
+// Retrieves the text of the item with [LB_GETTEXT].
+//
+// Panics on error.
+//
+// [LB_GETTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-gettext
+func (me ListBoxItem) Text() string {
+	nChars, _ := me.owner.hWnd.SendMessage(co.LB_GETTEXTLEN, win.WPARAM(me.index), 0)
+	if int32(nChars) == -1 { // LB_ERR
+		panic(fmt.Sprintf("LB_GETTEXTLEN %d failed.", me.index))
+	}
+
+	recvBuf := wstr.NewBufDecoder(uint(nChars) + 1)
+	defer recvBuf.Free()
+
+	me.owner.hWnd.SendMessage(co.LB_GETTEXT,
+		win.WPARAM(me.index), win.LPARAM(recvBuf.UnsafePtr()))
+	return recvBuf.String()
+}
+
+// Returns the unique ID stored with [LB_SETITEMDATA], or zero if the item has
+// no data.
+//
+// [LB_SETITEMDATA]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-setitemdata
+func (me ListBoxItem) uid() uintptr {
+	ret, _ := me.owner.hWnd.SendMessage(co.LB_GETITEMDATA, win.WPARAM(me.index), 0)
+	if int32(ret) == -1 { // LB_ERR
+		return 0
+	}
+	return uintptr(ret)
+}
@@ -0,0 +1,257 @@
+//go:build windows
+
+package ui
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// The items collection.
+//
+// You cannot create this object directly, it will be created automatically
+// by the owning [ListBox].
+type CollectionListBoxItems struct {
+	owner *ListBox
+}
+
+// Adds one or more items using [LB_ADDSTRING].
+//
+// Panics on error.
+//
+// [LB_ADDSTRING]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-addstring
+func (me *CollectionListBoxItems) Add(texts ...string) {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	for _, text := range texts {
+		pText := wbuf.PtrAllowEmpty(text)
+		ret, _ := me.owner.hWnd.SendMessage(co.LB_ADDSTRING,
+			0, win.LPARAM(pText))
+		if int32(ret) < 0 { // LB_ERR or LB_ERRSPACE
+			panic(fmt.Sprintf("LB_ADDSTRING \"%s\" failed.", text))
+		}
+		wbuf.Clear()
+	}
+}
+
+// Returns all items.
+func (me *CollectionListBoxItems) All() []ListBoxItem {
+	nItems := me.Count()
+	items := make([]ListBoxItem, 0, nItems)
+	for i := 0; i < int(nItems); i++ {
+		items = append(items, me.Get(i))
+	}
+	return items
+}
+
+// Retrieves the number of items with [LB_GETCOUNT].
+//
+// [LB_GETCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-getcount
+func (me *CollectionListBoxItems) Count() uint {
+	n, _ := me.owner.hWnd.SendMessage(co.LB_GETCOUNT, 0, 0)
+	return uint(n)
+}
+
+// Deletes all items with [LB_RESETCONTENT].
+//
+// [LB_RESETCONTENT]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-resetcontent
+func (me *CollectionListBoxItems) DeleteAll() {
+	me.owner.hWnd.SendMessage(co.LB_RESETCONTENT, 0, 0)
+	me.owner.itemsData = make(map[uintptr]interface{})
+}
+
+// Deletes all selected items.
+//
+// Panics on error.
+func (me *CollectionListBoxItems) DeleteSelected() {
+	selItems := me.Selected()
+	for i := len(selItems) - 1; i >= 0; i-- { // from last to first, so indexes remain valid
+		selItems[i].Delete()
+	}
+}
+
+// Searches for the first item with the given text, case-insensitive, with
+// [LB_FINDSTRINGEXACT].
+//
+// [LB_FINDSTRINGEXACT]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-findstringexact
+func (me *CollectionListBoxItems) Find(text string) (ListBoxItem, bool) {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	idx, _ := me.owner.hWnd.SendMessage(co.LB_FINDSTRINGEXACT,
+		win.WPARAM(^uintptr(0)), // search from the beginning: -1
+		win.LPARAM(wbuf.PtrAllowEmpty(text)))
+	if int32(idx) == -1 { // LB_ERR
+		return ListBoxItem{}, false
+	}
+	return me.Get(int(idx)), true
+}
+
+// Returns the item at the given index.
+func (me *CollectionListBoxItems) Get(index int) ListBoxItem {
+	return ListBoxItem{
+		owner: me.owner,
+		index: int32(index),
+	}
+}
+
+// Retrieves the item nearest to the given coordinates with [LB_ITEMFROMPOINT],
+// if the coordinates are within the client area.
+//
+// The coordinates must be relative to the ListBox.
+//
+// [LB_ITEMFROMPOINT]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-itemfrompoint
+func (me *CollectionListBoxItems) HitTest(pos win.POINT) (ListBoxItem, bool) {
+	ret, _ := me.owner.hWnd.SendMessage(co.LB_ITEMFROMPOINT,
+		0, win.MAKELPARAM(uint16(pos.X), uint16(pos.Y)))
+	if win.HIWORD(uint32(ret)) != 0 || win.LOWORD(uint32(ret)) >= uint16(me.Count()) { // outside client area
+		return ListBoxItem{}, false
+	}
+	return me.Get(int(win.LOWORD(uint32(ret)))), true
+}
+
+// Inserts an item at the given position with [LB_INSERTSTRING]. If index is
+// -1, the item is added at the end, and the list is not sorted.
+//
+// Panics on error.
+//
+// [LB_INSERTSTRING]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-insertstring
+func (me *CollectionListBoxItems) Insert(index int, text string) ListBoxItem {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	newIdx, _ := me.owner.hWnd.SendMessage(co.LB_INSERTSTRING,
+		win.WPARAM(index), win.LPARAM(wbuf.PtrAllowEmpty(text)))
+	if int32(newIdx) < 0 { // LB_ERR or LB_ERRSPACE
+		panic(fmt.Sprintf("LB_INSERTSTRING \"%s\" failed.", text))
+	}
+	return me.Get(int(newIdx))
+}
+
+// Returns the last item.
+//
+// Panics if empty.
+func (me *CollectionListBoxItems) Last() ListBoxItem {
+	nItems := me.Count()
+	if nItems == 0 {
+		panic("ListBox has no items.")
+	}
+	return me.Get(int(nItems) - 1)
+}
+
+// In a single-selection list box, selects the given item with
+// [LB_SETCURSEL]. If index is -1, selection is cleared.
+//
+// For multiple-selection list boxes, use [ListBoxItem.Select] or
+// [CollectionListBoxItems.SelectRange].
+//
+// [LB_SETCURSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-setcursel
+func (me *CollectionListBoxItems) Select(index int) {
+	me.owner.hWnd.SendMessage(co.LB_SETCURSEL, win.WPARAM(index), 0)
+}
+
+// In a multiple-selection list box, selects or deselects all items at once
+// with [LB_SETSEL].
+//
+// Panics on error.
+//
+// [LB_SETSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-setsel
+func (me *CollectionListBoxItems) SelectAll(doSelect bool) {
+	ret, _ := me.owner.hWnd.SendMessage(co.LB_SETSEL,
+		win.WPARAM(utl.BoolToUintptr(doSelect)), win.LPARAM(^uintptr(0))) // -1 means all items
+	if int32(ret) == -1 { // LB_ERR
+		panic("LB_SETSEL failed.")
+	}
+}
+
+// In a multiple-selection list box, selects or deselects the items from first
+// to last, inclusive, with [LB_SELITEMRANGEEX].
+//
+// Panics on error.
+//
+// [LB_SELITEMRANGEEX]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-selitemrangeex
+func (me *CollectionListBoxItems) SelectRange(first, last int, doSelect bool) {
+	if first > last {
+		first, last = last, first
+	}
+	if first == last { // LB_SELITEMRANGEEX can't tell a single item
+		me.Get(first).Select(doSelect)
+		return
+	}
+	if !doSelect {
+		first, last = last, first // reversed range means deselection
+	}
+	ret, _ := me.owner.hWnd.SendMessage(co.LB_SELITEMRANGEEX,
+		win.WPARAM(first), win.LPARAM(last))
+	if int32(ret) == -1 { // LB_ERR
+		panic(fmt.Sprintf("LB_SELITEMRANGEEX %d, %d failed.", first, last))
+	}
+}
+
+// Returns the selected items.
+//
+// In a single-selection list box, uses [LB_GETCURSEL], returning at most one
+// item. In a multiple-selection list box, uses [LB_GETSELITEMS].
+//
+// [LB_GETCURSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-getcursel
+// [LB_GETSELITEMS]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-getselitems
+func (me *CollectionListBoxItems) Selected() []ListBoxItem {
+	if !me.owner.IsMultiSel() {
+		idx, _ := me.owner.hWnd.SendMessage(co.LB_GETCURSEL, 0, 0)
+		if int32(idx) == -1 { // LB_ERR, no selection
+			return []ListBoxItem{}
+		}
+		return []ListBoxItem{me.Get(int(idx))}
+	}
+
+	nSelected := me.SelectedCount()
+	if nSelected == 0 {
+		return []ListBoxItem{}
+	}
+
+	indexes := make([]int32, nSelected)
+	me.owner.hWnd.SendMessage(co.LB_GETSELITEMS,
+		win.WPARAM(nSelected), win.LPARAM(unsafe.Pointer(&indexes[0])))
+
+	items := make([]ListBoxItem, 0, nSelected)
+	for _, idx := range indexes {
+		items = append(items, me.Get(int(idx)))
+	}
+	return items
+}
+
+// Retrieves the number of selected items.
+//
+// In a single-selection list box, uses [LB_GETCURSEL]. In a
+// multiple-selection list box, uses [LB_GETSELCOUNT].
+//
+// [LB_GETCURSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-getcursel
+// [LB_GETSELCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/lb-getselcount
+func (me *CollectionListBoxItems) SelectedCount() uint {
+	if !me.owner.IsMultiSel() {
+		idx, _ := me.owner.hWnd.SendMessage(co.LB_GETCURSEL, 0, 0)
+		if int32(idx) == -1 { // LB_ERR, no selection
+			return 0
+		}
+		return 1
+	}
+
+	n, _ := me.owner.hWnd.SendMessage(co.LB_GETSELCOUNT, 0, 0)
+	return uint(n)
+}
+
+// Returns the texts of all items.
+func (me *CollectionListBoxItems) Texts() []string {
+	nItems := me.Count()
+	texts := make([]string, 0, nItems)
+	for i := 0; i < int(nItems); i++ {
+		texts = append(texts, me.Get(i).Text())
+	}
+	return texts
+}
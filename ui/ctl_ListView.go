@@ -18,7 +18,13 @@ type ListView struct {
 	_BaseCtrl
 	events       EventsListView
 	hContextMenu win.HMENU
-	itemsData    map[int]interface{} // data associated with each item; replaces LPARAM approach
+	itemsData    map[int]interface{}            // data associated with each item; replaces LPARAM approach
+	ownerData    *_ListViewOwnerData            // items source, for LVS_OWNERDATA list views
+	sortCmps     map[int]func(a, b string) int  // comparers of the sortable columns
+	sortCol      int                            // column currently sorted, or -1
+	sortDesc     bool                           // current sort direction
+	editors      map[int]*VarOptsListViewEditor // in-place editors of the editable columns
+	editing      *_ListViewCellEdit             // cell currently being edited, if any
 	header       *Header
 	Cols         CollectionListViewCols  // Methods to interact with the columns collection.
 	Items        CollectionListViewItems // Methods to interact with the items collection.
@@ -32,6 +38,9 @@ func NewListView(parent Parent, opts *VarOptsListView) *ListView {
 		events:       EventsListView{opts.ctrlId, &parent.base().userEvents},
 		hContextMenu: opts.contextMenu,
 		itemsData:    make(map[int]interface{}),
+		sortCmps:     make(map[int]func(a, b string) int),
+		sortCol:      -1,
+		editors:      make(map[int]*VarOptsListViewEditor),
 		header:       newHeaderFromListView(parent),
 	}
 	me.Cols.owner = me
@@ -71,6 +80,9 @@ func NewListViewDlg(parent Parent, ctrlId uint16, contextMenuId uint16, layout L
 		events:       EventsListView{ctrlId, &parent.base().userEvents},
 		hContextMenu: hMenu,
 		itemsData:    make(map[int]interface{}),
+		sortCmps:     make(map[int]func(a, b string) int),
+		sortCol:      -1,
+		editors:      make(map[int]*VarOptsListViewEditor),
 		header:       newHeaderFromListView(parent),
 	}
 	me.Cols.owner = me
@@ -88,6 +100,13 @@ func NewListViewDlg(parent Parent, ctrlId uint16, contextMenuId uint16, layout L
 }
 
 func (me *ListView) defaultMessageHandlers(parent Parent) {
+	parent.base().afterUserEvents.WmDestroy(func() {
+		for _, which := range []co.LVSIL{co.LVSIL_NORMAL, co.LVSIL_SMALL, co.LVSIL_STATE} {
+			h, _ := me.hWnd.SendMessage(co.LVM_GETIMAGELIST, win.WPARAM(which), 0)
+			forgetOwnedImageList(win.HIMAGELIST(h)) // will be destroyed by the control itself
+		}
+	})
+
 	me.subclassEvents.WmGetDlgCode(func(p WmGetDlgCode) co.DLGC {
 		if !p.IsQuery() && p.VirtualKeyCode() == co.VK_RETURN { // Enter key
 			iCode := int32(co.LVN_KEYDOWN)
@@ -136,11 +155,35 @@ func (me *ListView) defaultMessageHandlers(parent Parent) {
 		return 0 // ignored
 	})
 
+	parent.base().afterUserEvents.WmNotify(me.ctrlId, co.LVN_DELETEALLITEMS, func(_ unsafe.Pointer) uintptr {
+		// If a user handler suppresses the subsequent LVN_DELETEITEM
+		// notifications, the data would never be released.
+		me.itemsData = make(map[int]interface{})
+		return 0 // ignored
+	})
+
+	parent.base().beforeUserEvents.WmNotify(me.ctrlId, co.LVN_COLUMNCLICK, func(p unsafe.Pointer) uintptr {
+		nmlv := (*win.NMLISTVIEW)(p)
+		col := int(nmlv.ISubItem)
+		_, isSortable := me.sortCmps[col]
+		if me.ownerData != nil { // rows are not stored in the control
+			_, isSortable = me.ownerData.src.(ListViewDataSourceSorter)
+		}
+		if isSortable {
+			ascending := col != me.sortCol || me.sortDesc // same column toggles
+			me.Cols.Get(col).Sort(ascending)
+		}
+		return 0 // ignored
+	})
+
 	parent.base().afterUserEvents.WmDestroy(func() {
 		if me.hContextMenu != 0 {
 			me.hContextMenu.DestroyMenu()
 		}
 	})
+
+	me.ownerDataMessageHandlers(parent)
+	me.editorMessageHandlers(parent)
 }
 
 func (me *ListView) showContextMenu(followCursor, hasCtrl, hasShift bool) {
@@ -189,10 +232,7 @@ func (me *ListView) assignOrClearHeader() {
 }
 
 // Exposes all the control notifications the can be handled.
-//
-// Panics if called after the control has been created.
 func (me *ListView) On() *EventsListView {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
@@ -224,7 +264,7 @@ func (me *ListView) ImageList(which co.LVSIL) win.HIMAGELIST {
 		if which == co.LVSIL_NORMAL {
 			cx, cy = 32, 32
 		}
-		hImg, _ = win.ImageListCreate(uint(cx), uint(cy), co.ILC_COLOR32, 1, 1)
+		hImg = createOwnedImageList(cx, cy)
 		me.hWnd.SendMessage(co.LVM_SETIMAGELIST, win.WPARAM(which), win.LPARAM(hImg))
 	}
 	return hImg
@@ -355,6 +395,9 @@ func (o *VarOptsListView) Size(cx int, cy int) *VarOptsListView {
 // Since the image lists are managed by the control, co.LVS_SHAREIMAGELISTS
 // won't be allowed.
 //
+// Use co.LVS_OWNERDATA for a virtual list view, whose items are provided by
+// [ListView.SetDataSource].
+//
 // Defaults to co.LVS_REPORT | co.LVS_NOSORTHEADER | co.LVS_SHOWSELALWAYS.
 //
 // [style]: https://learn.microsoft.com/en-us/windows/win32/controls/list-view-window-styles
@@ -409,8 +452,8 @@ type EventsListView struct {
 // [LVN_BEGINDRAG] message handler.
 //
 // [LVN_BEGINDRAG]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-begindrag
-func (me *EventsListView) LvnBeginDrag(fun func(p *win.NMLISTVIEW)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_BEGINDRAG, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnBeginDrag(fun func(p *win.NMLISTVIEW)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_BEGINDRAG, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLISTVIEW)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -419,8 +462,8 @@ func (me *EventsListView) LvnBeginDrag(fun func(p *win.NMLISTVIEW)) {
 // [LVN_BEGINLABELEDIT] message handler.
 //
 // [LVN_BEGINLABELEDIT]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-beginlabeledit
-func (me *EventsListView) LvnBeginLabelEdit(fun func(p *win.NMLVDISPINFO) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_BEGINLABELEDIT, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnBeginLabelEdit(fun func(p *win.NMLVDISPINFO) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_BEGINLABELEDIT, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMLVDISPINFO)(p)))
 	})
 }
@@ -428,8 +471,8 @@ func (me *EventsListView) LvnBeginLabelEdit(fun func(p *win.NMLVDISPINFO) bool)
 // [LVN_BEGINRDRAG] message handler.
 //
 // [LVN_BEGINRDRAG]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-beginrdrag
-func (me *EventsListView) LvnBeginRDrag(fun func(p *win.NMLISTVIEW)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_BEGINRDRAG, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnBeginRDrag(fun func(p *win.NMLISTVIEW)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_BEGINRDRAG, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLISTVIEW)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -438,8 +481,8 @@ func (me *EventsListView) LvnBeginRDrag(fun func(p *win.NMLISTVIEW)) {
 // [LVN_BEGINSCROLL] message handler.
 //
 // [LVN_BEGINSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-beginscroll
-func (me *EventsListView) LvnBeginScroll(fun func(p *win.NMLVSCROLL)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_BEGINSCROLL, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnBeginScroll(fun func(p *win.NMLVSCROLL)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_BEGINSCROLL, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLVSCROLL)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -448,8 +491,8 @@ func (me *EventsListView) LvnBeginScroll(fun func(p *win.NMLVSCROLL)) {
 // [LVN_COLUMNCLICK] message handler.
 //
 // [LVN_COLUMNCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-columnclick
-func (me *EventsListView) LvnColumnClick(fun func(p *win.NMLISTVIEW)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_COLUMNCLICK, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnColumnClick(fun func(p *win.NMLISTVIEW)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_COLUMNCLICK, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLISTVIEW)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -458,8 +501,8 @@ func (me *EventsListView) LvnColumnClick(fun func(p *win.NMLISTVIEW)) {
 // [LVN_COLUMNDROPDOWN] message handler.
 //
 // [LVN_COLUMNDROPDOWN]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-columndropdown
-func (me *EventsListView) LvnColumnDropDown(fun func(p *win.NMLISTVIEW)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_COLUMNDROPDOWN, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnColumnDropDown(fun func(p *win.NMLISTVIEW)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_COLUMNDROPDOWN, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLISTVIEW)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -468,8 +511,8 @@ func (me *EventsListView) LvnColumnDropDown(fun func(p *win.NMLISTVIEW)) {
 // [LVN_COLUMNOVERFLOWCLICK] message handler.
 //
 // [LVN_COLUMNOVERFLOWCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-columnoverflowclick
-func (me *EventsListView) LvnColumnOverflowClick(fun func(p *win.NMLISTVIEW)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_COLUMNOVERFLOWCLICK, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnColumnOverflowClick(fun func(p *win.NMLISTVIEW)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_COLUMNOVERFLOWCLICK, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLISTVIEW)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -478,8 +521,8 @@ func (me *EventsListView) LvnColumnOverflowClick(fun func(p *win.NMLISTVIEW)) {
 // [LVN_DELETEALLITEMS] message handler.
 //
 // [LVN_DELETEALLITEMS]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-deleteallitems
-func (me *EventsListView) LvnDeleteAllItems(fun func(p *win.NMLISTVIEW)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_DELETEALLITEMS, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnDeleteAllItems(fun func(p *win.NMLISTVIEW)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_DELETEALLITEMS, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLISTVIEW)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -488,8 +531,8 @@ func (me *EventsListView) LvnDeleteAllItems(fun func(p *win.NMLISTVIEW)) {
 // [LVN_DELETEITEM] message handler.
 //
 // [LVN_DELETEITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-deleteitem
-func (me *EventsListView) LvnDeleteItem(fun func(p *win.NMLISTVIEW)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_DELETEITEM, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnDeleteItem(fun func(p *win.NMLISTVIEW)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_DELETEITEM, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLISTVIEW)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -498,8 +541,8 @@ func (me *EventsListView) LvnDeleteItem(fun func(p *win.NMLISTVIEW)) {
 // [LVN_ENDLABELEDIT] message handler.
 //
 // [LVN_ENDLABELEDIT]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-endlabeledit
-func (me *EventsListView) LvnEndLabelEdit(fun func(p *win.NMLVDISPINFO) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_ENDLABELEDIT, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnEndLabelEdit(fun func(p *win.NMLVDISPINFO) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_ENDLABELEDIT, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMLVDISPINFO)(p)))
 	})
 }
@@ -507,8 +550,8 @@ func (me *EventsListView) LvnEndLabelEdit(fun func(p *win.NMLVDISPINFO) bool) {
 // [LVN_ENDSCROLL] message handler.
 //
 // [LVN_ENDSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-endscroll
-func (me *EventsListView) LvnEndScroll(fun func(p *win.NMLVSCROLL)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_ENDSCROLL, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnEndScroll(fun func(p *win.NMLVSCROLL)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_ENDSCROLL, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLVSCROLL)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -517,8 +560,8 @@ func (me *EventsListView) LvnEndScroll(fun func(p *win.NMLVSCROLL)) {
 // [LVN_GETDISPINFO] message handler.
 //
 // [LVN_GETDISPINFO]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-getdispinfo
-func (me *EventsListView) LvnGetDispInfo(fun func(p *win.NMLVDISPINFO)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_GETDISPINFO, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnGetDispInfo(fun func(p *win.NMLVDISPINFO)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_GETDISPINFO, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLVDISPINFO)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -527,8 +570,8 @@ func (me *EventsListView) LvnGetDispInfo(fun func(p *win.NMLVDISPINFO)) {
 // [LVN_GETEMPTYMARKUP] message handler.
 //
 // [LVN_GETEMPTYMARKUP]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-getemptymarkup
-func (me *EventsListView) LvnGetEmptyMarkup(fun func(p *win.NMLVEMPTYMARKUP) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_GETEMPTYMARKUP, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnGetEmptyMarkup(fun func(p *win.NMLVEMPTYMARKUP) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_GETEMPTYMARKUP, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMLVEMPTYMARKUP)(p)))
 	})
 }
@@ -536,8 +579,8 @@ func (me *EventsListView) LvnGetEmptyMarkup(fun func(p *win.NMLVEMPTYMARKUP) boo
 // [LVN_GETINFOTIP] message handler.
 //
 // [LVN_GETINFOTIP]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-getinfotip
-func (me *EventsListView) LvnGetInfoTip(fun func(p *win.NMLVGETINFOTIP)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_GETINFOTIP, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnGetInfoTip(fun func(p *win.NMLVGETINFOTIP)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_GETINFOTIP, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLVGETINFOTIP)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -546,8 +589,8 @@ func (me *EventsListView) LvnGetInfoTip(fun func(p *win.NMLVGETINFOTIP)) {
 // [LVN_HOTTRACK] message handler.
 //
 // [LVN_HOTTRACK]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-hottrack
-func (me *EventsListView) LvnHotTrack(fun func(p *win.NMLISTVIEW) int) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_HOTTRACK, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnHotTrack(fun func(p *win.NMLISTVIEW) int) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_HOTTRACK, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMLISTVIEW)(p)))
 	})
 }
@@ -555,8 +598,8 @@ func (me *EventsListView) LvnHotTrack(fun func(p *win.NMLISTVIEW) int) {
 // [LVN_INCREMENTALSEARCH] message handler.
 //
 // [LVN_INCREMENTALSEARCH]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-incrementalsearch
-func (me *EventsListView) LvnIncrementalSearch(fun func(p *win.NMLVFINDITEM) int) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_INCREMENTALSEARCH, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnIncrementalSearch(fun func(p *win.NMLVFINDITEM) int) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_INCREMENTALSEARCH, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMLVFINDITEM)(p)))
 	})
 }
@@ -564,8 +607,8 @@ func (me *EventsListView) LvnIncrementalSearch(fun func(p *win.NMLVFINDITEM) int
 // [LVN_INSERTITEM] message handler.
 //
 // [LVN_INSERTITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-insertitem
-func (me *EventsListView) LvnInsertItem(fun func(p *win.NMLISTVIEW)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_INSERTITEM, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnInsertItem(fun func(p *win.NMLISTVIEW)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_INSERTITEM, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLISTVIEW)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -574,8 +617,8 @@ func (me *EventsListView) LvnInsertItem(fun func(p *win.NMLISTVIEW)) {
 // [LVN_ITEMACTIVATE] message handler.
 //
 // [LVN_ITEMACTIVATE]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-itemactivate
-func (me *EventsListView) LvnItemActivate(fun func(p *win.NMITEMACTIVATE)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_ITEMACTIVATE, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnItemActivate(fun func(p *win.NMITEMACTIVATE)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_ITEMACTIVATE, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMITEMACTIVATE)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -584,8 +627,8 @@ func (me *EventsListView) LvnItemActivate(fun func(p *win.NMITEMACTIVATE)) {
 // [LVN_ITEMCHANGED] message handler.
 //
 // [LVN_ITEMCHANGED]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-itemchanged
-func (me *EventsListView) LvnItemChanged(fun func(p *win.NMLISTVIEW)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_ITEMCHANGED, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnItemChanged(fun func(p *win.NMLISTVIEW)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_ITEMCHANGED, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLISTVIEW)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -594,8 +637,8 @@ func (me *EventsListView) LvnItemChanged(fun func(p *win.NMLISTVIEW)) {
 // [LVN_ITEMCHANGING] message handler.
 //
 // [LVN_ITEMCHANGING]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-itemchanging
-func (me *EventsListView) LvnItemChanging(fun func(p *win.NMLISTVIEW) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_ITEMCHANGING, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnItemChanging(fun func(p *win.NMLISTVIEW) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_ITEMCHANGING, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMLISTVIEW)(p)))
 	})
 }
@@ -603,8 +646,8 @@ func (me *EventsListView) LvnItemChanging(fun func(p *win.NMLISTVIEW) bool) {
 // [LVN_KEYDOWN] message handler.
 //
 // [LVN_KEYDOWN]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-keydown
-func (me *EventsListView) LvnKeyDown(fun func(p *win.NMLVKEYDOWN)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_KEYDOWN, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnKeyDown(fun func(p *win.NMLVKEYDOWN)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_KEYDOWN, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLVKEYDOWN)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -613,8 +656,8 @@ func (me *EventsListView) LvnKeyDown(fun func(p *win.NMLVKEYDOWN)) {
 // [LVN_LINKCLICK] message handler.
 //
 // [LVN_LINKCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-linkclick
-func (me *EventsListView) LvnLinkClick(fun func(p *win.NMLVLINK)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_LINKCLICK, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnLinkClick(fun func(p *win.NMLVLINK)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_LINKCLICK, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLVLINK)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -623,8 +666,8 @@ func (me *EventsListView) LvnLinkClick(fun func(p *win.NMLVLINK)) {
 // [LVN_MARQUEEBEGIN] message handler.
 //
 // [LVN_MARQUEEBEGIN]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-marqueebegin
-func (me *EventsListView) LvnMarqueeBegin(fun func() uint) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_MARQUEEBEGIN, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnMarqueeBegin(fun func() uint) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_MARQUEEBEGIN, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun())
 	})
 }
@@ -632,8 +675,8 @@ func (me *EventsListView) LvnMarqueeBegin(fun func() uint) {
 // [LVN_ODCACHEHINT] message handler.
 //
 // [LVN_ODCACHEHINT]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-odcachehint
-func (me *EventsListView) LvnODCacheHint(fun func(p *win.NMLVCACHEHINT)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_ODCACHEHINT, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnODCacheHint(fun func(p *win.NMLVCACHEHINT)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_ODCACHEHINT, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLVCACHEHINT)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -642,8 +685,8 @@ func (me *EventsListView) LvnODCacheHint(fun func(p *win.NMLVCACHEHINT)) {
 // [LVN_ODFINDITEM] message handler.
 //
 // [LVN_ODFINDITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-odfinditem
-func (me *EventsListView) LvnODFindItem(fun func(p *win.NMLVFINDITEM) int) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_ODFINDITEM, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnODFindItem(fun func(p *win.NMLVFINDITEM) int) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_ODFINDITEM, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMLVFINDITEM)(p)))
 	})
 }
@@ -651,8 +694,8 @@ func (me *EventsListView) LvnODFindItem(fun func(p *win.NMLVFINDITEM) int) {
 // [LVN_ODSTATECHANGED] message handler.
 //
 // [LVN_ODSTATECHANGED]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-odstatechanged
-func (me *EventsListView) LvnODStateChanged(fun func(p *win.NMLVODSTATECHANGE)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_ODSTATECHANGED, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnODStateChanged(fun func(p *win.NMLVODSTATECHANGE)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_ODSTATECHANGED, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLVODSTATECHANGE)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -661,8 +704,8 @@ func (me *EventsListView) LvnODStateChanged(fun func(p *win.NMLVODSTATECHANGE))
 // [LVN_SETDISPINFO] message handler.
 //
 // [LVN_SETDISPINFO]: https://learn.microsoft.com/en-us/windows/win32/controls/lvn-setdispinfo
-func (me *EventsListView) LvnSetDispInfo(fun func(p *win.NMLVDISPINFO)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.LVN_SETDISPINFO, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) LvnSetDispInfo(fun func(p *win.NMLVDISPINFO)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.LVN_SETDISPINFO, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLVDISPINFO)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -671,8 +714,8 @@ func (me *EventsListView) LvnSetDispInfo(fun func(p *win.NMLVDISPINFO)) {
 // [NM_CLICK] message handler.
 //
 // [NM_CLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-click-list-view
-func (me *EventsListView) NmClick(fun func(p *win.NMITEMACTIVATE)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_CLICK, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) NmClick(fun func(p *win.NMITEMACTIVATE)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_CLICK, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMITEMACTIVATE)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -681,8 +724,8 @@ func (me *EventsListView) NmClick(fun func(p *win.NMITEMACTIVATE)) {
 // [NM_CUSTOMDRAW] message handler.
 //
 // [NM_CUSTOMDRAW]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-customdraw-list-view
-func (me *EventsListView) NmCustomDraw(fun func(p *win.NMLVCUSTOMDRAW) co.CDRF) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_CUSTOMDRAW, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) NmCustomDraw(fun func(p *win.NMLVCUSTOMDRAW) co.CDRF) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_CUSTOMDRAW, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMLVCUSTOMDRAW)(p)))
 	})
 }
@@ -690,8 +733,8 @@ func (me *EventsListView) NmCustomDraw(fun func(p *win.NMLVCUSTOMDRAW) co.CDRF)
 // [NM_DBLCLK] message handler.
 //
 // [NM_DBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-dblclk-list-view
-func (me *EventsListView) NmDblClk(fun func(p *win.NMITEMACTIVATE)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_DBLCLK, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) NmDblClk(fun func(p *win.NMITEMACTIVATE)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_DBLCLK, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMITEMACTIVATE)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -700,8 +743,8 @@ func (me *EventsListView) NmDblClk(fun func(p *win.NMITEMACTIVATE)) {
 // [NM_HOVER] message handler.
 //
 // [NM_HOVER]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-hover-list-view
-func (me *EventsListView) NmHover(fun func() uint) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_HOVER, func(_ unsafe.Pointer) uintptr {
+func (me *EventsListView) NmHover(fun func() uint) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_HOVER, func(_ unsafe.Pointer) uintptr {
 		return uintptr(fun())
 	})
 }
@@ -709,8 +752,8 @@ func (me *EventsListView) NmHover(fun func() uint) {
 // [NM_KILLFOCUS] message handler.
 //
 // [NM_KILLFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-killfocus-list-view
-func (me *EventsListView) NmKillFocus(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_KILLFOCUS, func(_ unsafe.Pointer) uintptr {
+func (me *EventsListView) NmKillFocus(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_KILLFOCUS, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -719,8 +762,8 @@ func (me *EventsListView) NmKillFocus(fun func()) {
 // [NM_RCLICK] message handler.
 //
 // [NM_RCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-rclick-list-view
-func (me *EventsListView) NmRClick(fun func(p *win.NMITEMACTIVATE)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RCLICK, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) NmRClick(fun func(p *win.NMITEMACTIVATE)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RCLICK, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMITEMACTIVATE)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -729,8 +772,8 @@ func (me *EventsListView) NmRClick(fun func(p *win.NMITEMACTIVATE)) {
 // [NM_RDBLCLK] message handler.
 //
 // [NM_RDBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-rdblclk-list-view
-func (me *EventsListView) NmRDblClk(fun func(p *win.NMITEMACTIVATE)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RDBLCLK, func(p unsafe.Pointer) uintptr {
+func (me *EventsListView) NmRDblClk(fun func(p *win.NMITEMACTIVATE)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RDBLCLK, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMITEMACTIVATE)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -739,8 +782,8 @@ func (me *EventsListView) NmRDblClk(fun func(p *win.NMITEMACTIVATE)) {
 // [NM_RELEASEDCAPTURE] message handler.
 //
 // [NM_RELEASEDCAPTURE]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-releasedcapture-list-view-
-func (me *EventsListView) NmReleasedCapture(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) uintptr {
+func (me *EventsListView) NmReleasedCapture(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -749,8 +792,8 @@ func (me *EventsListView) NmReleasedCapture(fun func()) {
 // [NM_RETURN] message handler.
 //
 // [NM_RETURN]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-return-list-view-
-func (me *EventsListView) NmReturn(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RETURN, func(_ unsafe.Pointer) uintptr {
+func (me *EventsListView) NmReturn(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RETURN, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -759,8 +802,8 @@ func (me *EventsListView) NmReturn(fun func()) {
 // [NM_SETFOCUS] message handler.
 //
 // [NM_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-setfocus-list-view-
-func (me *EventsListView) NmSetFocus(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_SETFOCUS, func(_ unsafe.Pointer) uintptr {
+func (me *EventsListView) NmSetFocus(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_SETFOCUS, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -102,6 +102,69 @@ func (me ListViewCol) SelectedTexts() []string {
 	return texts
 }
 
+// Sets the function used to compare the texts of the items under this column,
+// which makes the column sortable: clicking its header sorts the items,
+// toggling between ascending and descending order. Usual comparers are
+// [wstr.CmpI], [wstr.CmpNatural], [wstr.CmpBytes] and [wstr.CmpDate].
+//
+// If the list view has a header, the co.HDS_BUTTONS style is added to it, so
+// the columns can be clicked even if the list view has the
+// co.LVS_NOSORTHEADER style.
+//
+// Not supported by list views with the co.LVS_OWNERDATA style, whose data
+// source must implement [ListViewDataSourceSorter] instead.
+//
+// Returns the same column, so further operations can be chained.
+//
+// # Example
+//
+//	var list ui.ListView // initialized somewhere
+//
+//	list.Cols.Get(0).SetComparer(wstr.CmpNatural)
+//	list.Cols.Get(1).SetComparer(wstr.CmpDate("2006-01-02"))
+//	list.Cols.Get(2).SetComparer(func(a, b string) int {
+//		return wstr.Cmp(wstr.RemoveDiacritics(a), wstr.RemoveDiacritics(b))
+//	})
+func (me ListViewCol) SetComparer(fun func(a, b string) int) ListViewCol {
+	me.owner.sortCmps[int(me.index)] = fun
+
+	if me.owner.Header() != nil {
+		hHeader := me.owner.header.Hwnd()
+		style, _ := hHeader.Style()
+		if (co.HDS(style) & co.HDS_BUTTONS) == 0 {
+			hHeader.SetWindowLongPtr(co.GWLP_STYLE, uintptr(style|co.WS(co.HDS_BUTTONS)))
+		}
+	}
+	return me
+}
+
+// Sets the in-place editor of the cells under this column, which makes them
+// editable: the editor is shown when the user double-clicks a cell, or presses
+// F2 on the focused item, which edits its first editable column. Passing nil
+// makes the column read-only.
+//
+// While editing, Tab and Shift+Tab move to the next and the previous editable
+// cells, Enter saves the text, and Esc discards it.
+//
+// Returns the same column, so further operations can be chained.
+//
+// # Example
+//
+//	var list ui.ListView // initialized somewhere
+//
+//	list.Cols.Get(1).SetEditor(
+//		ui.OptsListViewEditor().
+//			DateTimePicker("2006-01-02"),
+//	)
+func (me ListViewCol) SetEditor(opts *VarOptsListViewEditor) ListViewCol {
+	if opts == nil {
+		delete(me.owner.editors, int(me.index))
+	} else {
+		me.owner.editors[int(me.index)] = opts
+	}
+	return me
+}
+
 // Sets the displayed sort arrow with [LVM_GETHEADER] and [HDM_SETITEM].
 //
 // Possible values:
@@ -197,6 +260,58 @@ func (me ListViewCol) SetWidthToFill() ListViewCol {
 	return me
 }
 
+// Sorts the items by the texts under this column, using the comparer set with
+// [ListViewCol.SetComparer], or [wstr.CmpI] if none. The sort arrows of the
+// header are updated, and the selected and focused items are kept, with the
+// focused item scrolled into view.
+//
+// For list views with the co.LVS_OWNERDATA style, the sorting is delegated to
+// the data source, if it implements [ListViewDataSourceSorter]; otherwise,
+// nothing is done. Since the list view keeps the selection by row index, it
+// doesn't follow the sorted rows.
+//
+// Returns the same column, so further operations can be chained.
+func (me ListViewCol) Sort(ascending bool) ListViewCol {
+	colIdx := me.Index()
+	if me.owner.ownerData != nil {
+		sorter, ok := me.owner.ownerData.src.(ListViewDataSourceSorter)
+		if !ok {
+			return me
+		}
+		sorter.Sort(colIdx, ascending)
+		me.owner.RefreshData()
+	} else {
+		cmp, ok := me.owner.sortCmps[colIdx]
+		if !ok {
+			cmp = wstr.CmpI
+		}
+		me.owner.Items.Sort(func(a, b ListViewItem) int { // selection and focus move along with the items
+			if ascending {
+				return cmp(a.Text(colIdx), b.Text(colIdx))
+			}
+			return cmp(b.Text(colIdx), a.Text(colIdx))
+		})
+	}
+	me.owner.sortCol = colIdx
+	me.owner.sortDesc = !ascending
+
+	if me.owner.Header() != nil {
+		for _, col := range me.owner.Cols.All() {
+			col.SetSortArrow(co.HDF_NONE)
+		}
+		if ascending {
+			me.SetSortArrow(co.HDF_SORTUP)
+		} else {
+			me.SetSortArrow(co.HDF_SORTDOWN)
+		}
+	}
+
+	if focused, hasFocused := me.owner.Items.Focused(); hasFocused {
+		focused.EnsureVisible()
+	}
+	return me
+}
+
 // Retrieves the displayed sort arrow with [HDM_GETITEM].
 //
 // Possible values:
@@ -0,0 +1,211 @@
+//go:build windows
+
+package ui
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// Source of the items of a [ListView] created with the co.LVS_OWNERDATA style,
+// also known as virtual list view. The items are not stored in the control:
+// their texts are retrieved on demand, only when they're about to be
+// displayed, so the list view can hold millions of rows.
+//
+// The source may also implement [ListViewDataSourceIcons],
+// [ListViewDataSourceFinder], [ListViewDataSourceCacher] and
+// [ListViewDataSourceSorter].
+//
+// Set with [ListView.SetDataSource].
+type ListViewDataSource interface {
+	// Returns the number of rows.
+	RowCount() int
+
+	// Returns the text of the given row and column.
+	Cell(row, col int) string
+}
+
+// Optional interface of a [ListViewDataSource], which provides the icons of
+// the rows.
+type ListViewDataSourceIcons interface {
+	// Returns the index of the icon of the given row, within the image lists
+	// of the list view, or -1 for none.
+	Icon(row int) int
+}
+
+// Optional interface of a [ListViewDataSource], which allows the user to jump
+// to a row by typing its text.
+type ListViewDataSourceFinder interface {
+	// Returns the index of the first row, starting at start, whose text in
+	// the first column begins with the given text, or -1 if not found.
+	Find(text string, start int) int
+}
+
+// Optional interface of a [ListViewDataSource], which allows the rows to be
+// loaded in batches, before being displayed.
+type ListViewDataSourceCacher interface {
+	// Called when the rows from..to, inclusive, are about to be displayed.
+	CacheHint(from, to int)
+}
+
+// Optional interface of a [ListViewDataSource], which sorts the rows when a
+// column header is clicked, since the list view cannot sort rows it doesn't
+// store. Without it, clicking the headers does nothing.
+type ListViewDataSourceSorter interface {
+	// Sorts the rows by the given column. The list view is refreshed
+	// afterwards.
+	Sort(col int, ascending bool)
+}
+
+// Owner-data state of a list view.
+type _ListViewOwnerData struct {
+	src       ListViewDataSource
+	cacheFrom int        // row of cache[0]
+	cache     [][]string // texts of the rows hinted by the last LVN_ODCACHEHINT
+	findToken EventToken // LVN_ODFINDITEM handler, if the source is a finder
+}
+
+// Returns the text of the cell, from the cache if possible.
+func (me *_ListViewOwnerData) cell(row, col int) string {
+	if idx := row - me.cacheFrom; idx >= 0 && idx < len(me.cache) {
+		if cells := me.cache[idx]; col < len(cells) {
+			return cells[col]
+		}
+	}
+	return me.src.Cell(row, col)
+}
+
+// Loads the texts of the rows into the cache, unless they're already there.
+func (me *_ListViewOwnerData) fillCache(from, to, numCols int) {
+	if from >= me.cacheFrom && to < me.cacheFrom+len(me.cache) {
+		return // already cached
+	}
+
+	if cacher, ok := me.src.(ListViewDataSourceCacher); ok {
+		cacher.CacheHint(from, to)
+	}
+
+	me.cacheFrom = from
+	me.cache = make([][]string, 0, to-from+1)
+	for row := from; row <= to; row++ {
+		cells := make([]string, numCols)
+		for col := range cells {
+			cells[col] = me.src.Cell(row, col)
+		}
+		me.cache = append(me.cache, cells)
+	}
+}
+
+func (me *_ListViewOwnerData) clearCache() {
+	me.cacheFrom = 0
+	me.cache = nil
+}
+
+func (me *ListView) ownerDataMessageHandlers(parent Parent) {
+	parent.base().beforeUserEvents.WmNotify(me.ctrlId, co.LVN_GETDISPINFO, func(p unsafe.Pointer) uintptr {
+		if me.ownerData == nil {
+			return 0 // ignored
+		}
+		item := &(*win.NMLVDISPINFO)(p).Item
+		row := int(item.IItem)
+
+		if (item.Mask & co.LVIF_TEXT) != 0 {
+			if buf := item.PszText(); len(buf) > 0 {
+				wstr.EncodeToBuf(me.ownerData.cell(row, int(item.ISubItem)), buf)
+			}
+		}
+		if (item.Mask & co.LVIF_IMAGE) != 0 {
+			if icons, ok := me.ownerData.src.(ListViewDataSourceIcons); ok {
+				item.IImage = int32(icons.Icon(row))
+			}
+		}
+		return 0 // ignored
+	})
+
+	parent.base().beforeUserEvents.WmNotify(me.ctrlId, co.LVN_ODCACHEHINT, func(p unsafe.Pointer) uintptr {
+		if me.ownerData != nil {
+			nmc := (*win.NMLVCACHEHINT)(p)
+			me.ownerData.fillCache(int(nmc.IFrom), int(nmc.ITo), int(me.Cols.Count()))
+		}
+		return 0 // ignored
+	})
+}
+
+// Sets the source of the items of a list view created with the
+// co.LVS_OWNERDATA style, then calls [ListView.RefreshData].
+//
+// The selection is kept by the list view itself, so
+// [CollectionListViewItems.Selected] and the other selection methods work as
+// usual, regardless of the number of rows. Item data, as set with
+// [ListViewItem.SetData], is not supported.
+//
+// If the source implements [ListViewDataSourceFinder], any
+// [EventsListView.LvnODFindItem] handler is overridden.
+//
+// Returns the same object, so further operations can be chained.
+//
+// Panics if the list view doesn't have the co.LVS_OWNERDATA style.
+//
+// # Example
+//
+//	type LogTable struct {
+//		lines []string
+//	}
+//
+//	func (t *LogTable) RowCount() int            { return len(t.lines) }
+//	func (t *LogTable) Cell(row, col int) string { return t.lines[row] }
+//
+//	var lv ui.ListView // initialized somewhere
+//
+//	lv.SetDataSource(&LogTable{lines: loadLog()})
+func (me *ListView) SetDataSource(src ListViewDataSource) *ListView {
+	stylesRet, _ := me.hWnd.GetWindowLongPtr(co.GWLP_STYLE)
+	if (co.LVS(stylesRet) & co.LVS_OWNERDATA) == 0 {
+		panic("ListView must have the LVS_OWNERDATA style to use a data source.")
+	}
+
+	if me.ownerData != nil {
+		me.ownerData.findToken.Remove()
+	}
+	me.ownerData = &_ListViewOwnerData{src: src}
+
+	if finder, ok := src.(ListViewDataSourceFinder); ok {
+		me.ownerData.findToken = me.events.parentEvents.WmNotify(me.ctrlId, co.LVN_ODFINDITEM,
+			func(p unsafe.Pointer) uintptr {
+				nmf := (*win.NMLVFINDITEM)(p)
+				if (nmf.Lvfi.Flags & (co.LVFI_STRING | co.LVFI_PARTIAL)) == 0 {
+					return ^uintptr(0) // -1, only text searches are supported
+				}
+				text := wstr.DecodePtr(nmf.Lvfi.Psz)
+				return uintptr(finder.Find(text, int(nmf.IStart)))
+			})
+	}
+
+	return me.RefreshData()
+}
+
+// Discards the cached texts, and reloads the number of rows from the data
+// source with [LVM_SETITEMCOUNT], repainting the list view. Call this method
+// whenever the data of the source changes.
+//
+// Returns the same object, so further operations can be chained.
+//
+// Panics if no data source was set.
+//
+// [LVM_SETITEMCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-setitemcount
+func (me *ListView) RefreshData() *ListView {
+	if me.ownerData == nil {
+		panic("No data source set, cannot refresh.")
+	}
+
+	me.ownerData.clearCache()
+	ret, err := me.hWnd.SendMessage(co.LVM_SETITEMCOUNT,
+		win.WPARAM(me.ownerData.src.RowCount()), win.LPARAM(co.LVSICF_NOSCROLL))
+	if err != nil || ret == 0 {
+		panic("LVM_SETITEMCOUNT failed.")
+	}
+	return me
+}
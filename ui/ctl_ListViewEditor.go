@@ -0,0 +1,366 @@
+//go:build windows
+
+package ui
+
+import (
+	"time"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// Kind of in-place cell editor.
+type _LVED uint8
+
+const (
+	_LVED_EDIT _LVED = iota
+	_LVED_COMBOBOX
+	_LVED_DATETIMEPICKER
+)
+
+// Options for the in-place cell editor of a [ListView] column, set with
+// [ListViewCol.SetEditor]; returned by [OptsListViewEditor].
+type VarOptsListViewEditor struct {
+	kind       _LVED
+	choices    []string
+	dateLayout string
+	validate   func(item ListViewItem, text string) bool
+}
+
+// Options for [ListViewCol.SetEditor].
+//
+// # Example
+//
+//	var list ui.ListView // initialized somewhere
+//
+//	list.Cols.Get(1).SetEditor(
+//		ui.OptsListViewEditor().
+//			Validate(func(item ui.ListViewItem, text string) bool {
+//				_, err := strconv.Atoi(text)
+//				return err == nil
+//			}),
+//	)
+//	list.Cols.Get(2).SetEditor(
+//		ui.OptsListViewEditor().
+//			ComboBox("Low", "Medium", "High"),
+//	)
+func OptsListViewEditor() *VarOptsListViewEditor {
+	return &VarOptsListViewEditor{
+		kind: _LVED_EDIT,
+	}
+}
+
+// Uses a drop-down list [ComboBox] as the editor, with the given choices.
+//
+// Defaults to an [Edit].
+func (o *VarOptsListViewEditor) ComboBox(choices ...string) *VarOptsListViewEditor {
+	o.kind = _LVED_COMBOBOX
+	o.choices = choices
+	return o
+}
+
+// Uses a [DateTimePicker] as the editor. The cell texts are parsed and
+// formatted with the given [time.Parse] layout.
+//
+// Defaults to an [Edit].
+func (o *VarOptsListViewEditor) DateTimePicker(layout string) *VarOptsListViewEditor {
+	o.kind = _LVED_DATETIMEPICKER
+	o.dateLayout = layout
+	return o
+}
+
+// Function called with the new text before it's written to the cell. Return
+// false to reject it: the editor stays open, or, if it lost the focus, the
+// edition is cancelled.
+//
+// Defaults to none.
+func (o *VarOptsListViewEditor) Validate(fun func(item ListViewItem, text string) bool) *VarOptsListViewEditor {
+	o.validate = fun
+	return o
+}
+
+// A cell being edited.
+type _ListViewCellEdit struct {
+	ctrl _BaseCtrl
+	opts *VarOptsListViewEditor
+	item ListViewItem
+	col  int
+}
+
+// Returns the current text of the editor.
+func (me *_ListViewCellEdit) text() string {
+	if me.opts.kind == _LVED_DATETIMEPICKER {
+		var st win.SYSTEMTIME
+		ret, _ := me.ctrl.hWnd.SendMessage(co.DTM_GETSYSTEMTIME,
+			0, win.LPARAM(unsafe.Pointer(&st)))
+		if co.GDT(ret) != co.GDT_VALID {
+			return ""
+		}
+		return st.ToTime().Format(me.opts.dateLayout)
+	}
+
+	text, _ := me.ctrl.hWnd.GetWindowText()
+	return text
+}
+
+func (me *ListView) editorMessageHandlers(parent Parent) {
+	parent.base().beforeUserEvents.WmNotify(me.ctrlId, co.NM_DBLCLK, func(p unsafe.Pointer) uintptr {
+		nmi := (*win.NMITEMACTIVATE)(p)
+		if _, isEditable := me.editors[int(nmi.ISubItem)]; isEditable && nmi.IItem != -1 {
+			me.beginEdit(me.Items.Get(int(nmi.IItem)), int(nmi.ISubItem))
+		}
+		return 0 // ignored
+	})
+
+	parent.base().beforeUserEvents.WmNotify(me.ctrlId, co.LVN_KEYDOWN, func(p unsafe.Pointer) uintptr {
+		nmk := (*win.NMLVKEYDOWN)(p)
+		if nmk.WVKey == co.VK_F2 {
+			if focused, hasFocused := me.Items.Focused(); hasFocused {
+				if cols := me.editableCols(); len(cols) > 0 {
+					me.beginEdit(focused, cols[0])
+				}
+			}
+		}
+		return 0 // ignored
+	})
+
+	parent.base().beforeUserEvents.WmNotify(me.ctrlId, co.LVN_BEGINSCROLL, func(_ unsafe.Pointer) uintptr {
+		if !me.endEdit(true) {
+			me.endEdit(false) // the editor doesn't follow the scroll
+		}
+		return 0 // ignored
+	})
+}
+
+// Returns the indexes of the columns with an editor, in ascending order.
+func (me *ListView) editableCols() []int {
+	cols := make([]int, 0, len(me.editors))
+	numCols := int(me.Cols.Count())
+	for i := 0; i < numCols; i++ {
+		if _, isEditable := me.editors[i]; isEditable {
+			cols = append(cols, i)
+		}
+	}
+	return cols
+}
+
+// Returns the rectangle of the cell, relative to the list view, scrolling it
+// horizontally into view if needed.
+func (me *ListView) cellRectInView(item ListViewItem, col int) win.RECT {
+	portion := co.LVIR_BOUNDS
+	if col == 0 {
+		portion = co.LVIR_LABEL // bounds of column 0 is the whole row
+	}
+
+	item.EnsureVisible()
+	rc := item.SubItemRect(col, portion)
+	rcClient, _ := me.hWnd.GetClientRect()
+
+	if rc.Left < 0 {
+		me.Scroll(int(rc.Left), 0)
+	} else if rc.Right > rcClient.Right {
+		dx := rc.Right - rcClient.Right
+		if dx > rc.Left {
+			dx = rc.Left
+		}
+		me.Scroll(int(dx), 0)
+	} else {
+		return rc
+	}
+	return item.SubItemRect(col, portion)
+}
+
+// Creates the editor over the cell, finishing any current edition.
+func (me *ListView) beginEdit(item ListViewItem, col int) {
+	opts, isEditable := me.editors[col]
+	if !isEditable {
+		panic("This ListView column has no editor.")
+	}
+	if !me.endEdit(true) {
+		return // current edition is not valid
+	}
+
+	rc := me.cellRectInView(item, col)
+	text := item.Text(col)
+	hInst, _ := me.hWnd.HInstance()
+	hFont, _ := me.hWnd.SendMessage(co.WM_GETFONT, 0, 0)
+
+	var className string
+	style := co.WS_CHILD | co.WS_VISIBLE
+	cy := rc.Bottom - rc.Top
+
+	switch opts.kind {
+	case _LVED_EDIT:
+		className = "EDIT"
+		style |= co.WS_BORDER | co.WS(co.ES_AUTOHSCROLL)
+	case _LVED_COMBOBOX:
+		className = "COMBOBOX"
+		style |= co.WS_VSCROLL | co.WS(co.CBS_DROPDOWNLIST)
+		cy += int32(DpiY(150)) // room for the drop-down list
+	case _LVED_DATETIMEPICKER:
+		className = "SysDateTimePick32"
+	}
+
+	edit := &_ListViewCellEdit{
+		ctrl: newBaseCtrl(0),
+		opts: opts,
+		item: item,
+		col:  col,
+	}
+	hEdit, err := win.CreateWindowEx(co.WS_EX_NONE, win.ClassNameStr(className),
+		"", style, int(rc.Left), int(rc.Top), uint(rc.Right-rc.Left), uint(cy),
+		me.hWnd, win.HMENU(0), hInst, win.LPARAM(0))
+	if err != nil {
+		panic(err)
+	}
+	edit.ctrl.hWnd = hEdit
+	edit.ctrl.hWnd.SendMessage(co.WM_SETFONT, win.WPARAM(hFont), 0)
+
+	switch opts.kind {
+	case _LVED_EDIT:
+		edit.ctrl.hWnd.SetWindowText(text)
+		edit.ctrl.hWnd.SendMessage(co.EM_SETSEL, 0, ^win.LPARAM(0))
+	case _LVED_COMBOBOX:
+		wbuf := wstr.NewBufEncoder()
+		defer wbuf.Free()
+
+		for _, choice := range opts.choices {
+			edit.ctrl.hWnd.SendMessage(co.CB_ADDSTRING,
+				0, win.LPARAM(wbuf.PtrAllowEmpty(choice)))
+			wbuf.Clear()
+		}
+		idx, _ := edit.ctrl.hWnd.SendMessage(co.CB_FINDSTRINGEXACT,
+			^win.WPARAM(0), win.LPARAM(wbuf.PtrAllowEmpty(text)))
+		edit.ctrl.hWnd.SendMessage(co.CB_SETCURSEL, win.WPARAM(idx), 0)
+	case _LVED_DATETIMEPICKER:
+		if t, err := time.ParseInLocation(opts.dateLayout, text, time.Local); err == nil {
+			var st win.SYSTEMTIME
+			st.SetTime(t)
+			edit.ctrl.hWnd.SendMessage(co.DTM_SETSYSTEMTIME,
+				win.WPARAM(co.GDT_VALID), win.LPARAM(unsafe.Pointer(&st)))
+		}
+	}
+
+	me.editing = edit
+	me.editorSubclass(edit)
+	edit.ctrl.hWnd.SetFocus()
+}
+
+// Handles the keyboard navigation and the focus loss of the editor.
+func (me *ListView) editorSubclass(edit *_ListViewCellEdit) {
+	hEdit := edit.ctrl.hWnd
+	events := &edit.ctrl.subclassEvents
+
+	events.WmGetDlgCode(func(p WmGetDlgCode) co.DLGC {
+		dlgcSystem := hEdit.DefSubclassProc(co.WM_GETDLGCODE, p.Raw.WParam, p.Raw.LParam)
+		return co.DLGC(dlgcSystem) | co.DLGC_WANTALLKEYS // receive Tab, Enter and Esc
+	})
+
+	events.Wm(co.WM_KEYDOWN, func(p Wm) uintptr {
+		if edit.opts.kind == _LVED_COMBOBOX {
+			if dropped, _ := hEdit.SendMessage(co.CB_GETDROPPEDSTATE, 0, 0); dropped != 0 {
+				return hEdit.DefSubclassProc(p.Msg, p.WParam, p.LParam) // Enter and Esc close the list
+			}
+		}
+
+		switch co.VK(p.WParam) {
+		case co.VK_TAB:
+			hasShift := (win.GetKeyState(co.VK_SHIFT) & 0x8000) != 0
+			me.moveEdit(!hasShift)
+		case co.VK_RETURN:
+			me.endEdit(true)
+		case co.VK_ESCAPE:
+			me.endEdit(false)
+		default:
+			return hEdit.DefSubclassProc(p.Msg, p.WParam, p.LParam)
+		}
+		return 0
+	})
+
+	events.Wm(co.WM_CHAR, func(p Wm) uintptr {
+		switch rune(p.WParam) {
+		case '\t', '\r', 0x1b: // already processed in WM_KEYDOWN; avoid the beep
+			return 0
+		}
+		return hEdit.DefSubclassProc(p.Msg, p.WParam, p.LParam)
+	})
+
+	events.Wm(co.WM_KILLFOCUS, func(p Wm) uintptr {
+		ret := hEdit.DefSubclassProc(p.Msg, p.WParam, p.LParam)
+		if edit.opts.kind == _LVED_DATETIMEPICKER {
+			if hCal, _ := hEdit.SendMessage(co.DTM_GETMONTHCAL, 0, 0); hCal != 0 {
+				return ret // focus went to the drop-down calendar
+			}
+		}
+		if me.editing == edit && !me.endEdit(true) {
+			me.endEdit(false)
+		}
+		return ret
+	})
+
+	edit.ctrl.installSubclass()
+}
+
+// Finishes the current edition, if any, writing the text to the cell if save
+// is true. Returns false if the text was rejected by the validation.
+func (me *ListView) endEdit(save bool) bool {
+	edit := me.editing
+	if edit == nil {
+		return true
+	}
+
+	if save {
+		text := edit.text()
+		if edit.opts.validate != nil && !edit.opts.validate(edit.item, text) {
+			return false
+		}
+		edit.item.SetText(edit.col, text)
+	}
+
+	me.editing = nil // before the focus change, so WM_KILLFOCUS ignores it
+	if win.GetFocus() == edit.ctrl.hWnd {
+		me.hWnd.SetFocus()
+	}
+	edit.ctrl.hWnd.DestroyWindow()
+	return true
+}
+
+// Finishes the current edition, and starts editing the next or the previous
+// editable cell, moving to another row if needed.
+func (me *ListView) moveEdit(forward bool) {
+	edit := me.editing
+	if !me.endEdit(true) {
+		return
+	}
+
+	cols := me.editableCols()
+	if len(cols) == 0 {
+		return
+	}
+	rowIdx, pos := edit.item.Index(), -1
+	for i, col := range cols {
+		if col == edit.col {
+			pos = i
+		}
+	}
+
+	if forward {
+		pos++
+		if pos == len(cols) {
+			pos = 0
+			rowIdx++
+		}
+	} else {
+		pos--
+		if pos < 0 {
+			pos = len(cols) - 1
+			rowIdx--
+		}
+	}
+
+	if rowIdx >= 0 && rowIdx < int(me.Items.Count()) {
+		me.beginEdit(me.Items.Get(rowIdx), cols[pos])
+	}
+}
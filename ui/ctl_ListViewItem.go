@@ -53,6 +53,14 @@ func (me ListViewItem) Data() interface{} {
 	return nil
 }
 
+// Shows the in-place editor over the cell of this item under the given column,
+// which must have an editor set with [ListViewCol.SetEditor].
+//
+// Panics if the column has no editor.
+func (me ListViewItem) EditCell(columnIndex int) {
+	me.owner.beginEdit(me, columnIndex)
+}
+
 // Makes sure the item is visible with [LVM_ENSUREVISIBLE], scrolling the
 // list view if needed.
 //
@@ -305,6 +313,29 @@ func (me ListViewItem) SetText(columnIndex int, text string) ListViewItem {
 	return me
 }
 
+// Retrieves the coordinates of the rectangle surrounding the subitem under the
+// given column with [LVM_GETSUBITEMRECT].
+//
+// For column 0, co.LVIR_BOUNDS returns the rectangle of the whole item; use
+// co.LVIR_LABEL to retrieve the rectangle of the subitem only.
+//
+// Panics on error.
+//
+// [LVM_GETSUBITEMRECT]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-getsubitemrect
+func (me ListViewItem) SubItemRect(columnIndex int, portion co.LVIR) win.RECT {
+	rcSubItem := win.RECT{
+		Top:  int32(columnIndex),
+		Left: int32(portion),
+	}
+
+	ret, err := me.owner.hWnd.SendMessage(co.LVM_GETSUBITEMRECT,
+		win.WPARAM(me.index), win.LPARAM(unsafe.Pointer(&rcSubItem)))
+	if err != nil || ret == 0 {
+		panic(fmt.Sprintf("LVM_GETSUBITEMRECT %d/%d failed.", me.index, columnIndex))
+	}
+	return rcSubItem // coordinates relative to the ListView
+}
+
 // Retrieves the text of the item, with [LVM_GETITEMTEXT].
 //
 // [LVM_GETITEMTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-getitemtext
@@ -0,0 +1,76 @@
+//go:build windows
+
+package ui
+
+// A [ListView] whose items carry user-custom data of type T, so the data
+// doesn't need to be type-asserted.
+//
+// The data is released automatically when the items are deleted.
+//
+// # Example
+//
+//	type Person struct {
+//		Name string
+//		Age  int
+//	}
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	lv := ui.NewListViewOf[*Person](wndOwner, ui.OptsListView())
+//
+//	wndOwner.On().WmCreate(func(_ ui.WmCreate) int {
+//		lv.Cols.Add("Name", ui.DpiX(120))
+//		lv.AddWithData(&Person{"John", 30}, "John")
+//		return 0
+//	})
+//
+//	lv.On().LvnItemActivate(func(p *win.NMITEMACTIVATE) {
+//		if person, ok := lv.Data(lv.Items.Get(int(p.IItem))); ok {
+//			println(person.Age)
+//		}
+//	})
+type ListViewOf[T any] struct {
+	*ListView
+}
+
+// Creates a new [ListViewOf] with [win.CreateWindowEx].
+func NewListViewOf[T any](parent Parent, opts *VarOptsListView) *ListViewOf[T] {
+	return &ListViewOf[T]{NewListView(parent, opts)}
+}
+
+// Instantiates a new [ListViewOf] to be loaded from a dialog resource with
+// [win.HWND.GetDlgItem].
+func NewListViewOfDlg[T any](parent Parent, ctrlId uint16, contextMenuId uint16, layout LAY) *ListViewOf[T] {
+	return &ListViewOf[T]{NewListViewDlg(parent, ctrlId, contextMenuId, layout)}
+}
+
+// Adds a new item with the given texts, one for each column, and stores the
+// data for it.
+func (me *ListViewOf[T]) AddWithData(data T, texts ...string) ListViewItem {
+	item := me.Items.Add(texts...)
+	item.SetData(data)
+	return item
+}
+
+// Returns the data stored for the item, if any.
+func (me *ListViewOf[T]) Data(item ListViewItem) (T, bool) {
+	data, ok := item.Data().(T)
+	return data, ok
+}
+
+// Returns the data of all selected items, which have any.
+func (me *ListViewOf[T]) SelectedData() []T {
+	selItems := me.Items.Selected()
+	datas := make([]T, 0, len(selItems))
+	for _, item := range selItems {
+		if data, ok := me.Data(item); ok {
+			datas = append(datas, data)
+		}
+	}
+	return datas
+}
+
+// Stores the data for the item, replacing any previous one.
+func (me *ListViewOf[T]) SetData(item ListViewItem, data T) {
+	item.SetData(data)
+}
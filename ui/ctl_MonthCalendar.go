@@ -84,10 +84,7 @@ func NewMonthCalendarDlg(parent Parent, ctrlId uint16, layout LAY) *MonthCalenda
 }
 
 // Exposes all the control notifications the can be handled.
-//
-// Panics if called after the control has been created.
 func (me *MonthCalendar) On() *EventsMonthCalendar {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
@@ -190,8 +187,8 @@ type EventsMonthCalendar struct {
 // [MCN_GETDAYSTATE] message handler.
 //
 // [MCN_GETDAYSTATE]: https://learn.microsoft.com/en-us/windows/win32/controls/mcn-getdaystate
-func (me *EventsMonthCalendar) McnGetDayState(fun func(p *win.NMDAYSTATE)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.MCN_GETDAYSTATE, func(p unsafe.Pointer) uintptr {
+func (me *EventsMonthCalendar) McnGetDayState(fun func(p *win.NMDAYSTATE)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.MCN_GETDAYSTATE, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMDAYSTATE)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -200,8 +197,8 @@ func (me *EventsMonthCalendar) McnGetDayState(fun func(p *win.NMDAYSTATE)) {
 // [MCN_SELCHANGE] message handler.
 //
 // [MCN_SELCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/mcn-selchange
-func (me *EventsMonthCalendar) McnSelChange(fun func(p *win.NMSELCHANGE)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.MCN_SELCHANGE, func(p unsafe.Pointer) uintptr {
+func (me *EventsMonthCalendar) McnSelChange(fun func(p *win.NMSELCHANGE)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.MCN_SELCHANGE, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMSELCHANGE)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -210,8 +207,8 @@ func (me *EventsMonthCalendar) McnSelChange(fun func(p *win.NMSELCHANGE)) {
 // [MCN_SELECT] message handler.
 //
 // [MCN_SELECT]: https://learn.microsoft.com/en-us/windows/win32/controls/mcn-select
-func (me *EventsMonthCalendar) McnSelect(fun func(p *win.NMSELCHANGE)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.MCN_SELECT, func(p unsafe.Pointer) uintptr {
+func (me *EventsMonthCalendar) McnSelect(fun func(p *win.NMSELCHANGE)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.MCN_SELECT, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMSELCHANGE)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -220,8 +217,8 @@ func (me *EventsMonthCalendar) McnSelect(fun func(p *win.NMSELCHANGE)) {
 // [MCN_VIEWCHANGE] message handler.
 //
 // [MCN_VIEWCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/mcn-viewchange
-func (me *EventsMonthCalendar) McnViewChange(fun func(p *win.NMVIEWCHANGE)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.MCN_VIEWCHANGE, func(p unsafe.Pointer) uintptr {
+func (me *EventsMonthCalendar) McnViewChange(fun func(p *win.NMVIEWCHANGE)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.MCN_VIEWCHANGE, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMVIEWCHANGE)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -230,8 +227,8 @@ func (me *EventsMonthCalendar) McnViewChange(fun func(p *win.NMVIEWCHANGE)) {
 // [NM_RELEASEDCAPTURE] message handler.
 //
 // [NM_RELEASEDCAPTURE]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-releasedcapture-monthcal-
-func (me *EventsMonthCalendar) NmReleasedCapture(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) uintptr {
+func (me *EventsMonthCalendar) NmReleasedCapture(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -22,12 +22,9 @@ type RadioButton struct {
 
 // Exposes all the control notifications the can be handled.
 //
-// Panics if called after the control has been created.
-//
 // Prefer using the [RadioGroup] notifications, which can handle all radio
 // buttons in the group at once.
 func (me *RadioButton) On() *EventsButton {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
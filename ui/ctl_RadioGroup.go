@@ -112,12 +112,7 @@ func NewRadioGroupDlg(parent Parent, layout LAY, ctrlIds ...uint16) *RadioGroup
 
 // Exposes all the control notifications the can be handled for all
 // [RadioButton] controls at once.
-//
-// Panics if called after the controls have been created.
 func (me *RadioGroup) On() *EventsRadioGroup {
-	if me.radios[0].hWnd != 0 {
-		panic("Cannot add event handling after the control has been created.")
-	}
 	return &me.events
 }
 
@@ -173,50 +168,51 @@ type EventsRadioGroup struct {
 	parentEvents *EventsWindow
 }
 
-// [BN_CLICKED] message handler.
-//
-// [BN_CLICKED]: https://learn.microsoft.com/en-us/windows/win32/controls/bn-clicked
-func (me *EventsRadioGroup) BnClicked(fun func(radio *RadioButton)) {
+// Adds the WM_COMMAND handler to all radio buttons, under a single token.
+func (me *EventsRadioGroup) addCommand(notifCode co.CMD, fun func(radio *RadioButton)) EventToken {
+	token := me.parentEvents.newToken()
 	for _, radio := range me.radioGroup.radios {
 		radio := radio
-		me.parentEvents.WmCommand(radio.ctrlId, co.BN_CLICKED, func() {
-			fun(radio)
-		})
+		me.parentEvents.cmds = append(me.parentEvents.cmds,
+			_StorageCmd{token.id, radio.ctrlId, notifCode, func() {
+				fun(radio)
+			}})
 	}
+	return token
+}
+
+// [BN_CLICKED] message handler.
+//
+// The returned token removes the handler of all radio buttons at once.
+//
+// [BN_CLICKED]: https://learn.microsoft.com/en-us/windows/win32/controls/bn-clicked
+func (me *EventsRadioGroup) BnClicked(fun func(radio *RadioButton)) EventToken {
+	return me.addCommand(co.BN_CLICKED, fun)
 }
 
 // [BN_DBLCLK] message handler.
 //
+// The returned token removes the handler of all radio buttons at once.
+//
 // [BN_DBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/bn-dblclk
-func (me *EventsRadioGroup) BnDblClk(fun func(radio *RadioButton)) {
-	for _, radio := range me.radioGroup.radios {
-		radio := radio
-		me.parentEvents.WmCommand(radio.ctrlId, co.BN_DBLCLK, func() {
-			fun(radio)
-		})
-	}
+func (me *EventsRadioGroup) BnDblClk(fun func(radio *RadioButton)) EventToken {
+	return me.addCommand(co.BN_DBLCLK, fun)
 }
 
 // [BN_KILLFOCUS] message handler.
 //
+// The returned token removes the handler of all radio buttons at once.
+//
 // [BN_KILLFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/bn-killfocus
-func (me *EventsRadioGroup) BnKillFocus(fun func(radio *RadioButton)) {
-	for _, radio := range me.radioGroup.radios {
-		radio := radio
-		me.parentEvents.WmCommand(radio.ctrlId, co.BN_KILLFOCUS, func() {
-			fun(radio)
-		})
-	}
+func (me *EventsRadioGroup) BnKillFocus(fun func(radio *RadioButton)) EventToken {
+	return me.addCommand(co.BN_KILLFOCUS, fun)
 }
 
 // [BN_SETFOCUS] message handler.
 //
+// The returned token removes the handler of all radio buttons at once.
+//
 // [BN_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/bn-setfocus
-func (me *EventsRadioGroup) BnSetFocus(fun func(radio *RadioButton)) {
-	for _, radio := range me.radioGroup.radios {
-		radio := radio
-		me.parentEvents.WmCommand(radio.ctrlId, co.BN_SETFOCUS, func() {
-			fun(radio)
-		})
-	}
+func (me *EventsRadioGroup) BnSetFocus(fun func(radio *RadioButton)) EventToken {
+	return me.addCommand(co.BN_SETFOCUS, fun)
 }
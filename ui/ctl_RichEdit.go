@@ -0,0 +1,740 @@
+//go:build windows
+
+package ui
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// Native [rich edit] control, version 4.1, from Msftedit.dll.
+//
+// [rich edit]: https://learn.microsoft.com/en-us/windows/win32/controls/about-rich-edit-controls
+type RichEdit struct {
+	_BaseCtrl
+	events EventsRichEdit
+}
+
+// Creates a new [RichEdit] with [win.CreateWindowEx].
+//
+// # Example
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	txtLog := ui.NewRichEdit(
+//		wndOwner,
+//		ui.OptsRichEdit().
+//			Position(ui.Dpi(10, 10)).
+//			Size(ui.Dpi(300, 200)),
+//	)
+func NewRichEdit(parent Parent, opts *VarOptsRichEdit) *RichEdit {
+	loadRichEditLib()
+	setUniqueCtrlId(&opts.ctrlId)
+	me := &RichEdit{
+		_BaseCtrl: newBaseCtrl(opts.ctrlId),
+		events:    EventsRichEdit{ctrlId: opts.ctrlId, parentEvents: &parent.base().userEvents},
+	}
+	me.events.hWnd = &me.hWnd
+
+	parent.base().beforeUserEvents.WmCreate(func(_ WmCreate) int {
+		me.createWindow(opts.wndExStyle, "RICHEDIT50W", opts.text,
+			opts.wndStyle|co.WS(opts.ctrlStyle), opts.position, opts.size, parent, true)
+		parent.base().layout.Add(parent, me.hWnd, opts.layout)
+		me.setEventMask()
+		return 0 // ignored
+	})
+
+	return me
+}
+
+// Instantiates a new [RichEdit] to be loaded from a dialog resource with
+// [win.HWND.GetDlgItem].
+//
+// The dialog resource must use the "RICHEDIT50W" class. Msftedit.dll is loaded
+// by this function, so it must be called before the dialog is created.
+//
+// # Example
+//
+//	const ID_TXT uint16 = 0x100
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	txt := ui.NewRichEditDlg(
+//		wndOwner, ID_TXT, ui.LAY_NONE_NONE)
+func NewRichEditDlg(parent Parent, ctrlId uint16, layout LAY) *RichEdit {
+	loadRichEditLib()
+	me := &RichEdit{
+		_BaseCtrl: newBaseCtrl(ctrlId),
+		events:    EventsRichEdit{ctrlId: ctrlId, parentEvents: &parent.base().userEvents},
+	}
+	me.events.hWnd = &me.hWnd
+
+	parent.base().beforeUserEvents.WmInitDialog(func(_ WmInitDialog) bool {
+		me.assignDialog(parent)
+		parent.base().layout.Add(parent, me.hWnd, layout)
+		me.setEventMask()
+		return true // ignored
+	})
+
+	return me
+}
+
+var _richEditLoaded bool
+
+// The rich edit window class is registered by Msftedit.dll, which is loaded
+// once and kept until the process ends.
+func loadRichEditLib() {
+	if !_richEditLoaded {
+		if _, err := win.LoadLibrary("Msftedit.dll"); err != nil {
+			panic(fmt.Sprintf("Msftedit.dll could not be loaded: %s", err.Error()))
+		}
+		_richEditLoaded = true
+	}
+}
+
+// Unlike the ordinary edit control, the rich edit sends most notifications
+// only if they're enabled in the event mask. They're enabled according to the
+// events the user has added.
+func (me *RichEdit) setEventMask() {
+	if me.events.eventMask != co.ENM_NONE {
+		me.hWnd.SendMessage(co.EM_SETEVENTMASK, 0, win.LPARAM(me.events.eventMask))
+	}
+}
+
+// Exposes all the control notifications the can be handled.
+func (me *RichEdit) On() *EventsRichEdit {
+	return &me.events
+}
+
+// Moves the caret to the end of the text and inserts the given text, with the
+// given character formatting. If cf is nil, the current formatting is used.
+// Then scrolls the caret into view.
+//
+// Useful to log viewers, where each line is appended with its own color.
+//
+// Returns the same object, so further operations can be chained.
+//
+// # Example
+//
+//	var txtLog *ui.RichEdit // initialized somewhere
+//
+//	var cf win.CHARFORMAT2
+//	cf.SetCbSize()
+//	cf.DwMask = co.CFM_COLOR
+//	cf.CrTextColor = win.RGB(200, 0, 0)
+//
+//	txtLog.AppendText("Something failed.\r\n", &cf)
+func (me *RichEdit) AppendText(text string, cf *win.CHARFORMAT2) *RichEdit {
+	nChars := me.TextLength()
+	me.SetSelection(nChars, nChars) // moves the caret to the end
+	if cf != nil {
+		me.SetCharFormat(co.SCF_SELECTION, cf)
+	}
+	me.ReplaceSelection(text, false)
+	me.hWnd.SendMessage(co.EM_SCROLLCARET, 0, 0)
+	return me
+}
+
+// Calls [EM_AUTOURLDETECT] to enable or disable the automatic detection of
+// URLs, which are then reported by [EventsRichEdit.EnLink].
+//
+// Returns the same object, so further operations can be chained.
+//
+// [EM_AUTOURLDETECT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-autourldetect
+func (me *RichEdit) AutoUrlDetect(enable bool) *RichEdit {
+	me.hWnd.SendMessage(co.EM_AUTOURLDETECT, win.WPARAM(utl.BoolToUintptr(enable)), 0)
+	return me
+}
+
+// Retrieves the character formatting with [EM_GETCHARFORMAT]. The scf must be
+// co.SCF_DEFAULT or co.SCF_SELECTION.
+//
+// [EM_GETCHARFORMAT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-getcharformat
+func (me *RichEdit) CharFormat(scf co.SCF) win.CHARFORMAT2 {
+	var cf win.CHARFORMAT2
+	cf.SetCbSize()
+	me.hWnd.SendMessage(co.EM_GETCHARFORMAT,
+		win.WPARAM(scf), win.LPARAM(unsafe.Pointer(&cf)))
+	return cf
+}
+
+// Searches the text with [EM_FINDTEXTEX], within the given character
+// positions. If endPos is -1, searches until the end of the text. Use
+// co.FR_DOWN to search forward.
+//
+// Returns the range of the found text.
+//
+// # Example
+//
+//	var txt *ui.RichEdit // initialized somewhere
+//
+//	if found, ok := txt.Find("foo", co.FR_DOWN|co.FR_MATCHCASE, 0, -1); ok {
+//		txt.SetSelection(int(found.CpMin), int(found.CpMax))
+//	}
+//
+// [EM_FINDTEXTEX]: https://learn.microsoft.com/en-us/windows/win32/controls/em-findtextex
+func (me *RichEdit) Find(text string, flags co.FR, startPos, endPos int) (win.CHARRANGE, bool) {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	ft := win.FINDTEXTEX{
+		Chrg:      win.CHARRANGE{CpMin: int32(startPos), CpMax: int32(endPos)},
+		LpstrText: (*uint16)(wbuf.PtrAllowEmpty(text)),
+	}
+	ret, _ := me.hWnd.SendMessage(co.EM_FINDTEXTEXW,
+		win.WPARAM(flags), win.LPARAM(unsafe.Pointer(&ft)))
+	if int32(ret) == -1 {
+		return win.CHARRANGE{}, false
+	}
+	return ft.ChrgText, true
+}
+
+// Calls [EM_EXLIMITTEXT].
+//
+// Returns the same object, so further operations can be chained.
+//
+// [EM_EXLIMITTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-exlimittext
+func (me *RichEdit) LimitText(maxChars uint) *RichEdit {
+	me.hWnd.SendMessage(co.EM_EXLIMITTEXT, 0, win.LPARAM(maxChars))
+	return me
+}
+
+// Retrieves the paragraph formatting of the current selection with
+// [EM_GETPARAFORMAT].
+//
+// [EM_GETPARAFORMAT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-getparaformat
+func (me *RichEdit) ParaFormat() win.PARAFORMAT2 {
+	var pf win.PARAFORMAT2
+	pf.SetCbSize()
+	me.hWnd.SendMessage(co.EM_GETPARAFORMAT, 0, win.LPARAM(unsafe.Pointer(&pf)))
+	return pf
+}
+
+// Replaces all occurrences of the text, returning the number of replacements.
+//
+// Each replacement can be undone.
+func (me *RichEdit) ReplaceAll(text, replacement string, flags co.FR) int {
+	count := 0
+	pos := 0
+	for {
+		found, ok := me.Find(text, flags|co.FR_DOWN, pos, -1)
+		if !ok {
+			break
+		}
+		me.SetSelection(int(found.CpMin), int(found.CpMax))
+		me.ReplaceSelection(replacement, true)
+		_, pos = me.Selection() // caret is right after the replacement
+		count++
+	}
+	return count
+}
+
+// Replaces the current selection with the given text, with [EM_REPLACESEL].
+//
+// Returns the same object, so further operations can be chained.
+//
+// [EM_REPLACESEL]: https://learn.microsoft.com/en-us/windows/win32/controls/em-replacesel
+func (me *RichEdit) ReplaceSelection(text string, canUndo bool) *RichEdit {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	me.hWnd.SendMessage(co.EM_REPLACESEL, win.WPARAM(utl.BoolToUintptr(canUndo)),
+		win.LPARAM(wbuf.PtrAllowEmpty(text)))
+	return me
+}
+
+// Retrieves the currently selected text with [EM_GETSELTEXT].
+//
+// [EM_GETSELTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-getseltext
+func (me *RichEdit) SelectedText() string {
+	start, end := me.Selection()
+	recvBuf := wstr.NewBufDecoder(uint(end-start) + 1)
+	defer recvBuf.Free()
+
+	me.hWnd.SendMessage(co.EM_GETSELTEXT, 0, win.LPARAM(recvBuf.UnsafePtr()))
+	return recvBuf.String()
+}
+
+// Retrieves the current selection with [EM_EXGETSEL], as character positions.
+//
+// [EM_EXGETSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/em-exgetsel
+func (me *RichEdit) Selection() (startPos, endPos int) {
+	var cr win.CHARRANGE
+	me.hWnd.SendMessage(co.EM_EXGETSEL, 0, win.LPARAM(unsafe.Pointer(&cr)))
+	return int(cr.CpMin), int(cr.CpMax)
+}
+
+// Calls [EM_SETBKGNDCOLOR].
+//
+// Returns the same object, so further operations can be chained.
+//
+// [EM_SETBKGNDCOLOR]: https://learn.microsoft.com/en-us/windows/win32/controls/em-setbkgndcolor
+func (me *RichEdit) SetBackgroundColor(color win.COLORREF) *RichEdit {
+	me.hWnd.SendMessage(co.EM_SETBKGNDCOLOR, 0, win.LPARAM(color))
+	return me
+}
+
+// Sets the character formatting with [EM_SETCHARFORMAT].
+//
+// Returns the same object, so further operations can be chained.
+//
+// Panics on error.
+//
+// # Example
+//
+//	var txt *ui.RichEdit // initialized somewhere
+//
+//	var cf win.CHARFORMAT2
+//	cf.SetCbSize()
+//	cf.DwMask = co.CFM_BOLD | co.CFM_FACE
+//	cf.DwEffects = co.CFE_BOLD
+//	cf.SetSzFaceName("Consolas")
+//
+//	txt.SetCharFormat(co.SCF_SELECTION, &cf)
+//
+// [EM_SETCHARFORMAT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-setcharformat
+func (me *RichEdit) SetCharFormat(scf co.SCF, cf *win.CHARFORMAT2) *RichEdit {
+	ret, _ := me.hWnd.SendMessage(co.EM_SETCHARFORMAT,
+		win.WPARAM(scf), win.LPARAM(unsafe.Pointer(cf)))
+	if ret == 0 {
+		panic("EM_SETCHARFORMAT failed.")
+	}
+	return me
+}
+
+// Sets the paragraph formatting of the current selection with
+// [EM_SETPARAFORMAT].
+//
+// Returns the same object, so further operations can be chained.
+//
+// Panics on error.
+//
+// [EM_SETPARAFORMAT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-setparaformat
+func (me *RichEdit) SetParaFormat(pf *win.PARAFORMAT2) *RichEdit {
+	ret, _ := me.hWnd.SendMessage(co.EM_SETPARAFORMAT,
+		0, win.LPARAM(unsafe.Pointer(pf)))
+	if ret == 0 {
+		panic("EM_SETPARAFORMAT failed.")
+	}
+	return me
+}
+
+// Calls [EM_EXSETSEL].
+//
+// If the start is 0 and the end is -1, all the text is selected. If the start
+// is -1, any current selection is deselected.
+//
+// Returns the same object, so further operations can be chained.
+//
+// [EM_EXSETSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/em-exsetsel
+func (me *RichEdit) SetSelection(startPos, endPos int) *RichEdit {
+	cr := win.CHARRANGE{CpMin: int32(startPos), CpMax: int32(endPos)}
+	me.hWnd.SendMessage(co.EM_EXSETSEL, 0, win.LPARAM(unsafe.Pointer(&cr)))
+	return me
+}
+
+// Calls [win.HWND.SetWindowText].
+//
+// Returns the same object, so further operations can be chained.
+func (me *RichEdit) SetText(text string) *RichEdit {
+	me.hWnd.SetWindowText(text)
+	return me
+}
+
+// Replaces the contents of the control with [EM_STREAMIN], reading from the
+// given [io.Reader] until [io.EOF].
+//
+// The format is co.SF_RTF or co.SF_TEXT, optionally combined with
+// co.SFF_SELECTION to replace only the current selection. Plain text is read
+// as UTF-8, unless co.SF_UNICODE is also given, in which case the reader must
+// provide UTF-16 LE.
+//
+// # Example
+//
+//	var txt *ui.RichEdit // initialized somewhere
+//
+//	f, _ := os.Open("C:\\Temp\\foo.rtf")
+//	defer f.Close()
+//	_ = txt.StreamIn(f, co.SF_RTF)
+//
+// [EM_STREAMIN]: https://learn.microsoft.com/en-us/windows/win32/controls/em-streamin
+func (me *RichEdit) StreamIn(r io.Reader, format co.SF) error {
+	pPack := &_RichEditStreamPack{r: r}
+	return me.stream(co.EM_STREAMIN, pPack, format)
+}
+
+// Writes the contents of the control to the given [io.Writer] with
+// [EM_STREAMOUT].
+//
+// The format is co.SF_RTF or co.SF_TEXT, optionally combined with
+// co.SFF_SELECTION to write only the current selection. Plain text is written
+// as UTF-8, unless co.SF_UNICODE is also given, in which case UTF-16 LE is
+// written.
+//
+// # Example
+//
+//	var txt *ui.RichEdit // initialized somewhere
+//
+//	var buf bytes.Buffer
+//	_ = txt.StreamOut(&buf, co.SF_RTF)
+//
+// [EM_STREAMOUT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-streamout
+func (me *RichEdit) StreamOut(w io.Writer, format co.SF) error {
+	pPack := &_RichEditStreamPack{w: w}
+	return me.stream(co.EM_STREAMOUT, pPack, format)
+}
+
+func (me *RichEdit) stream(msg co.WM, pPack *_RichEditStreamPack, format co.SF) error {
+	if (format&0x000f) == co.SF_TEXT && (format&(co.SF_UNICODE|co.SF_USECODEPAGE)) == 0 {
+		format |= co.SF_USECODEPAGE | co.SF(co.CP_UTF8)<<16 // Go strings are UTF-8
+	}
+
+	var es win.EDITSTREAM
+	es.SetDwCookie(uintptr(unsafe.Pointer(pPack)))
+	es.SetPfnCallback(richEditStreamCallback())
+
+	me.hWnd.SendMessage(msg, win.WPARAM(format), win.LPARAM(unsafe.Pointer(&es)))
+	runtime.KeepAlive(pPack)
+
+	if pPack.err != nil {
+		return pPack.err
+	} else if es.DwError() != 0 {
+		return fmt.Errorf("rich edit streaming failed with error %d", es.DwError())
+	}
+	return nil
+}
+
+type _RichEditStreamPack struct {
+	r   io.Reader // used by EM_STREAMIN
+	w   io.Writer // used by EM_STREAMOUT
+	err error     // error returned by the reader or writer
+}
+
+var _richEditStreamCallback uintptr
+
+func richEditStreamCallback() uintptr {
+	if _richEditStreamCallback != 0 {
+		return _richEditStreamCallback
+	}
+
+	_richEditStreamCallback = syscall.NewCallback(
+		func(dwCookie uintptr, pbBuff *byte, cb uintptr, pcb *int32) uintptr {
+			pPack := (*_RichEditStreamPack)(unsafe.Pointer(dwCookie))
+			buf := unsafe.Slice(pbBuff, int32(cb))
+
+			var n int
+			var err error
+			if pPack.r != nil {
+				for n == 0 && err == nil { // zero bytes would be taken as the end
+					n, err = pPack.r.Read(buf)
+				}
+				if err == io.EOF {
+					err = nil // next call will return zero bytes, ending the stream
+				}
+			} else {
+				n, err = pPack.w.Write(buf)
+			}
+
+			*pcb = int32(n)
+			if err != nil {
+				pPack.err = err
+				return 1 // nonzero aborts the operation
+			}
+			return 0
+		},
+	)
+	return _richEditStreamCallback
+}
+
+// Calls [win.HWND.GetWindowText].
+func (me *RichEdit) Text() string {
+	t, _ := me.hWnd.GetWindowText()
+	return t
+}
+
+// Retrieves the number of characters with [EM_GETTEXTLENGTHEX].
+//
+// [EM_GETTEXTLENGTHEX]: https://learn.microsoft.com/en-us/windows/win32/controls/em-gettextlengthex
+func (me *RichEdit) TextLength() int {
+	gtl := win.GETTEXTLENGTHEX{
+		Flags:    co.GTL_NUMCHARS | co.GTL_PRECISE,
+		Codepage: 1200, // UTF-16
+	}
+	n, _ := me.hWnd.SendMessage(co.EM_GETTEXTLENGTHEX,
+		win.WPARAM(unsafe.Pointer(&gtl)), 0)
+	return int(n)
+}
+
+// Retrieves the text within the given character positions with
+// [EM_GETTEXTRANGE].
+//
+// [EM_GETTEXTRANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/em-gettextrange
+func (me *RichEdit) TextRange(startPos, endPos int) string {
+	if endPos == -1 {
+		endPos = me.TextLength()
+	}
+	if endPos <= startPos {
+		return ""
+	}
+
+	recvBuf := wstr.NewBufDecoder(uint(endPos-startPos) + 1)
+	defer recvBuf.Free()
+
+	tr := win.TEXTRANGE{
+		Chrg:      win.CHARRANGE{CpMin: int32(startPos), CpMax: int32(endPos)},
+		LpstrText: (*uint16)(recvBuf.UnsafePtr()),
+	}
+	me.hWnd.SendMessage(co.EM_GETTEXTRANGE, 0, win.LPARAM(unsafe.Pointer(&tr)))
+	return recvBuf.String()
+}
+
+// Options for [NewRichEdit]; returned by [OptsRichEdit].
+type VarOptsRichEdit struct {
+	ctrlId     uint16
+	layout     LAY
+	text       string
+	position   win.POINT
+	size       win.SIZE
+	ctrlStyle  co.ES
+	wndStyle   co.WS
+	wndExStyle co.WS_EX
+}
+
+// Options for [NewRichEdit].
+func OptsRichEdit() *VarOptsRichEdit {
+	return &VarOptsRichEdit{
+		size:       win.SIZE{Cx: int32(DpiX(200)), Cy: int32(DpiY(120))},
+		ctrlStyle:  co.ES_MULTILINE | co.ES_AUTOVSCROLL | co.ES_WANTRETURN | co.ES_NOHIDESEL,
+		wndStyle:   co.WS_CHILD | co.WS_VISIBLE | co.WS_TABSTOP | co.WS_GROUP | co.WS_VSCROLL,
+		wndExStyle: co.WS_EX_LEFT | co.WS_EX_CLIENTEDGE,
+	}
+}
+
+// Control ID. Must be unique within a same parent window.
+//
+// Defaults to an auto-generated ID.
+func (o *VarOptsRichEdit) CtrlId(id uint16) *VarOptsRichEdit { o.ctrlId = id; return o }
+
+// Horizontal and vertical behavior for the control layout, when the parent
+// window is resized.
+//
+// Defaults to ui.LAY_NONE_NONE.
+func (o *VarOptsRichEdit) Layout(l LAY) *VarOptsRichEdit { o.layout = l; return o }
+
+// Text to be displayed, passed to [win.CreateWindowEx].
+//
+// Defaults to empty string.
+func (o *VarOptsRichEdit) Text(t string) *VarOptsRichEdit { o.text = t; return o }
+
+// Position coordinates within parent window client area, in pixels, passed to
+// [win.CreateWindowEx].
+//
+// Defaults to ui.Dpi(0, 0).
+func (o *VarOptsRichEdit) Position(x, y int) *VarOptsRichEdit {
+	o.position.X = int32(x)
+	o.position.Y = int32(y)
+	return o
+}
+
+// Control size in pixels, passed to [win.CreateWindowEx].
+//
+// Defaults to ui.Dpi(200, 120).
+func (o *VarOptsRichEdit) Size(cx int, cy int) *VarOptsRichEdit {
+	o.size.Cx = int32(cx)
+	o.size.Cy = int32(cy)
+	return o
+}
+
+// Rich edit control [style], passed to [win.CreateWindowEx].
+//
+// Defaults to co.ES_MULTILINE | co.ES_AUTOVSCROLL | co.ES_WANTRETURN | co.ES_NOHIDESEL.
+//
+// [style]: https://learn.microsoft.com/en-us/windows/win32/controls/rich-edit-control-styles
+func (o *VarOptsRichEdit) CtrlStyle(s co.ES) *VarOptsRichEdit { o.ctrlStyle = s; return o }
+
+// Window style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_CHILD | co.WS_VISIBLE | co.WS_TABSTOP | co.WS_GROUP | co.WS_VSCROLL.
+func (o *VarOptsRichEdit) WndStyle(s co.WS) *VarOptsRichEdit { o.wndStyle = s; return o }
+
+// Window extended style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_EX_LEFT | co.WS_EX_CLIENTEDGE.
+func (o *VarOptsRichEdit) WndExStyle(s co.WS_EX) *VarOptsRichEdit { o.wndExStyle = s; return o }
+
+// Native [rich edit] control events.
+//
+// The notifications which must be enabled with [EM_SETEVENTMASK] are enabled
+// automatically, when the corresponding handler is added.
+//
+// You cannot create this object directly, it will be created automatically
+// by the owning control.
+//
+// [rich edit]: https://learn.microsoft.com/en-us/windows/win32/controls/about-rich-edit-controls
+// [EM_SETEVENTMASK]: https://learn.microsoft.com/en-us/windows/win32/controls/em-seteventmask
+type EventsRichEdit struct {
+	ctrlId       uint16
+	parentEvents *EventsWindow
+	hWnd         *win.HWND // owning control, zero until created
+	eventMask    co.ENM    // notifications enabled by the added handlers
+}
+
+// Enables the notifications in the event mask. If the control already exists,
+// the mask is updated right away.
+func (me *EventsRichEdit) addEventMask(mask co.ENM) {
+	me.eventMask |= mask
+	if *me.hWnd != 0 {
+		me.hWnd.SendMessage(co.EM_SETEVENTMASK, 0, win.LPARAM(me.eventMask))
+	}
+}
+
+// [EN_CHANGE] message handler.
+//
+// [EN_CHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-change
+func (me *EventsRichEdit) EnChange(fun func()) EventToken {
+	me.addEventMask(co.ENM_CHANGE)
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_CHANGE, fun)
+}
+
+// [EN_DRAGDROPDONE] message handler.
+//
+// [EN_DRAGDROPDONE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-dragdropdone
+func (me *EventsRichEdit) EnDragDropDone(fun func(p *win.NMHDR)) EventToken {
+	me.addEventMask(co.ENM_DRAGDROPDONE)
+	return me.parentEvents.WmNotify(me.ctrlId, co.EN_DRAGDROPDONE, func(p unsafe.Pointer) uintptr {
+		fun((*win.NMHDR)(p))
+		return me.parentEvents.defProcVal
+	})
+}
+
+// [EN_DROPFILES] message handler.
+//
+// Return true to allow the drop operation.
+//
+// [EN_DROPFILES]: https://learn.microsoft.com/en-us/windows/win32/controls/en-dropfiles
+func (me *EventsRichEdit) EnDropFiles(fun func(p *win.ENDROPFILES) bool) EventToken {
+	me.addEventMask(co.ENM_DROPFILES)
+	return me.parentEvents.WmNotify(me.ctrlId, co.EN_DROPFILES, func(p unsafe.Pointer) uintptr {
+		return utl.BoolToUintptr(fun((*win.ENDROPFILES)(p)))
+	})
+}
+
+// [EN_ERRSPACE] message handler.
+//
+// [EN_ERRSPACE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-errspace
+func (me *EventsRichEdit) EnErrSpace(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_ERRSPACE, fun)
+}
+
+// [EN_HSCROLL] message handler.
+//
+// [EN_HSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/en-hscroll
+func (me *EventsRichEdit) EnHScroll(fun func()) EventToken {
+	me.addEventMask(co.ENM_SCROLL)
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_HSCROLL, fun)
+}
+
+// [EN_KILLFOCUS] message handler.
+//
+// [EN_KILLFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/en-killfocus
+func (me *EventsRichEdit) EnKillFocus(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_KILLFOCUS, fun)
+}
+
+// [EN_LINK] message handler.
+//
+// Return true to prevent the control from processing the mouse or keyboard
+// message.
+//
+// # Example
+//
+//	var txt *ui.RichEdit // initialized somewhere
+//
+//	txt.On().EnLink(func(p *win.ENLINK) bool {
+//		if p.Msg() == co.WM_LBUTTONUP {
+//			chrg := p.Chrg()
+//			url := txt.TextRange(int(chrg.CpMin), int(chrg.CpMax))
+//			println(url)
+//		}
+//		return false
+//	})
+//
+// [EN_LINK]: https://learn.microsoft.com/en-us/windows/win32/controls/en-link
+func (me *EventsRichEdit) EnLink(fun func(p *win.ENLINK) bool) EventToken {
+	me.addEventMask(co.ENM_LINK)
+	return me.parentEvents.WmNotify(me.ctrlId, co.EN_LINK, func(p unsafe.Pointer) uintptr {
+		return utl.BoolToUintptr(fun((*win.ENLINK)(p)))
+	})
+}
+
+// [EN_MAXTEXT] message handler.
+//
+// [EN_MAXTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/en-maxtext
+func (me *EventsRichEdit) EnMaxText(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_MAXTEXT, fun)
+}
+
+// [EN_MSGFILTER] message handler, for keyboard and mouse events.
+//
+// Return true to prevent the control from processing the message.
+//
+// [EN_MSGFILTER]: https://learn.microsoft.com/en-us/windows/win32/controls/en-msgfilter
+func (me *EventsRichEdit) EnMsgFilter(fun func(p *win.MSGFILTER) bool) EventToken {
+	me.addEventMask(co.ENM_KEYEVENTS | co.ENM_MOUSEEVENTS)
+	return me.parentEvents.WmNotify(me.ctrlId, co.EN_MSGFILTER, func(p unsafe.Pointer) uintptr {
+		return utl.BoolToUintptr(fun((*win.MSGFILTER)(p)))
+	})
+}
+
+// [EN_REQUESTRESIZE] message handler.
+//
+// [EN_REQUESTRESIZE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-requestresize
+func (me *EventsRichEdit) EnRequestResize(fun func(p *win.REQRESIZE)) EventToken {
+	me.addEventMask(co.ENM_REQUESTRESIZE)
+	return me.parentEvents.WmNotify(me.ctrlId, co.EN_REQUESTRESIZE, func(p unsafe.Pointer) uintptr {
+		fun((*win.REQRESIZE)(p))
+		return me.parentEvents.defProcVal
+	})
+}
+
+// [EN_SELCHANGE] message handler.
+//
+// [EN_SELCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-selchange-rich-edit-control-
+func (me *EventsRichEdit) EnSelChange(fun func(p *win.SELCHANGE)) EventToken {
+	me.addEventMask(co.ENM_SELCHANGE)
+	return me.parentEvents.WmNotify(me.ctrlId, co.EN_SELCHANGE, func(p unsafe.Pointer) uintptr {
+		fun((*win.SELCHANGE)(p))
+		return me.parentEvents.defProcVal
+	})
+}
+
+// [EN_SETFOCUS] message handler.
+//
+// [EN_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/en-setfocus
+func (me *EventsRichEdit) EnSetFocus(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_SETFOCUS, fun)
+}
+
+// [EN_UPDATE] message handler.
+//
+// [EN_UPDATE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-update
+func (me *EventsRichEdit) EnUpdate(fun func()) EventToken {
+	me.addEventMask(co.ENM_UPDATE)
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_UPDATE, fun)
+}
+
+// [EN_VSCROLL] message handler.
+//
+// [EN_VSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/en-vscroll
+func (me *EventsRichEdit) EnVScroll(fun func()) EventToken {
+	me.addEventMask(co.ENM_SCROLL)
+	return me.parentEvents.WmCommand(me.ctrlId, co.EN_VSCROLL, fun)
+}
@@ -0,0 +1,475 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Orientation of a [Splitter].
+type SPLIT uint8
+
+const (
+	SPLIT_HORZ SPLIT = iota // Panes side by side, divided by a vertical bar.
+	SPLIT_VERT              // Panes stacked, divided by a horizontal bar.
+)
+
+// Custom control which hosts two child panes, divided by a bar which can be
+// dragged with the mouse, or moved with the arrow keys when focused.
+//
+// The panes are child controls created with the splitter as their parent, and
+// informed with [Splitter.SetPanes]. A pane can be another splitter, so they
+// can be nested. The panes are automatically resized, so they must be created
+// with the default ui.LAY_NONE_NONE layout.
+//
+// The position of the bar is kept as a ratio of the available space, which
+// can be saved with [Splitter.Ratio] and restored with [Splitter.SetRatio].
+//
+// Implements:
+//   - [Window]
+//   - [ChildControl]
+//   - [Parent]
+//
+// # Example
+//
+//	var wnd ui.Parent // initialized somewhere
+//
+//	split := ui.NewSplitter(wnd,
+//		ui.OptsSplitter().
+//			Size(ui.Dpi(500, 400)).
+//			Layout(ui.LAY_RESIZE_RESIZE).
+//			Ratio(0.3).
+//			MinSizes(ui.DpiX(100), ui.DpiX(150)),
+//	)
+//	tree := ui.NewTreeView(split, ui.OptsTreeView())
+//	list := ui.NewListView(split, ui.OptsListView())
+//	split.SetPanes(tree, list)
+type Splitter struct {
+	*Control
+	split       SPLIT
+	pane1       Window
+	pane2       Window
+	ratio       float64 // size of the first pane, relative to the available space
+	min1, min2  int     // in pixels
+	barSize     int     // in pixels
+	collapsible bool
+	collapsed   int      // 0, 1 or 2
+	dpi         int      // DPI of min1, min2 and barSize
+	rcBar       win.RECT // computed in arrange()
+	dragging    bool     // mouse is captured
+	dragOffset  int      // mouse position within the bar, when dragging started
+	prevFocus   win.HWND // to be restored when the bar loses the focus
+}
+
+// Creates a new [Splitter]. Call [Splitter.SetPanes] to inform the panes.
+func NewSplitter(parent Parent, opts *VarOptsSplitter) *Splitter {
+	idc, className := co.IDC_SIZEWE, "windigo.SplitterHorz"
+	if opts.split == SPLIT_VERT {
+		idc, className = co.IDC_SIZENS, "windigo.SplitterVert"
+	}
+	hCursor, _ := win.HINSTANCE(0).LoadCursor(win.CursorResIdc(idc))
+
+	me := &Splitter{
+		Control: NewControl(parent,
+			OptsControl().
+				ClassName(className).
+				ClassCursor(hCursor).
+				ClassBrush(win.HBRUSH(co.COLOR_BTNFACE+1)).
+				CtrlId(opts.ctrlId).
+				Layout(opts.layout).
+				Position(int(opts.position.X), int(opts.position.Y)).
+				Size(int(opts.size.Cx), int(opts.size.Cy)).
+				Style(co.WS_CHILD|co.WS_VISIBLE|co.WS_TABSTOP|co.WS_CLIPCHILDREN|co.WS_CLIPSIBLINGS).
+				ExStyle(co.WS_EX_LEFT),
+		),
+		split:       opts.split,
+		ratio:       clampRatio(opts.ratio),
+		min1:        opts.min1,
+		min2:        opts.min2,
+		barSize:     opts.barSize,
+		collapsible: opts.collapsible,
+		dpi:         parent.base().dpi,
+	}
+
+	me.defaultMessageHandlers()
+	return me
+}
+
+func (me *Splitter) defaultMessageHandlers() {
+	before := &me.raw.beforeUserEvents
+	after := &me.raw.afterUserEvents
+
+	// Panes are created in beforeUserEvents, so after the user events all of
+	// them exist, and the first arrangement can be made.
+	after.WmCreate(func(_ WmCreate) int {
+		me.arrange()
+		return 0 // ignored
+	})
+
+	before.WmSize(func(_ WmSize) {
+		me.arrange()
+	})
+
+	after.Wm(co.WM_DPICHANGED_AFTERPARENT, func(_ Wm) uintptr {
+		me.arrange() // sizes are rescaled within
+		return 0     // ignored
+	})
+
+	before.WmPaint(func() {
+		var ps win.PAINTSTRUCT
+		hdc, _ := me.Hwnd().BeginPaint(&ps)
+		defer me.Hwnd().EndPaint(&ps)
+
+		if win.GetFocus() == me.Hwnd() {
+			rc := me.rcBar
+			hdc.DrawFocusRect(&rc)
+		}
+	})
+
+	before.WmSetFocus(func(p WmSetFocus) {
+		me.prevFocus = p.HwndLosingFocus()
+		me.Hwnd().InvalidateRect(nil, true)
+	})
+
+	before.WmKillFocus(func(_ WmKillFocus) {
+		me.Hwnd().InvalidateRect(nil, true)
+	})
+
+	before.WmGetDlgCode(func(_ WmGetDlgCode) co.DLGC {
+		return co.DLGC_WANTARROWS
+	})
+
+	before.WmKeyDown(func(p WmKey) {
+		me.processKey(p.VirtualKeyCode())
+	})
+
+	before.WmLButtonDown(func(p WmMouse) {
+		pos := me.coord(p.Pos())
+		if pos >= me.coord(win.POINT{X: me.rcBar.Left, Y: me.rcBar.Top}) &&
+			pos < me.coord(win.POINT{X: me.rcBar.Right, Y: me.rcBar.Bottom}) {
+
+			me.dragging = true
+			me.dragOffset = pos - me.barPos()
+			me.Hwnd().SetCapture()
+			if win.GetFocus() != me.Hwnd() {
+				me.Hwnd().SetFocus()
+			}
+		}
+	})
+
+	before.WmMouseMove(func(p WmMouse) {
+		if me.dragging {
+			me.moveBar(me.coord(p.Pos()) - me.dragOffset)
+		}
+	})
+
+	before.WmLButtonUp(func(_ WmMouse) {
+		if me.dragging {
+			win.ReleaseCapture() // dragging is reset in WM_CAPTURECHANGED
+			me.restoreFocus()
+		}
+	})
+
+	before.WmCaptureChanged(func(_ WmCaptureChanged) {
+		me.dragging = false
+	})
+}
+
+// Arrow keys move the bar; Home and End move it to the extremes; Enter and
+// Esc return the focus to the previously focused window.
+func (me *Splitter) processKey(vk co.VK) {
+	step := DpiScale(8, 96, me.dpi)
+	decr, incr := co.VK_LEFT, co.VK_RIGHT
+	if me.split == SPLIT_VERT {
+		decr, incr = co.VK_UP, co.VK_DOWN
+	}
+
+	switch vk {
+	case decr:
+		me.moveBar(me.barPos() - step)
+	case incr:
+		me.moveBar(me.barPos() + step)
+	case co.VK_HOME:
+		me.moveBar(0)
+	case co.VK_END:
+		me.moveBar(me.available())
+	case co.VK_RETURN, co.VK_ESCAPE:
+		me.restoreFocus()
+	}
+}
+
+// Gives the focus back to the window which had it before the bar was clicked.
+func (me *Splitter) restoreFocus() {
+	if me.prevFocus != 0 && me.prevFocus.IsWindow() {
+		me.prevFocus.SetFocus()
+	}
+}
+
+// Returns the X coordinate of horizontal splitters, or the Y of vertical ones.
+func (me *Splitter) coord(pt win.POINT) int {
+	if me.split == SPLIT_HORZ {
+		return int(pt.X)
+	}
+	return int(pt.Y)
+}
+
+// Returns the client area length which can be divided among the panes.
+func (me *Splitter) available() int {
+	rc, _ := me.Hwnd().GetClientRect()
+	avail := me.coord(win.POINT{X: rc.Right, Y: rc.Bottom}) - me.barSize
+	if avail < 0 {
+		return 0
+	}
+	return avail
+}
+
+// Returns the length of the first pane, which is the position of the bar.
+func (me *Splitter) barPos() int {
+	avail := me.available()
+	switch me.collapsed {
+	case 1:
+		return 0
+	case 2:
+		return avail
+	}
+	return me.clampPos(int(me.ratio*float64(avail)+0.5), avail)
+}
+
+// Keeps the position within the minimum sizes of the panes.
+func (me *Splitter) clampPos(pos, avail int) int {
+	if pos > avail-me.min2 {
+		pos = avail - me.min2
+	}
+	if pos < me.min1 {
+		pos = me.min1
+	}
+	if pos > avail {
+		pos = avail
+	}
+	if pos < 0 {
+		pos = 0
+	}
+	return pos
+}
+
+// Moves the bar to the given position, collapsing a pane if the position is
+// beyond half of its minimum size.
+func (me *Splitter) moveBar(pos int) {
+	avail := me.available()
+	if avail == 0 {
+		return
+	}
+
+	if me.collapsible && pos < me.min1/2 {
+		me.collapsed = 1
+	} else if me.collapsible && pos > avail-me.min2/2 {
+		me.collapsed = 2
+	} else {
+		me.collapsed = 0
+		me.ratio = float64(me.clampPos(pos, avail)) / float64(avail)
+	}
+	me.arrange()
+}
+
+// Computes the bar rectangle and resizes the panes.
+func (me *Splitter) arrange() {
+	if me.Hwnd() == 0 {
+		return // not created yet
+	}
+
+	if newDpi := me.base().dpi; newDpi != me.dpi {
+		me.min1 = DpiScale(me.min1, me.dpi, newDpi)
+		me.min2 = DpiScale(me.min2, me.dpi, newDpi)
+		me.barSize = DpiScale(me.barSize, me.dpi, newDpi)
+		me.dpi = newDpi
+	}
+
+	rc, _ := me.Hwnd().GetClientRect()
+	pos := int32(me.barPos())
+	rc1, rcBar, rc2 := rc, rc, rc
+
+	if me.split == SPLIT_HORZ {
+		rc1.Right = pos
+		rcBar.Left, rcBar.Right = pos, pos+int32(me.barSize)
+		rc2.Left = rcBar.Right
+	} else {
+		rc1.Bottom = pos
+		rcBar.Top, rcBar.Bottom = pos, pos+int32(me.barSize)
+		rc2.Top = rcBar.Bottom
+	}
+	me.rcBar = rcBar
+
+	panes := make([]Window, 0, 2)
+	rects := make([]win.RECT, 0, 2)
+	for i, pane := range []Window{me.pane1, me.pane2} {
+		if pane != nil && pane.Hwnd() != 0 {
+			panes = append(panes, pane)
+			rects = append(rects, []win.RECT{rc1, rc2}[i])
+			if me.collapsed == i+1 {
+				pane.Hwnd().ShowWindow(co.SW_HIDE)
+			} else {
+				pane.Hwnd().ShowWindow(co.SW_SHOWNA)
+			}
+		}
+	}
+
+	if len(panes) > 0 {
+		hdwp, _ := win.BeginDeferWindowPos(uint(len(panes)))
+		for i, pane := range panes {
+			hdwp.DeferWindowPos(pane.Hwnd(), win.HWND(0),
+				int(rects[i].Left), int(rects[i].Top),
+				int(rects[i].Right-rects[i].Left), int(rects[i].Bottom-rects[i].Top),
+				co.SWP_NOZORDER|co.SWP_NOACTIVATE)
+		}
+		hdwp.EndDeferWindowPos()
+	}
+
+	me.Hwnd().InvalidateRect(nil, true)
+}
+
+// Informs the two panes, which must be child controls created with this
+// splitter as their parent. A nil pane leaves its area empty.
+//
+// If the splitter already exists, the panes are immediately resized.
+func (me *Splitter) SetPanes(pane1, pane2 Window) *Splitter {
+	me.pane1, me.pane2 = pane1, pane2
+	me.arrange()
+	return me
+}
+
+// Returns the panes informed with [Splitter.SetPanes].
+func (me *Splitter) Panes() (Window, Window) {
+	return me.pane1, me.pane2
+}
+
+// Returns the collapsed pane: 1 for the first, 2 for the second, or 0 if none
+// is collapsed.
+func (me *Splitter) Collapsed() int {
+	return me.collapsed
+}
+
+// Collapses a pane, giving all the space to the other one: 1 for the first
+// pane, 2 for the second, or 0 to expand back. The ratio is not changed, so
+// it's restored when the pane is expanded.
+//
+// Panics if pane is not 0, 1 or 2.
+func (me *Splitter) SetCollapsed(pane int) *Splitter {
+	if pane < 0 || pane > 2 {
+		panic("Splitter pane must be 0, 1 or 2.")
+	}
+	me.collapsed = pane
+	me.arrange()
+	return me
+}
+
+// Returns the size of the first pane relative to the space available to both
+// panes, from 0 to 1. A collapsed pane doesn't change the ratio.
+//
+// The value can be saved and later restored with [Splitter.SetRatio].
+func (me *Splitter) Ratio() float64 {
+	return me.ratio
+}
+
+// Sets the size of the first pane relative to the space available to both
+// panes, from 0 to 1. The minimum sizes of the panes are still respected.
+func (me *Splitter) SetRatio(ratio float64) *Splitter {
+	me.ratio = clampRatio(ratio)
+	me.arrange()
+	return me
+}
+
+func clampRatio(ratio float64) float64 {
+	if ratio < 0 {
+		return 0
+	} else if ratio > 1 {
+		return 1
+	}
+	return ratio
+}
+
+// Options for [NewSplitter]; returned by [OptsSplitter].
+type VarOptsSplitter struct {
+	split       SPLIT
+	ctrlId      uint16
+	layout      LAY
+	position    win.POINT
+	size        win.SIZE
+	ratio       float64
+	min1, min2  int
+	barSize     int
+	collapsible bool
+}
+
+// Options for [NewSplitter].
+func OptsSplitter() *VarOptsSplitter {
+	return &VarOptsSplitter{
+		size:    win.SIZE{Cx: int32(DpiX(300)), Cy: int32(DpiY(200))},
+		ratio:   0.5,
+		barSize: DpiX(5),
+	}
+}
+
+// Orientation of the splitter.
+//
+// Defaults to ui.SPLIT_HORZ.
+func (o *VarOptsSplitter) Split(s SPLIT) *VarOptsSplitter { o.split = s; return o }
+
+// Control ID. Must be unique within a same parent window.
+//
+// Defaults to an auto-generated ID.
+func (o *VarOptsSplitter) CtrlId(id uint16) *VarOptsSplitter { o.ctrlId = id; return o }
+
+// Horizontal and vertical behavior for the control layout, when the parent
+// window is resized.
+//
+// Defaults to ui.LAY_NONE_NONE.
+func (o *VarOptsSplitter) Layout(l LAY) *VarOptsSplitter { o.layout = l; return o }
+
+// Position coordinates within parent window client area, passed to
+// [CreateWindowEx].
+//
+// Defaults to ui.Dpi(0, 0).
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsSplitter) Position(x, y int) *VarOptsSplitter {
+	o.position.X = int32(x)
+	o.position.Y = int32(y)
+	return o
+}
+
+// Control size in pixels, passed to [CreateWindowEx].
+//
+// Defaults to ui.Dpi(300, 200).
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsSplitter) Size(cx int, cy int) *VarOptsSplitter {
+	o.size.Cx = int32(cx)
+	o.size.Cy = int32(cy)
+	return o
+}
+
+// Initial size of the first pane relative to the space available to both
+// panes, from 0 to 1.
+//
+// Defaults to 0.5.
+func (o *VarOptsSplitter) Ratio(r float64) *VarOptsSplitter { o.ratio = r; return o }
+
+// Minimum sizes of the first and second panes, in pixels.
+//
+// Defaults to 0, 0.
+func (o *VarOptsSplitter) MinSizes(min1, min2 int) *VarOptsSplitter {
+	o.min1, o.min2 = min1, min2
+	return o
+}
+
+// Thickness of the bar, in pixels.
+//
+// Defaults to ui.DpiX(5).
+func (o *VarOptsSplitter) BarSize(s int) *VarOptsSplitter { o.barSize = s; return o }
+
+// If true, dragging the bar beyond half of the minimum size of a pane
+// collapses it; dragging the bar back expands it.
+//
+// Defaults to false.
+func (o *VarOptsSplitter) Collapsible(c bool) *VarOptsSplitter { o.collapsible = c; return o }
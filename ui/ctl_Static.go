@@ -55,10 +55,7 @@ func NewStaticDlg(parent Parent, ctrlId uint16, layout LAY) *Static {
 }
 
 // Exposes all the control notifications the can be handled.
-//
-// Panics if called after the control has been created.
 func (me *Static) On() *EventsStatic {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
@@ -163,27 +160,27 @@ type EventsStatic struct {
 // [STN_CLICKED] message handler.
 //
 // [STN_CLICKED]: https://learn.microsoft.com/en-us/windows/win32/controls/stn-clicked
-func (me *EventsStatic) StnClicked(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.STN_CLICKED, fun)
+func (me *EventsStatic) StnClicked(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.STN_CLICKED, fun)
 }
 
 // [STN_DBLCLK] message handler.
 //
 // [STN_DBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/stn-dblclk
-func (me *EventsStatic) StnDblClk(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.STN_DBLCLK, fun)
+func (me *EventsStatic) StnDblClk(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.STN_DBLCLK, fun)
 }
 
 // [STN_DISABLE] message handler.
 //
 // [STN_DISABLE]: https://learn.microsoft.com/en-us/windows/win32/controls/stn-disable
-func (me *EventsStatic) StnDisable(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.STN_DISABLE, fun)
+func (me *EventsStatic) StnDisable(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.STN_DISABLE, fun)
 }
 
 // [STN_ENABLE] message handler.
 //
 // [STN_ENABLE]: https://learn.microsoft.com/en-us/windows/win32/controls/stn-enable
-func (me *EventsStatic) StnEnable(fun func()) {
-	me.parentEvents.WmCommand(me.ctrlId, co.STN_ENABLE, fun)
+func (me *EventsStatic) StnEnable(fun func()) EventToken {
+	return me.parentEvents.WmCommand(me.ctrlId, co.STN_ENABLE, fun)
 }
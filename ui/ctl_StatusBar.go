@@ -50,10 +50,7 @@ func NewStatusBar(parent Parent) *StatusBar {
 }
 
 // Exposes all the control notifications the can be handled.
-//
-// Panics if called after the control has been created.
 func (me *StatusBar) On() *EventsStatusBar {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
@@ -71,8 +68,8 @@ type EventsStatusBar struct {
 // [NM_CLICK] message handler.
 //
 // [NM_CLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-click-status-bar
-func (me *EventsStatusBar) NmClick(fun func(p *win.NMMOUSE) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_CLICK, func(p unsafe.Pointer) uintptr {
+func (me *EventsStatusBar) NmClick(fun func(p *win.NMMOUSE) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_CLICK, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMMOUSE)(p)))
 	})
 }
@@ -80,8 +77,8 @@ func (me *EventsStatusBar) NmClick(fun func(p *win.NMMOUSE) bool) {
 // [NM_DBLCLK] message handler.
 //
 // [NM_DBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-dblclk-status-bar
-func (me *EventsStatusBar) NmDblClk(fun func(p *win.NMMOUSE) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_DBLCLK, func(p unsafe.Pointer) uintptr {
+func (me *EventsStatusBar) NmDblClk(fun func(p *win.NMMOUSE) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_DBLCLK, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMMOUSE)(p)))
 	})
 }
@@ -89,8 +86,8 @@ func (me *EventsStatusBar) NmDblClk(fun func(p *win.NMMOUSE) bool) {
 // [NM_RCLICK] message handler.
 //
 // [NM_RCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-rclick-status-bar
-func (me *EventsStatusBar) NmRClick(fun func(p *win.NMMOUSE) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RCLICK, func(p unsafe.Pointer) uintptr {
+func (me *EventsStatusBar) NmRClick(fun func(p *win.NMMOUSE) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RCLICK, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMMOUSE)(p)))
 	})
 }
@@ -98,8 +95,8 @@ func (me *EventsStatusBar) NmRClick(fun func(p *win.NMMOUSE) bool) {
 // [NM_RDBLCLK] message handler.
 //
 // [NM_RDBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-rdblclk-status-bar
-func (me *EventsStatusBar) NmRDblClk(fun func(p *win.NMMOUSE) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RDBLCLK, func(p unsafe.Pointer) uintptr {
+func (me *EventsStatusBar) NmRDblClk(fun func(p *win.NMMOUSE) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RDBLCLK, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMMOUSE)(p)))
 	})
 }
@@ -107,8 +104,8 @@ func (me *EventsStatusBar) NmRDblClk(fun func(p *win.NMMOUSE) bool) {
 // [SBN_SIMPLEMODECHANGE] message handler.
 //
 // [SBN_SIMPLEMODECHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/sbn-simplemodechange
-func (me *EventsStatusBar) SbnSimpleModeChange(fun func(p *win.NMMOUSE)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.SBN_SIMPLEMODECHANGE, func(p unsafe.Pointer) uintptr {
+func (me *EventsStatusBar) SbnSimpleModeChange(fun func(p *win.NMMOUSE)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.SBN_SIMPLEMODECHANGE, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMMOUSE)(p))
 		return me.parentEvents.defProcVal
 	})
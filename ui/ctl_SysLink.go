@@ -57,10 +57,7 @@ func NewSysLinkDlg(parent Parent, ctrlId uint16, layout LAY) *SysLink {
 }
 
 // Exposes all the control notifications the can be handled.
-//
-// Panics if called after the control has been created.
 func (me *SysLink) On() *EventsSysLink {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
@@ -178,8 +175,8 @@ type EventsSysLink struct {
 // [NM_CLICK] message handler.
 //
 // [NM_CLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-click-syslink
-func (me *EventsSysLink) NmClick(fun func(p *win.NMLINK)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_CLICK, func(p unsafe.Pointer) uintptr {
+func (me *EventsSysLink) NmClick(fun func(p *win.NMLINK)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_CLICK, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMLINK)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -101,10 +101,7 @@ func (me *Tab) defaultMessageHandlers(parent Parent) {
 }
 
 // Exposes all the control notifications the can be handled.
-//
-// Panics if called after the control has been created.
 func (me *Tab) On() *EventsTab {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
@@ -230,8 +227,8 @@ type EventsTab struct {
 // [NM_CLICK] message handler.
 //
 // [NM_CLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-click-tab
-func (me *EventsTab) NmClick(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_CLICK, func(_ unsafe.Pointer) uintptr {
+func (me *EventsTab) NmClick(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_CLICK, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -240,8 +237,8 @@ func (me *EventsTab) NmClick(fun func()) {
 // [NM_DBLCLK] message handler.
 //
 // [NM_DBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-dblclk-tab
-func (me *EventsTab) NmDblClk(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_DBLCLK, func(_ unsafe.Pointer) uintptr {
+func (me *EventsTab) NmDblClk(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_DBLCLK, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -250,8 +247,8 @@ func (me *EventsTab) NmDblClk(fun func()) {
 // [NM_RCLICK] message handler.
 //
 // [NM_RCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-rclick-tab
-func (me *EventsTab) NmRClick(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RCLICK, func(_ unsafe.Pointer) uintptr {
+func (me *EventsTab) NmRClick(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RCLICK, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -260,8 +257,8 @@ func (me *EventsTab) NmRClick(fun func()) {
 // [NM_RDBLCLK] message handler.
 //
 // [NM_RDBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-rdblclk-tab
-func (me *EventsTab) NmRDblClk(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RDBLCLK, func(_ unsafe.Pointer) uintptr {
+func (me *EventsTab) NmRDblClk(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RDBLCLK, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -270,8 +267,8 @@ func (me *EventsTab) NmRDblClk(fun func()) {
 // [NM_RELEASEDCAPTURE] message handler.
 //
 // [NM_RELEASEDCAPTURE]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-rdblclk-tab
-func (me *EventsTab) NmReleasedCapture(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) uintptr {
+func (me *EventsTab) NmReleasedCapture(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -280,8 +277,8 @@ func (me *EventsTab) NmReleasedCapture(fun func()) {
 // [TCN_FOCUSCHANGE] message handler.
 //
 // [TCN_FOCUSCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/tcn-focuschange
-func (me *EventsTab) TcnFocusChange(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TCN_FOCUSCHANGE, func(_ unsafe.Pointer) uintptr {
+func (me *EventsTab) TcnFocusChange(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TCN_FOCUSCHANGE, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -290,8 +287,8 @@ func (me *EventsTab) TcnFocusChange(fun func()) {
 // [TCN_GETOBJECT] message handler.
 //
 // [TCN_GETOBJECT]: https://learn.microsoft.com/en-us/windows/win32/controls/tcn-getobject
-func (me *EventsTab) TcnGetObject(fun func(p *win.NMOBJECTNOTIFY)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TCN_GETOBJECT, func(p unsafe.Pointer) uintptr {
+func (me *EventsTab) TcnGetObject(fun func(p *win.NMOBJECTNOTIFY)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TCN_GETOBJECT, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMOBJECTNOTIFY)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -300,8 +297,8 @@ func (me *EventsTab) TcnGetObject(fun func(p *win.NMOBJECTNOTIFY)) {
 // [TCN_KEYDOWN] message handler.
 //
 // [TCN_KEYDOWN]: https://learn.microsoft.com/en-us/windows/win32/controls/tcn-keydown
-func (me *EventsTab) TcnKeyDown(fun func(p *win.NMTCKEYDOWN)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TCN_KEYDOWN, func(p unsafe.Pointer) uintptr {
+func (me *EventsTab) TcnKeyDown(fun func(p *win.NMTCKEYDOWN)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TCN_KEYDOWN, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTCKEYDOWN)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -310,8 +307,8 @@ func (me *EventsTab) TcnKeyDown(fun func(p *win.NMTCKEYDOWN)) {
 // [TCN_SELCHANGE] message handler.
 //
 // [TCN_SELCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/tcn-selchange
-func (me *EventsTab) TcnSelChange(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TCN_SELCHANGE, func(_ unsafe.Pointer) uintptr {
+func (me *EventsTab) TcnSelChange(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TCN_SELCHANGE, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -320,8 +317,8 @@ func (me *EventsTab) TcnSelChange(fun func()) {
 // [TCN_SELCHANGING] message handler.
 //
 // [TCN_SELCHANGING]: https://learn.microsoft.com/en-us/windows/win32/controls/tcn-selchanging
-func (me *EventsTab) TcnSelChanging(fun func() bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TCN_SELCHANGING, func(_ unsafe.Pointer) uintptr {
+func (me *EventsTab) TcnSelChanging(fun func() bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TCN_SELCHANGING, func(_ unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun())
 	})
 }
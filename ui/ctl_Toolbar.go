@@ -55,10 +55,7 @@ func (me *Toolbar) defaultMessageHandlers(parent Parent) {
 }
 
 // Exposes all the control notifications the can be handled.
-//
-// Panics if called after the control has been created.
 func (me *Toolbar) On() *EventsToolbar {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
@@ -167,8 +164,8 @@ type EventsToolbar struct {
 // [TBN_BEGINADJUST] message handler.
 //
 // [TBN_BEGINADJUST]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-beginadjust
-func (me *EventsToolbar) TbnBeginAdjust(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_BEGINADJUST, func(_ unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnBeginAdjust(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_BEGINADJUST, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -177,8 +174,8 @@ func (me *EventsToolbar) TbnBeginAdjust(fun func()) {
 // [TBN_BEGINDRAG] message handler.
 //
 // [TBN_BEGINDRAG]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-begindrag
-func (me *EventsToolbar) TbnBeginDrag(fun func(p *win.NMTOOLBAR)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_BEGINDRAG, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnBeginDrag(fun func(p *win.NMTOOLBAR)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_BEGINDRAG, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTOOLBAR)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -187,8 +184,8 @@ func (me *EventsToolbar) TbnBeginDrag(fun func(p *win.NMTOOLBAR)) {
 // [TBN_CUSTHELP] message handler.
 //
 // [TBN_CUSTHELP]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-custhelp
-func (me *EventsToolbar) TbnCustHelp(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_CUSTHELP, func(_ unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnCustHelp(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_CUSTHELP, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -197,8 +194,8 @@ func (me *EventsToolbar) TbnCustHelp(fun func()) {
 // [TBN_DELETINGBUTTON] message handler.
 //
 // [TBN_DELETINGBUTTON]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-deletingbutton
-func (me *EventsToolbar) TbnDeletingButton(fun func(p *win.NMTOOLBAR)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_DELETINGBUTTON, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnDeletingButton(fun func(p *win.NMTOOLBAR)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_DELETINGBUTTON, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTOOLBAR)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -207,8 +204,8 @@ func (me *EventsToolbar) TbnDeletingButton(fun func(p *win.NMTOOLBAR)) {
 // [TBN_DRAGOUT] message handler.
 //
 // [TBN_DRAGOUT]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-dragout
-func (me *EventsToolbar) TbnDragOut(fun func(p *win.NMTOOLBAR)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_DRAGOUT, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnDragOut(fun func(p *win.NMTOOLBAR)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_DRAGOUT, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTOOLBAR)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -217,8 +214,8 @@ func (me *EventsToolbar) TbnDragOut(fun func(p *win.NMTOOLBAR)) {
 // [TBN_DRAGOVER] message handler.
 //
 // [TBN_DRAGOVER]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-dragover
-func (me *EventsToolbar) TbnDragOver(fun func(p *win.NMTBHOTITEM) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_DRAGOVER, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnDragOver(fun func(p *win.NMTBHOTITEM) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_DRAGOVER, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMTBHOTITEM)(p)))
 	})
 }
@@ -226,8 +223,8 @@ func (me *EventsToolbar) TbnDragOver(fun func(p *win.NMTBHOTITEM) bool) {
 // [TBN_DROPDOWN] message handler.
 //
 // [TBN_DROPDOWN]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-dropdown
-func (me *EventsToolbar) TbnDropDown(fun func(p *win.NMTOOLBAR) co.TBDDRET) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_DROPDOWN, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnDropDown(fun func(p *win.NMTOOLBAR) co.TBDDRET) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_DROPDOWN, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMTOOLBAR)(p)))
 	})
 }
@@ -235,8 +232,8 @@ func (me *EventsToolbar) TbnDropDown(fun func(p *win.NMTOOLBAR) co.TBDDRET) {
 // [TBN_DUPACCELERATOR] message handler.
 //
 // [TBN_DUPACCELERATOR]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-dupaccelerator
-func (me *EventsToolbar) TbnDupAccelerator(fun func(p *win.NMTBDUPACCELERATOR) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_DUPACCELERATOR, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnDupAccelerator(fun func(p *win.NMTBDUPACCELERATOR) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_DUPACCELERATOR, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMTBDUPACCELERATOR)(p)))
 	})
 }
@@ -244,8 +241,8 @@ func (me *EventsToolbar) TbnDupAccelerator(fun func(p *win.NMTBDUPACCELERATOR) b
 // [TBN_ENDADJUST] message handler.
 //
 // [TBN_ENDADJUST]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-endadjust
-func (me *EventsToolbar) TbnEndAdjust(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_ENDADJUST, func(_ unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnEndAdjust(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_ENDADJUST, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -254,8 +251,8 @@ func (me *EventsToolbar) TbnEndAdjust(fun func()) {
 // [TBN_ENDDRAG] message handler.
 //
 // [TBN_ENDDRAG]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-enddrag
-func (me *EventsToolbar) TbnEndDrag(fun func(p *win.NMTOOLBAR)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_ENDDRAG, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnEndDrag(fun func(p *win.NMTOOLBAR)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_ENDDRAG, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTOOLBAR)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -264,8 +261,8 @@ func (me *EventsToolbar) TbnEndDrag(fun func(p *win.NMTOOLBAR)) {
 // [TBN_GETBUTTONINFO] message handler.
 //
 // [TBN_GETBUTTONINFO]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-getbuttoninfo
-func (me *EventsToolbar) TbnGetButtonInfo(fun func(p *win.NMTOOLBAR) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_GETBUTTONINFO, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnGetButtonInfo(fun func(p *win.NMTOOLBAR) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_GETBUTTONINFO, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMTOOLBAR)(p)))
 	})
 }
@@ -273,8 +270,8 @@ func (me *EventsToolbar) TbnGetButtonInfo(fun func(p *win.NMTOOLBAR) bool) {
 // [TBN_GETDISPINFO] message handler.
 //
 // [TBN_GETDISPINFO]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-getdispinfo
-func (me *EventsToolbar) TbnGetDispInfo(fun func(p *win.NMTBDISPINFO)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_GETDISPINFO, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnGetDispInfo(fun func(p *win.NMTBDISPINFO)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_GETDISPINFO, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTBDISPINFO)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -283,8 +280,8 @@ func (me *EventsToolbar) TbnGetDispInfo(fun func(p *win.NMTBDISPINFO)) {
 // [TBN_GETINFOTIP] message handler.
 //
 // [TBN_GETINFOTIP]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-getinfotip
-func (me *EventsToolbar) TbnGetInfoTip(fun func(p *win.NMTBGETINFOTIP)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_GETINFOTIP, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnGetInfoTip(fun func(p *win.NMTBGETINFOTIP)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_GETINFOTIP, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTBGETINFOTIP)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -293,8 +290,8 @@ func (me *EventsToolbar) TbnGetInfoTip(fun func(p *win.NMTBGETINFOTIP)) {
 // [TBN_GETOBJECT] message handler.
 //
 // [TBN_GETOBJECT]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-getobject
-func (me *EventsToolbar) TbnGetObject(fun func(p *win.NMOBJECTNOTIFY)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_GETOBJECT, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnGetObject(fun func(p *win.NMOBJECTNOTIFY)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_GETOBJECT, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMOBJECTNOTIFY)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -303,8 +300,8 @@ func (me *EventsToolbar) TbnGetObject(fun func(p *win.NMOBJECTNOTIFY)) {
 // [TBN_HOTITEMCHANGE] message handler.
 //
 // [TBN_HOTITEMCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-hotitemchange
-func (me *EventsToolbar) TbnHotItemChange(fun func(*win.NMTBHOTITEM) int) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_HOTITEMCHANGE, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnHotItemChange(fun func(*win.NMTBHOTITEM) int) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_HOTITEMCHANGE, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMTBHOTITEM)(p)))
 	})
 }
@@ -312,8 +309,8 @@ func (me *EventsToolbar) TbnHotItemChange(fun func(*win.NMTBHOTITEM) int) {
 // [TBN_INITCUSTOMIZE] message handler.
 //
 // [TBN_INITCUSTOMIZE]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-initcustomize
-func (me *EventsToolbar) TbnInitCustomize(fun func() co.TBNRF) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_INITCUSTOMIZE, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnInitCustomize(fun func() co.TBNRF) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_INITCUSTOMIZE, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun())
 	})
 }
@@ -321,8 +318,8 @@ func (me *EventsToolbar) TbnInitCustomize(fun func() co.TBNRF) {
 // [TBN_MAPACCELERATOR] message handler.
 //
 // [TBN_MAPACCELERATOR]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-mapaccelerator
-func (me *EventsToolbar) TbnMapAccelerator(fun func(p *win.NMCHAR) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_MAPACCELERATOR, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnMapAccelerator(fun func(p *win.NMCHAR) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_MAPACCELERATOR, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMCHAR)(p)))
 	})
 }
@@ -330,8 +327,8 @@ func (me *EventsToolbar) TbnMapAccelerator(fun func(p *win.NMCHAR) bool) {
 // [TBN_QUERYDELETE] message handler.
 //
 // [TBN_QUERYDELETE]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-querydelete
-func (me *EventsToolbar) TbnQueryDelete(fun func(p *win.NMTOOLBAR) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_QUERYDELETE, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnQueryDelete(fun func(p *win.NMTOOLBAR) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_QUERYDELETE, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMTOOLBAR)(p)))
 	})
 }
@@ -339,8 +336,8 @@ func (me *EventsToolbar) TbnQueryDelete(fun func(p *win.NMTOOLBAR) bool) {
 // [TBN_QUERYINSERT] message handler.
 //
 // [TBN_QUERYINSERT]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-queryinsert
-func (me *EventsToolbar) TbnQueryInsert(fun func(p *win.NMTOOLBAR) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_QUERYINSERT, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnQueryInsert(fun func(p *win.NMTOOLBAR) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_QUERYINSERT, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMTOOLBAR)(p)))
 	})
 }
@@ -348,8 +345,8 @@ func (me *EventsToolbar) TbnQueryInsert(fun func(p *win.NMTOOLBAR) bool) {
 // [TBN_RESET] message handler.
 //
 // [TBN_RESET]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-reset
-func (me *EventsToolbar) TbnReset(fun func() co.TBNRF) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_RESET, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnReset(fun func() co.TBNRF) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_RESET, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun())
 	})
 }
@@ -357,8 +354,8 @@ func (me *EventsToolbar) TbnReset(fun func() co.TBNRF) {
 // [TBN_RESTORE] message handler.
 //
 // [TBN_RESTORE]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-restore
-func (me *EventsToolbar) TbnRestore(fun func(p *win.NMTBRESTORE) int) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_RESTORE, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnRestore(fun func(p *win.NMTBRESTORE) int) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_RESTORE, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMTBRESTORE)(p)))
 	})
 }
@@ -366,8 +363,8 @@ func (me *EventsToolbar) TbnRestore(fun func(p *win.NMTBRESTORE) int) {
 // [TBN_SAVE] message handler.
 //
 // [TBN_SAVE]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-save
-func (me *EventsToolbar) TbnSave(fun func(p *win.NMTBSAVE)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_SAVE, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnSave(fun func(p *win.NMTBSAVE)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_SAVE, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTBSAVE)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -376,8 +373,8 @@ func (me *EventsToolbar) TbnSave(fun func(p *win.NMTBSAVE)) {
 // [TBN_TOOLBARCHANGE] message handler.
 //
 // [TBN_TOOLBARCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-toolbarchange
-func (me *EventsToolbar) TbnToolbarChange(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_TOOLBARCHANGE, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnToolbarChange(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_TOOLBARCHANGE, func(p unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -386,8 +383,8 @@ func (me *EventsToolbar) TbnToolbarChange(fun func()) {
 // [TBN_WRAPACCELERATOR] message handler.
 //
 // [TBN_WRAPACCELERATOR]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-wrapaccelerator
-func (me *EventsToolbar) TbnWrapAccelerator(fun func(p *win.NMTBWRAPACCELERATOR) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_WRAPACCELERATOR, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnWrapAccelerator(fun func(p *win.NMTBWRAPACCELERATOR) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_WRAPACCELERATOR, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMTBWRAPACCELERATOR)(p)))
 	})
 }
@@ -395,8 +392,8 @@ func (me *EventsToolbar) TbnWrapAccelerator(fun func(p *win.NMTBWRAPACCELERATOR)
 // [TBN_WRAPHOTITEM] message handler.
 //
 // [TBN_WRAPHOTITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/tbn-wraphotitem
-func (me *EventsToolbar) TbnWrapHotItem(fun func(p *win.NMTBWRAPHOTITEM) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TBN_WRAPHOTITEM, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) TbnWrapHotItem(fun func(p *win.NMTBWRAPHOTITEM) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TBN_WRAPHOTITEM, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMTBWRAPHOTITEM)(p)))
 	})
 }
@@ -404,8 +401,8 @@ func (me *EventsToolbar) TbnWrapHotItem(fun func(p *win.NMTBWRAPHOTITEM) bool) {
 // [NM_CHAR] message handler.
 //
 // [NM_CHAR]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-char-toolbar
-func (me *EventsToolbar) NmChar(fun func(p *win.NMCHAR) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_CHAR, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) NmChar(fun func(p *win.NMCHAR) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_CHAR, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMCHAR)(p)))
 	})
 }
@@ -413,8 +410,8 @@ func (me *EventsToolbar) NmChar(fun func(p *win.NMCHAR) bool) {
 // [NM_CLICK] message handler.
 //
 // [NM_CLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-click-toolbar
-func (me *EventsToolbar) NmClick(fun func(p *win.NMMOUSE) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_CLICK, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) NmClick(fun func(p *win.NMMOUSE) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_CLICK, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMMOUSE)(p)))
 	})
 }
@@ -422,8 +419,8 @@ func (me *EventsToolbar) NmClick(fun func(p *win.NMMOUSE) bool) {
 // [NM_CUSTOMDRAW] message handler.
 //
 // [NM_CUSTOMDRAW]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-customdraw-toolbar
-func (me *EventsToolbar) NmCustomDraw(fun func(p *win.NMTBCUSTOMDRAW) co.CDRF) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_CUSTOMDRAW, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) NmCustomDraw(fun func(p *win.NMTBCUSTOMDRAW) co.CDRF) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_CUSTOMDRAW, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMTBCUSTOMDRAW)(p)))
 	})
 }
@@ -431,8 +428,8 @@ func (me *EventsToolbar) NmCustomDraw(fun func(p *win.NMTBCUSTOMDRAW) co.CDRF) {
 // [NM_DBLCLK] message handler.
 //
 // [NM_DBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-dblclk-toolbar
-func (me *EventsToolbar) NmDblClk(fun func(p *win.NMMOUSE) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_DBLCLK, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) NmDblClk(fun func(p *win.NMMOUSE) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_DBLCLK, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMMOUSE)(p)))
 	})
 }
@@ -440,8 +437,8 @@ func (me *EventsToolbar) NmDblClk(fun func(p *win.NMMOUSE) bool) {
 // [NM_KEYDOWN] message handler.
 //
 // [NM_KEYDOWN]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-keydown-toolbar
-func (me *EventsToolbar) NmKeyDown(fun func(p *win.NMKEY) int) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_KEYDOWN, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) NmKeyDown(fun func(p *win.NMKEY) int) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_KEYDOWN, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMKEY)(p)))
 	})
 }
@@ -449,8 +446,8 @@ func (me *EventsToolbar) NmKeyDown(fun func(p *win.NMKEY) int) {
 // [NM_LDOWN] message handler.
 //
 // [NM_LDOWN]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-ldown-toolbar
-func (me *EventsToolbar) NmLDown(fun func(p *win.NMMOUSE) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_LDOWN, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) NmLDown(fun func(p *win.NMMOUSE) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_LDOWN, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMMOUSE)(p)))
 	})
 }
@@ -458,8 +455,8 @@ func (me *EventsToolbar) NmLDown(fun func(p *win.NMMOUSE) bool) {
 // [NM_RCLICK] message handler.
 //
 // [NM_RCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-rclick-toolbar
-func (me *EventsToolbar) NmRClick(fun func(p *win.NMMOUSE) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RCLICK, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) NmRClick(fun func(p *win.NMMOUSE) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RCLICK, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMMOUSE)(p)))
 	})
 }
@@ -467,8 +464,8 @@ func (me *EventsToolbar) NmRClick(fun func(p *win.NMMOUSE) bool) {
 // [NM_RDBLCLK] message handler.
 //
 // [NM_RDBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-rdblclk-toolbar
-func (me *EventsToolbar) NmRDblClk(fun func(p *win.NMMOUSE) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RDBLCLK, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) NmRDblClk(fun func(p *win.NMMOUSE) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RDBLCLK, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMMOUSE)(p)))
 	})
 }
@@ -476,8 +473,8 @@ func (me *EventsToolbar) NmRDblClk(fun func(p *win.NMMOUSE) bool) {
 // [NM_RELEASEDCAPTURE] message handler.
 //
 // [NM_RELEASEDCAPTURE]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-releasedcapture-list-view-
-func (me *EventsToolbar) NmReleasedCapture(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) uintptr {
+func (me *EventsToolbar) NmReleasedCapture(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -486,8 +483,8 @@ func (me *EventsToolbar) NmReleasedCapture(fun func()) {
 // [NM_TOOLTIPSCREATED] message handler.
 //
 // [NM_TOOLTIPSCREATED]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-tooltipscreated-toolbar-
-func (me *EventsToolbar) NmTooltipsCreated(fun func(p *win.NMTOOLTIPSCREATED)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_TOOLTIPSCREATED, func(p unsafe.Pointer) uintptr {
+func (me *EventsToolbar) NmTooltipsCreated(fun func(p *win.NMTOOLTIPSCREATED)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_TOOLTIPSCREATED, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTOOLTIPSCREATED)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -0,0 +1,443 @@
+//go:build windows
+
+package ui
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// Native [tooltip] control.
+//
+// Unlike the other controls, a tooltip is a popup window owned by the parent,
+// so it has no control ID and it's not affected by the layout. Tools can be
+// attached to any child control, or to a rectangle of the parent client area.
+//
+// [tooltip]: https://learn.microsoft.com/en-us/windows/win32/controls/tooltip-controls
+type Tooltip struct {
+	hWnd       win.HWND
+	hParent    win.HWND // owner window, which receives the notifications
+	events     EventsTooltip
+	toolFlags  co.TTF                    // added to the flags of all tools
+	callbacks  map[uintptr]func() string // text callbacks of the tools, keyed by uId
+	dispBuf    []uint16                  // keeps the text of the last TTN_GETDISPINFO alive
+	nextRectId uintptr                   // uId of the next rectangle or tracking tool
+	pending    []func()                  // tools added before the tooltip was created
+}
+
+// Creates a new [Tooltip] with [win.CreateWindowEx].
+//
+// Tools can be added right away; if the tooltip is not created yet, they will
+// be added right after the parent window creation.
+//
+// # Example
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//	var btnSave ui.Button  // initialized somewhere
+//
+//	tip := ui.NewTooltip(
+//		wndOwner,
+//		ui.OptsTooltip().
+//			CtrlStyle(co.TTS_ALWAYSTIP|co.TTS_NOPREFIX|co.TTS_BALLOON),
+//	)
+//	tip.AddTool(btnSave, "Save the current file\nShortcut: Ctrl+S")
+func NewTooltip(parent Parent, opts *VarOptsTooltip) *Tooltip {
+	me := newTooltipObj(opts.toolFlags)
+
+	parent.base().beforeUserEvents.Wm(parent.base().wndTy.initMsg(), func(_ Wm) uintptr {
+		me.create(parent.Hwnd(), opts.ctrlStyle, opts.wndExStyle, opts.maxWidth)
+		return 0 // ignored
+	})
+
+	parent.base().afterUserEvents.Wm(parent.base().wndTy.initMsg(), func(_ Wm) uintptr {
+		// After the user handlers, so all controls exist.
+		me.flushPending()
+		return 0 // ignored
+	})
+
+	parent.base().beforeUserEvents.Wm(co.WM_NOTIFY, func(p Wm) uintptr {
+		me.processNotify((*win.NMHDR)(unsafe.Pointer(p.LParam)))
+		return 0 // ignored
+	})
+
+	return me
+}
+
+// Constructor of the object itself, without creating the window.
+func newTooltipObj(toolFlags co.TTF) *Tooltip {
+	return &Tooltip{
+		toolFlags:  toolFlags,
+		callbacks:  make(map[uintptr]func() string),
+		nextRectId: 1,
+	}
+}
+
+func (me *Tooltip) create(hParent win.HWND, ctrlStyle co.TTS, wndExStyle co.WS_EX, maxWidth int) {
+	if me.hWnd != 0 {
+		panic("Cannot create control twice.")
+	}
+
+	hInst, _ := hParent.HInstance()
+	me.hParent = hParent
+	me.hWnd, _ = win.CreateWindowEx(wndExStyle, win.ClassNameStr("tooltips_class32"),
+		"", co.WS_POPUP|co.WS(ctrlStyle),
+		0, 0, 0, 0, hParent, win.HMENU(0), hInst, win.LPARAM(0))
+	if maxWidth != 0 {
+		me.SetMaxWidth(maxWidth)
+	}
+}
+
+func (me *Tooltip) flushPending() {
+	pending := me.pending
+	me.pending = nil
+	for _, fun := range pending {
+		fun()
+	}
+}
+
+func (me *Tooltip) processNotify(hdr *win.NMHDR) {
+	if me.hWnd == 0 || hdr.HWndFrom != me.hWnd {
+		return // not from this tooltip
+	}
+
+	switch co.NM(hdr.Code) {
+	case co.TTN_GETDISPINFO:
+		if fun, ok := me.callbacks[hdr.IdFrom]; ok {
+			di := (*win.NMTTDISPINFO)(unsafe.Pointer(hdr))
+			me.dispBuf = wstr.EncodeToSlice(fun())
+			di.LpszText = &me.dispBuf[0]
+		}
+	case co.TTN_SHOW:
+		if me.events.show != nil {
+			me.events.show(TooltipTool{me, hdr.IdFrom})
+		}
+	case co.TTN_POP:
+		if me.events.pop != nil {
+			me.events.pop(TooltipTool{me, hdr.IdFrom})
+		}
+	case co.TTN_LINKCLICK:
+		if me.events.linkClick != nil {
+			me.events.linkClick(TooltipTool{me, hdr.IdFrom})
+		}
+	}
+}
+
+// Runs the function now, if the tooltip is already created; otherwise, runs
+// it right after the creation.
+func (me *Tooltip) runOrQueue(fun func()) {
+	if me.hWnd == 0 {
+		me.pending = append(me.pending, fun)
+	} else {
+		fun()
+	}
+}
+
+func (me *Tooltip) addTool(flags co.TTF, uId uintptr, rc win.RECT, pText *uint16) {
+	var ti win.TTTOOLINFO
+	ti.SetCbSize()
+	ti.UFlags = flags | me.toolFlags
+	ti.Hwnd = me.hParent
+	ti.UId = uId
+	ti.Rect = rc
+	ti.LpszText = pText
+
+	ret, _ := me.hWnd.SendMessage(co.TTM_ADDTOOL, 0, win.LPARAM(unsafe.Pointer(&ti)))
+	if ret == 0 {
+		panic("TTM_ADDTOOL failed.")
+	}
+}
+
+// Returns the underlying HWND handle of this window.
+//
+// Note that this handle is initially zero, existing only after window creation.
+func (me *Tooltip) Hwnd() win.HWND {
+	return me.hWnd
+}
+
+// Exposes all the control notifications the can be handled.
+func (me *Tooltip) On() *EventsTooltip {
+	return &me.events
+}
+
+// Activates or deactivates the tooltip with [TTM_ACTIVATE]. A deactivated
+// tooltip doesn't show any of its tools.
+//
+// [TTM_ACTIVATE]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-activate
+func (me *Tooltip) Activate(active bool) {
+	me.hWnd.SendMessage(co.TTM_ACTIVATE, win.WPARAM(utl.BoolToUintptr(active)), 0)
+}
+
+// Adds a tool to the given child control, with a fixed text, using
+// [TTM_ADDTOOL]. Line breaks in the text are shown only if a maximum width is
+// set.
+//
+// The tool can be retrieved later with [Tooltip.Tool].
+//
+// Panics on error.
+//
+// [TTM_ADDTOOL]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-addtool
+func (me *Tooltip) AddTool(ctrl ChildControl, text string) {
+	me.runOrQueue(func() {
+		wbuf := wstr.NewBufEncoder()
+		defer wbuf.Free()
+		me.addTool(co.TTF_IDISHWND|co.TTF_SUBCLASS, uintptr(ctrl.Hwnd()),
+			win.RECT{}, (*uint16)(wbuf.PtrAllowEmpty(text)))
+	})
+}
+
+// Adds a tool to the given child control, using [TTM_ADDTOOL]. The text is
+// retrieved by calling the given function each time the tooltip is about to
+// be shown.
+//
+// The tool can be retrieved later with [Tooltip.Tool].
+//
+// Panics on error.
+//
+// # Example
+//
+//	var tip *ui.Tooltip  // initialized somewhere
+//	var txtName *ui.Edit // initialized somewhere
+//
+//	tip.AddToolCallback(txtName, func() string {
+//		return fmt.Sprintf("%d chars typed", len(txtName.Text()))
+//	})
+//
+// [TTM_ADDTOOL]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-addtool
+func (me *Tooltip) AddToolCallback(ctrl ChildControl, fun func() string) {
+	me.runOrQueue(func() {
+		me.callbacks[uintptr(ctrl.Hwnd())] = fun
+		me.addTool(co.TTF_IDISHWND|co.TTF_SUBCLASS, uintptr(ctrl.Hwnd()),
+			win.RECT{}, (*uint16)(unsafe.Pointer(_LPSTR_TEXTCALLBACK)))
+	})
+}
+
+// Adds a tool to a rectangle of the parent window client area, with a fixed
+// text, using [TTM_ADDTOOL].
+//
+// Panics on error.
+//
+// [TTM_ADDTOOL]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-addtool
+func (me *Tooltip) AddToolRect(rc win.RECT, text string) TooltipTool {
+	tool := TooltipTool{me, me.nextRectId}
+	me.nextRectId++
+	me.runOrQueue(func() {
+		wbuf := wstr.NewBufEncoder()
+		defer wbuf.Free()
+		me.addTool(co.TTF_SUBCLASS, tool.uId, rc, (*uint16)(wbuf.PtrAllowEmpty(text)))
+	})
+	return tool
+}
+
+// Adds a tracking tool, using [TTM_ADDTOOL]. A tracking tool is not attached
+// to any window: it's shown with [TooltipTool.TrackActivate], at the position
+// set with [Tooltip.TrackPosition].
+//
+// Panics on error.
+//
+// [TTM_ADDTOOL]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-addtool
+func (me *Tooltip) AddToolTracking(text string) TooltipTool {
+	tool := TooltipTool{me, me.nextRectId}
+	me.nextRectId++
+	me.runOrQueue(func() {
+		wbuf := wstr.NewBufEncoder()
+		defer wbuf.Free()
+		me.addTool(co.TTF_TRACK|co.TTF_ABSOLUTE, tool.uId, win.RECT{},
+			(*uint16)(wbuf.PtrAllowEmpty(text)))
+	})
+	return tool
+}
+
+// Hides the tooltip, if visible, with [TTM_POP].
+//
+// [TTM_POP]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-pop
+func (me *Tooltip) Pop() {
+	me.hWnd.SendMessage(co.TTM_POP, 0, 0)
+}
+
+// Sets the initial, pop-up and reshow delays, in milliseconds, with
+// [TTM_SETDELAYTIME]. Passing -1 restores the default value.
+//
+// [TTM_SETDELAYTIME]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-setdelaytime
+func (me *Tooltip) SetDelayTime(which co.TTDT, milliseconds int) {
+	me.hWnd.SendMessage(co.TTM_SETDELAYTIME,
+		win.WPARAM(which), win.MAKELPARAM(uint16(int16(milliseconds)), 0))
+}
+
+// Sets the maximum width of the tooltip, in pixels, with [TTM_SETMAXTIPWIDTH].
+// This makes the tooltip multiline: longer texts are wrapped, and line breaks
+// are respected. Passing -1 makes it single-line again.
+//
+// [TTM_SETMAXTIPWIDTH]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-setmaxtipwidth
+func (me *Tooltip) SetMaxWidth(width int) {
+	me.hWnd.SendMessage(co.TTM_SETMAXTIPWIDTH, 0, win.LPARAM(width))
+}
+
+// Sets the title and the icon shown above the text, with [TTM_SETTITLE].
+// Passing an empty title removes it.
+//
+// Panics on error.
+//
+// [TTM_SETTITLE]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-settitle
+func (me *Tooltip) SetTitle(icon co.TTI, title string) {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	ret, _ := me.hWnd.SendMessage(co.TTM_SETTITLE,
+		win.WPARAM(icon), win.LPARAM(wbuf.PtrAllowEmpty(title)))
+	if ret == 0 {
+		panic("TTM_SETTITLE failed.")
+	}
+}
+
+// Returns the tool attached to the given child control, which may not exist;
+// this can be checked with [TooltipTool.Exists].
+func (me *Tooltip) Tool(ctrl ChildControl) TooltipTool {
+	return TooltipTool{me, uintptr(ctrl.Hwnd())}
+}
+
+// Sets the position of the tracking tooltip, in screen coordinates, with
+// [TTM_TRACKPOSITION].
+//
+// [TTM_TRACKPOSITION]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-trackposition
+func (me *Tooltip) TrackPosition(x, y int) {
+	me.hWnd.SendMessage(co.TTM_TRACKPOSITION,
+		0, win.MAKELPARAM(uint16(int16(x)), uint16(int16(y))))
+}
+
+const _LPSTR_TEXTCALLBACK = ^uintptr(0) // LPSTR_TEXTCALLBACK, for TTTOOLINFO
+
+// Options for [NewTooltip]; returned by [OptsTooltip].
+type VarOptsTooltip struct {
+	ctrlStyle  co.TTS
+	wndExStyle co.WS_EX
+	maxWidth   int
+	toolFlags  co.TTF
+}
+
+// Options for [NewTooltip].
+func OptsTooltip() *VarOptsTooltip {
+	return &VarOptsTooltip{
+		ctrlStyle:  co.TTS_ALWAYSTIP | co.TTS_NOPREFIX,
+		wndExStyle: co.WS_EX_TOPMOST,
+		maxWidth:   DpiX(400),
+	}
+}
+
+// Tooltip control [style], passed to [win.CreateWindowEx].
+//
+// Use co.TTS_BALLOON for balloon tooltips.
+//
+// Defaults to co.TTS_ALWAYSTIP | co.TTS_NOPREFIX.
+//
+// [style]: https://learn.microsoft.com/en-us/windows/win32/controls/tooltip-styles
+func (o *VarOptsTooltip) CtrlStyle(s co.TTS) *VarOptsTooltip { o.ctrlStyle = s; return o }
+
+// Window extended style, passed to [win.CreateWindowEx].
+//
+// Defaults to co.WS_EX_TOPMOST.
+func (o *VarOptsTooltip) WndExStyle(s co.WS_EX) *VarOptsTooltip { o.wndExStyle = s; return o }
+
+// Maximum width of the tooltip, in pixels, which makes it multiline. Zero
+// keeps the system default, which is single-line.
+//
+// Defaults to ui.DpiX(400).
+func (o *VarOptsTooltip) MaxWidth(w int) *VarOptsTooltip { o.maxWidth = w; return o }
+
+// Flags added to all the tools of the tooltip, like co.TTF_CENTERTIP, or
+// co.TTF_PARSELINKS, which is required by [EventsTooltip.TtnLinkClick].
+//
+// Defaults to none.
+func (o *VarOptsTooltip) ToolFlags(f co.TTF) *VarOptsTooltip { o.toolFlags = f; return o }
+
+// Native [tooltip] control events.
+//
+// You cannot create this object directly, it will be created automatically
+// by the owning control.
+//
+// [tooltip]: https://learn.microsoft.com/en-us/windows/win32/controls/tooltip-controls
+type EventsTooltip struct {
+	show      func(tool TooltipTool)
+	pop       func(tool TooltipTool)
+	linkClick func(tool TooltipTool)
+}
+
+// [TTN_LINKCLICK] message handler.
+//
+// [TTN_LINKCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/ttn-linkclick
+func (me *EventsTooltip) TtnLinkClick(fun func(tool TooltipTool)) {
+	me.linkClick = fun
+}
+
+// [TTN_POP] message handler.
+//
+// [TTN_POP]: https://learn.microsoft.com/en-us/windows/win32/controls/ttn-pop
+func (me *EventsTooltip) TtnPop(fun func(tool TooltipTool)) {
+	me.pop = fun
+}
+
+// [TTN_SHOW] message handler. The tooltip is always shown at its default
+// position.
+//
+// [TTN_SHOW]: https://learn.microsoft.com/en-us/windows/win32/controls/ttn-show
+func (me *EventsTooltip) TtnShow(fun func(tool TooltipTool)) {
+	me.show = fun
+}
+
+// Tooltips shared by the controls of each parent window, used by SetTooltip.
+var _sharedTooltips = make(map[win.HWND]*Tooltip)
+
+// Sets the tooltip text of the given control, which must be already created –
+// for example, within [EventsWindow.WmCreate] or [EventsWindow.WmInitDialog].
+// All the controls of a parent window share the same tooltip, which is
+// created when first needed. An empty text removes the tooltip of the control.
+//
+// For more options, like balloon or tracking tooltips, use [NewTooltip].
+//
+// Panics if the control was not created yet.
+//
+// # Example
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//	var btnSave ui.Button  // initialized somewhere
+//
+//	wndOwner.On().WmCreate(func(_ ui.WmCreate) int {
+//		ui.SetTooltip(btnSave, "Save the current file")
+//		return 0
+//	})
+func SetTooltip(ctrl ChildControl, text string) {
+	if ctrl.Hwnd() == 0 {
+		panic("Cannot set a tooltip before the control is created.")
+	}
+	hParent, _ := ctrl.Hwnd().GetAncestor(co.GA_PARENT)
+
+	tip, ok := _sharedTooltips[hParent]
+	if !ok || !tip.hWnd.IsWindow() {
+		if text == "" {
+			return // nothing to remove
+		}
+		for hOwner, other := range _sharedTooltips { // drop tooltips of destroyed windows
+			if !other.hWnd.IsWindow() {
+				delete(_sharedTooltips, hOwner)
+			}
+		}
+		tip = newTooltipObj(co.TTF(0))
+		tip.create(hParent, co.TTS_ALWAYSTIP|co.TTS_NOPREFIX, co.WS_EX_TOPMOST,
+			DpiX(400))
+		_sharedTooltips[hParent] = tip
+	}
+
+	tool := tip.Tool(ctrl)
+	if text == "" {
+		if tool.Exists() {
+			tool.Delete()
+		}
+	} else if tool.Exists() {
+		tool.SetText(text)
+	} else {
+		tip.AddTool(ctrl, text)
+	}
+}
@@ -0,0 +1,80 @@
+//go:build windows
+
+package ui
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// A tool of a [Tooltip]: a child control, a rectangle of the parent window, or
+// a tracking tool.
+type TooltipTool struct {
+	owner *Tooltip
+	uId   uintptr
+}
+
+func (me TooltipTool) toolInfo() win.TTTOOLINFO {
+	var ti win.TTTOOLINFO
+	ti.SetCbSize()
+	ti.Hwnd = me.owner.hParent
+	ti.UId = me.uId
+	return ti
+}
+
+// Deletes the tool with [TTM_DELTOOL].
+//
+// [TTM_DELTOOL]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-deltool
+func (me TooltipTool) Delete() {
+	ti := me.toolInfo()
+	me.owner.hWnd.SendMessage(co.TTM_DELTOOL, 0, win.LPARAM(unsafe.Pointer(&ti)))
+	delete(me.owner.callbacks, me.uId)
+}
+
+// Tells whether the tool exists, with [TTM_GETTOOLINFO].
+//
+// [TTM_GETTOOLINFO]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-gettoolinfo
+func (me TooltipTool) Exists() bool {
+	ti := me.toolInfo()
+	ret, _ := me.owner.hWnd.SendMessage(co.TTM_GETTOOLINFO,
+		0, win.LPARAM(unsafe.Pointer(&ti)))
+	return ret != 0
+}
+
+// Sets the rectangle of a rectangle tool, in parent window client
+// coordinates, with [TTM_NEWTOOLRECT].
+//
+// [TTM_NEWTOOLRECT]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-newtoolrect
+func (me TooltipTool) SetRect(rc win.RECT) {
+	ti := me.toolInfo()
+	ti.Rect = rc
+	me.owner.hWnd.SendMessage(co.TTM_NEWTOOLRECT, 0, win.LPARAM(unsafe.Pointer(&ti)))
+}
+
+// Sets the text of the tool with [TTM_UPDATETIPTEXT]. If the tool had a text
+// callback, it's discarded.
+//
+// [TTM_UPDATETIPTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-updatetiptext
+func (me TooltipTool) SetText(text string) {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	delete(me.owner.callbacks, me.uId)
+	ti := me.toolInfo()
+	ti.LpszText = (*uint16)(wbuf.PtrAllowEmpty(text))
+	me.owner.hWnd.SendMessage(co.TTM_UPDATETIPTEXT, 0, win.LPARAM(unsafe.Pointer(&ti)))
+}
+
+// Shows or hides a tracking tool with [TTM_TRACKACTIVATE]. The position is set
+// with [Tooltip.TrackPosition].
+//
+// [TTM_TRACKACTIVATE]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-trackactivate
+func (me TooltipTool) TrackActivate(show bool) {
+	ti := me.toolInfo()
+	me.owner.hWnd.SendMessage(co.TTM_TRACKACTIVATE,
+		win.WPARAM(utl.BoolToUintptr(show)), win.LPARAM(unsafe.Pointer(&ti)))
+}
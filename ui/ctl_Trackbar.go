@@ -80,10 +80,7 @@ func NewTrackbarDlg(parent Parent, ctrlId uint16, layout LAY) *Trackbar {
 }
 
 // Exposes all the control notifications the can be handled.
-//
-// Panics if called after the control has been created.
 func (me *Trackbar) On() *EventsTrackbar {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
@@ -246,8 +243,8 @@ type EventsTrackbar struct {
 // [TRBN_THUMBPOSCHANGING] message handler.
 //
 // [TRBN_THUMBPOSCHANGING]: https://learn.microsoft.com/en-us/windows/win32/controls/trbn-thumbposchanging
-func (me *EventsTrackbar) ThumbPosChanging(fun func(p *win.NMTRBTHUMBPOSCHANGING) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TRBN_THUMBPOSCHANGING, func(p unsafe.Pointer) uintptr {
+func (me *EventsTrackbar) ThumbPosChanging(fun func(p *win.NMTRBTHUMBPOSCHANGING) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TRBN_THUMBPOSCHANGING, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMTRBTHUMBPOSCHANGING)(p)))
 	})
 }
@@ -255,8 +252,8 @@ func (me *EventsTrackbar) ThumbPosChanging(fun func(p *win.NMTRBTHUMBPOSCHANGING
 // [WM_HSCROLL] message handler.
 //
 // [WM_HSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-hscroll
-func (me *EventsTrackbar) WmHScroll(fun func(p WmScroll)) {
-	me.parentEvents.Wm(co.WM_HSCROLL, func(p Wm) uintptr {
+func (me *EventsTrackbar) WmHScroll(fun func(p WmScroll)) EventToken {
+	return me.parentEvents.Wm(co.WM_HSCROLL, func(p Wm) uintptr {
 		fun(WmScroll{Raw: p})
 		return me.parentEvents.defProcVal
 	})
@@ -265,8 +262,8 @@ func (me *EventsTrackbar) WmHScroll(fun func(p WmScroll)) {
 // [WM_VSCROLL] message handler.
 //
 // [WM_VSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-vscroll
-func (me *EventsTrackbar) WmVScroll(fun func(p WmScroll)) {
-	me.parentEvents.Wm(co.WM_VSCROLL, func(p Wm) uintptr {
+func (me *EventsTrackbar) WmVScroll(fun func(p WmScroll)) EventToken {
+	return me.parentEvents.Wm(co.WM_VSCROLL, func(p Wm) uintptr {
 		fun(WmScroll{Raw: p})
 		return me.parentEvents.defProcVal
 	})
@@ -275,8 +272,8 @@ func (me *EventsTrackbar) WmVScroll(fun func(p WmScroll)) {
 // [NM_CUSTOMDRAW] message handler.
 //
 // [NM_CUSTOMDRAW]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-customdraw-trackbar
-func (me *EventsTrackbar) NmCustomDraw(fun func(p *win.NMCUSTOMDRAW) co.CDRF) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_CUSTOMDRAW, func(p unsafe.Pointer) uintptr {
+func (me *EventsTrackbar) NmCustomDraw(fun func(p *win.NMCUSTOMDRAW) co.CDRF) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_CUSTOMDRAW, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMCUSTOMDRAW)(p)))
 	})
 }
@@ -284,8 +281,8 @@ func (me *EventsTrackbar) NmCustomDraw(fun func(p *win.NMCUSTOMDRAW) co.CDRF) {
 // [NM_RELEASEDCAPTURE] message handler.
 //
 // [NM_RELEASEDCAPTURE]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-releasedcapture-trackbar-
-func (me *EventsTrackbar) NmReleasedCapture(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) uintptr {
+func (me *EventsTrackbar) NmReleasedCapture(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -0,0 +1,363 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+const _WM_UI_TRAY = co.WM_APP + 0x3ffe // Internal callback message of tray icons.
+
+var _trayIconNextId uint32 // uID of the next tray icon, unique within the process
+
+// Icon in the [notification area] of the taskbar, also known as system tray.
+//
+// The icon is bound to a parent window, which receives its mouse events. It's
+// added when the parent is created, and removed when the parent is destroyed.
+// If Explorer restarts, the icon is automatically added again – this only
+// works if the parent is a top-level window, since the TaskbarCreated message
+// is broadcast only to these.
+//
+// [notification area]: https://learn.microsoft.com/en-us/windows/win32/shell/notification-area
+type TrayIcon struct {
+	hParent      win.HWND
+	uId          uint32
+	hIcon        win.HICON
+	tooltip      string
+	hidden       bool
+	hContextMenu win.HMENU
+	added        bool
+	events       EventsTrayIcon
+}
+
+// Creates a new [TrayIcon] with [Shell_NotifyIcon].
+//
+// # Example
+//
+//	const ID_MENU_TRAY uint16 = 3000
+//	const ID_EXIT uint16 = 3001
+//
+//	var wndMain *ui.Main // initialized somewhere
+//
+//	hInst, _ := win.GetModuleHandle("")
+//	hMenu, _ := hInst.LoadMenu(win.ResIdInt(ID_MENU_TRAY))
+//
+//	tray := ui.NewTrayIcon(
+//		wndMain,
+//		ui.OptsTrayIcon().
+//			IconId(101).
+//			Tooltip("My program").
+//			ContextMenu(hMenu),
+//	)
+//
+//	tray.On().DblClick(func() {
+//		wndMain.Hwnd().ShowWindow(co.SW_RESTORE)
+//	})
+//
+//	wndMain.On().WmCommandAccelMenu(ID_EXIT, func() {
+//		wndMain.Hwnd().SendMessage(co.WM_CLOSE, 0, 0)
+//	})
+//
+// [Shell_NotifyIcon]: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shell_notifyiconw
+func NewTrayIcon(parent Parent, opts *VarOptsTrayIcon) *TrayIcon {
+	_trayIconNextId++
+	me := &TrayIcon{
+		uId:          _trayIconNextId,
+		hIcon:        opts.hIcon,
+		tooltip:      opts.tooltip,
+		hidden:       opts.hidden,
+		hContextMenu: opts.contextMenu,
+	}
+
+	parent.base().beforeUserEvents.Wm(parent.base().wndTy.initMsg(), func(_ Wm) uintptr {
+		me.hParent = parent.Hwnd()
+		if opts.iconId != 0 {
+			hInst, _ := me.hParent.HInstance()
+			hGdiobj, err := hInst.LoadImage(win.ResIdInt(opts.iconId), co.IMAGE_ICON,
+				uint(win.GetSystemMetrics(co.SM_CXSMICON)), uint(win.GetSystemMetrics(co.SM_CYSMICON)),
+				co.LR_DEFAULTCOLOR|co.LR_SHARED)
+			if err != nil {
+				panic(err)
+			}
+			me.hIcon = win.HICON(hGdiobj)
+		}
+		if err := me.add(); err != nil {
+			panic(err)
+		}
+		me.added = true
+		return 0 // ignored
+	})
+
+	parent.base().beforeUserEvents.Wm(_WM_UI_TRAY, func(p Wm) uintptr {
+		if uint32(p.WParam) == me.uId {
+			me.processMessage(co.WM(p.LParam.LoWord()))
+		}
+		return 0 // ignored
+	})
+
+	if msgTaskbarCreated, err := win.RegisterWindowMessage("TaskbarCreated"); err == nil {
+		parent.base().beforeUserEvents.Wm(msgTaskbarCreated, func(_ Wm) uintptr {
+			if me.added { // Explorer restarted, all icons are gone
+				if err := me.add(); err != nil {
+					// The icon may still exist, since this message is also sent
+					// in other occasions, like DPI changes. If this fails too,
+					// the next TaskbarCreated will try again.
+					nid := me.fullData()
+					win.Shell_NotifyIcon(co.NIM_MODIFY, &nid)
+				}
+			}
+			return 0 // ignored
+		})
+	}
+
+	parent.base().beforeUserEvents.WmDestroy(func() {
+		me.Remove()
+	})
+
+	return me
+}
+
+// Returns a NOTIFYICONDATA identifying this icon, with the given flags.
+func (me *TrayIcon) data(flags co.NIF) win.NOTIFYICONDATA {
+	var nid win.NOTIFYICONDATA
+	nid.SetCbSize()
+	nid.HWnd = me.hParent
+	nid.UID = me.uId
+	nid.UFlags = flags
+	return nid
+}
+
+// Returns a NOTIFYICONDATA with the whole current state of this icon.
+func (me *TrayIcon) fullData() win.NOTIFYICONDATA {
+	nid := me.data(co.NIF_MESSAGE | co.NIF_ICON | co.NIF_TIP | co.NIF_STATE)
+	nid.UCallbackMessage = _WM_UI_TRAY
+	nid.HIcon = me.hIcon
+	nid.SetSzTip(me.tooltip)
+	nid.DwStateMask = co.NIS_HIDDEN
+	if me.hidden {
+		nid.DwState = co.NIS_HIDDEN
+	}
+	return nid
+}
+
+func (me *TrayIcon) add() error {
+	nid := me.fullData()
+	return win.Shell_NotifyIcon(co.NIM_ADD, &nid)
+}
+
+func (me *TrayIcon) modify(nid *win.NOTIFYICONDATA) {
+	if me.added {
+		win.Shell_NotifyIcon(co.NIM_MODIFY, nid)
+	}
+}
+
+func (me *TrayIcon) processMessage(msg co.WM) {
+	switch msg {
+	case co.WM_LBUTTONUP:
+		if me.events.click != nil {
+			me.events.click()
+		}
+	case co.WM_LBUTTONDBLCLK:
+		if me.events.dblClick != nil {
+			me.events.dblClick()
+		}
+	case co.WM_RBUTTONUP:
+		if me.events.rightClick != nil {
+			me.events.rightClick()
+		}
+		if me.hContextMenu != 0 {
+			me.showContextMenu()
+		}
+	case co.NIN_BALLOONUSERCLICK:
+		if me.events.balloonClick != nil {
+			me.events.balloonClick()
+		}
+	case co.NIN_BALLOONTIMEOUT:
+		if me.events.balloonTimeout != nil {
+			me.events.balloonTimeout()
+		}
+	}
+}
+
+func (me *TrayIcon) showContextMenu() {
+	hSubMenu0, _ := me.hContextMenu.GetSubMenu(0)
+	pos, _ := win.GetCursorPos()
+
+	me.hParent.SetForegroundWindow() // so the menu is closed when clicking outside
+	hSubMenu0.TrackPopupMenu(co.TPM_LEFTBUTTON|co.TPM_RIGHTBUTTON,
+		int(pos.X), int(pos.Y), me.hParent)
+	me.hParent.PostMessage(co.WM_NULL, 0, 0) // necessary according to TrackMenuPopup docs
+}
+
+// Exposes the mouse and balloon events of the icon.
+func (me *TrayIcon) On() *EventsTrayIcon {
+	return &me.events
+}
+
+// Returns the bounding rectangle of the icon, in screen coordinates, with
+// [Shell_NotifyIconGetRect]. Useful to position a popup window near the icon.
+//
+// [Shell_NotifyIconGetRect]: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shell_notifyicongetrect
+func (me *TrayIcon) Rect() (win.RECT, error) {
+	var nii win.NOTIFYICONIDENTIFIER
+	nii.SetCbSize()
+	nii.HWnd = me.hParent
+	nii.UID = me.uId
+	return win.Shell_NotifyIconGetRect(&nii)
+}
+
+// Removes the icon from the notification area. It's automatically called when
+// the parent window is destroyed.
+func (me *TrayIcon) Remove() {
+	if me.added {
+		nid := me.data(0)
+		win.Shell_NotifyIcon(co.NIM_DELETE, &nid)
+		me.added = false
+	}
+}
+
+// Sets the context menu, whose first submenu is shown when the icon is
+// right-clicked. The commands are sent to the parent window as
+// [EventsWindow.WmCommandAccelMenu]. Pass zero to remove the menu.
+//
+// The menu is not destroyed automatically.
+func (me *TrayIcon) SetContextMenu(hMenu win.HMENU) {
+	me.hContextMenu = hMenu
+}
+
+// Replaces the icon.
+//
+// The icon is not destroyed automatically.
+func (me *TrayIcon) SetIcon(hIcon win.HICON) {
+	me.hIcon = hIcon
+	nid := me.data(co.NIF_ICON)
+	nid.HIcon = hIcon
+	me.modify(&nid)
+}
+
+// Sets the tooltip text shown when the mouse hovers the icon. Limited to 127
+// characters.
+func (me *TrayIcon) SetTooltip(text string) {
+	me.tooltip = text
+	nid := me.data(co.NIF_TIP)
+	nid.SetSzTip(text)
+	me.modify(&nid)
+}
+
+// Shows or hides the icon, without removing it.
+func (me *TrayIcon) SetVisible(visible bool) {
+	me.hidden = !visible
+	nid := me.data(co.NIF_STATE)
+	nid.DwStateMask = co.NIS_HIDDEN
+	if me.hidden {
+		nid.DwState = co.NIS_HIDDEN
+	}
+	me.modify(&nid)
+}
+
+// Shows a balloon notification – a toast notification on Windows 10 and later
+// – anchored at the icon. The text is limited to 255 characters, and the title
+// to 63.
+//
+// Clicks on the notification are handled by [EventsTrayIcon.BalloonClick].
+//
+// # Example
+//
+//	var tray *ui.TrayIcon // initialized somewhere
+//
+//	tray.ShowBalloon("Download", "The file was saved.", co.NIIF_INFO)
+func (me *TrayIcon) ShowBalloon(title, text string, icon co.NIIF) {
+	nid := me.data(co.NIF_INFO)
+	nid.SetSzInfoTitle(title)
+	nid.SetSzInfo(text)
+	nid.DwInfoFlags = icon
+	me.modify(&nid)
+}
+
+// Options for [NewTrayIcon]; returned by [OptsTrayIcon].
+type VarOptsTrayIcon struct {
+	iconId      uint16
+	hIcon       win.HICON
+	tooltip     string
+	hidden      bool
+	contextMenu win.HMENU
+}
+
+// Options for [NewTrayIcon].
+func OptsTrayIcon() *VarOptsTrayIcon {
+	return &VarOptsTrayIcon{}
+}
+
+// Icon resource ID, loaded with [LoadImage] at the small icon size of the
+// system, given by SM_CXSMICON and SM_CYSMICON. Overrides
+// [VarOptsTrayIcon.Icon].
+//
+// Defaults to none.
+//
+// [LoadImage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-loadimagew
+func (o *VarOptsTrayIcon) IconId(id uint16) *VarOptsTrayIcon { o.iconId = id; return o }
+
+// Icon handle. It's not destroyed automatically.
+//
+// Defaults to none.
+func (o *VarOptsTrayIcon) Icon(h win.HICON) *VarOptsTrayIcon { o.hIcon = h; return o }
+
+// Tooltip text shown when the mouse hovers the icon. Limited to 127
+// characters.
+//
+// Defaults to none.
+func (o *VarOptsTrayIcon) Tooltip(t string) *VarOptsTrayIcon { o.tooltip = t; return o }
+
+// Adds the icon initially hidden, to be shown with [TrayIcon.SetVisible].
+//
+// Defaults to false.
+func (o *VarOptsTrayIcon) Hidden(h bool) *VarOptsTrayIcon { o.hidden = h; return o }
+
+// Context menu, whose first submenu is shown when the icon is right-clicked.
+// The commands are sent to the parent window as [EventsWindow.WmCommandAccelMenu].
+// The menu is not destroyed automatically.
+//
+// Defaults to none.
+func (o *VarOptsTrayIcon) ContextMenu(h win.HMENU) *VarOptsTrayIcon { o.contextMenu = h; return o }
+
+// Exposes the events of a [TrayIcon].
+//
+// You cannot create this object directly, it will be created automatically
+// by the owning tray icon.
+type EventsTrayIcon struct {
+	click          func()
+	dblClick       func()
+	rightClick     func()
+	balloonClick   func()
+	balloonTimeout func()
+}
+
+// Called when the balloon notification is clicked.
+func (me *EventsTrayIcon) BalloonClick(fun func()) {
+	me.balloonClick = fun
+}
+
+// Called when the balloon notification is dismissed because of a timeout, or
+// because the user clicked its close button.
+func (me *EventsTrayIcon) BalloonTimeout(fun func()) {
+	me.balloonTimeout = fun
+}
+
+// Called when the icon is clicked with the left mouse button. A double click
+// also fires this event, before [EventsTrayIcon.DblClick].
+func (me *EventsTrayIcon) Click(fun func()) {
+	me.click = fun
+}
+
+// Called when the icon is double-clicked with the left mouse button.
+func (me *EventsTrayIcon) DblClick(fun func()) {
+	me.dblClick = fun
+}
+
+// Called when the icon is clicked with the right mouse button, right before
+// the context menu, if any, is shown. This is the place to update the state
+// of the menu items.
+func (me *EventsTrayIcon) RightClick(fun func()) {
+	me.rightClick = fun
+}
@@ -26,6 +26,7 @@ func NewTreeView(parent Parent, opts *VarOptsTreeView) *TreeView {
 	me := &TreeView{
 		_BaseCtrl: newBaseCtrl(opts.ctrlId),
 		events:    EventsTreeView{opts.ctrlId, &parent.base().userEvents},
+		itemsData: make(map[win.HTREEITEM]interface{}),
 	}
 	me.Items.owner = me
 
@@ -49,6 +50,7 @@ func NewTreeViewDlg(parent Parent, ctrlId uint16, layout LAY) *TreeView {
 	me := &TreeView{
 		_BaseCtrl: newBaseCtrl(ctrlId),
 		events:    EventsTreeView{ctrlId, &parent.base().userEvents},
+		itemsData: make(map[win.HTREEITEM]interface{}),
 	}
 	me.Items.owner = me
 
@@ -75,6 +77,7 @@ func (me *TreeView) defaultMessageHandlers(parent Parent) {
 			h, _ := me.hWnd.SendMessage(co.TVM_GETIMAGELIST, win.WPARAM(kind), 0)
 			if h != 0 {
 				me.hWnd.SendMessage(co.TVM_SETIMAGELIST, win.WPARAM(kind), 0)
+				forgetOwnedImageList(win.HIMAGELIST(h))
 				win.HIMAGELIST(h).Destroy()
 			}
 		}
@@ -82,10 +85,7 @@ func (me *TreeView) defaultMessageHandlers(parent Parent) {
 }
 
 // Exposes all the control notifications the can be handled.
-//
-// Panics if called after the control has been created.
 func (me *TreeView) On() *EventsTreeView {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
@@ -101,7 +101,7 @@ func (me *TreeView) ImageList(which co.TVSIL) win.HIMAGELIST {
 	h, _ := me.hWnd.SendMessage(co.TVM_GETIMAGELIST, win.WPARAM(which), 0)
 	hImg := win.HIMAGELIST(h)
 	if hImg == win.HIMAGELIST(0) {
-		hImg, _ = win.ImageListCreate(16, 16, co.ILC_COLOR32, 1, 1)
+		hImg = createOwnedImageList(16, 16)
 		me.hWnd.SendMessage(co.TVM_SETIMAGELIST, win.WPARAM(which), win.LPARAM(hImg))
 	}
 	return hImg
@@ -212,8 +212,8 @@ type EventsTreeView struct {
 // [TVN_ASYNCDRAW] message handler.
 //
 // [TVN_ASYNCDRAW]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-asyncdraw
-func (me *EventsTreeView) TvnAsyncDraw(fun func(p *win.NMTVASYNCDRAW)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_ASYNCDRAW, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnAsyncDraw(fun func(p *win.NMTVASYNCDRAW)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_ASYNCDRAW, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTVASYNCDRAW)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -222,8 +222,8 @@ func (me *EventsTreeView) TvnAsyncDraw(fun func(p *win.NMTVASYNCDRAW)) {
 // [TVN_BEGINDRAG] message handler.
 //
 // [TVN_BEGINDRAG]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-begindrag
-func (me *EventsTreeView) TvnBeginDrag(fun func(p *win.NMTREEVIEW)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_BEGINDRAG, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnBeginDrag(fun func(p *win.NMTREEVIEW)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_BEGINDRAG, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTREEVIEW)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -232,8 +232,8 @@ func (me *EventsTreeView) TvnBeginDrag(fun func(p *win.NMTREEVIEW)) {
 // [TVN_BEGINLABELEDIT] message handler.
 //
 // [TVN_BEGINLABELEDIT]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-beginlabeledit
-func (me *EventsTreeView) TvnBeginLabelEdit(fun func(p *win.NMTVDISPINFO) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_BEGINLABELEDIT, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnBeginLabelEdit(fun func(p *win.NMTVDISPINFO) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_BEGINLABELEDIT, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMTVDISPINFO)(p)))
 	})
 }
@@ -241,8 +241,8 @@ func (me *EventsTreeView) TvnBeginLabelEdit(fun func(p *win.NMTVDISPINFO) bool)
 // [TVN_BEGINRDRAG] message handler.
 //
 // [TVN_BEGINRDRAG]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-beginrdrag
-func (me *EventsTreeView) TvnBeginRDrag(fun func(p *win.NMTREEVIEW)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_BEGINRDRAG, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnBeginRDrag(fun func(p *win.NMTREEVIEW)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_BEGINRDRAG, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTREEVIEW)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -251,8 +251,8 @@ func (me *EventsTreeView) TvnBeginRDrag(fun func(p *win.NMTREEVIEW)) {
 // [TVN_DELETEITEM] message handler.
 //
 // [TVN_DELETEITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-deleteitem
-func (me *EventsTreeView) TvnDeleteItem(fun func(p *win.NMTREEVIEW)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_DELETEITEM, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnDeleteItem(fun func(p *win.NMTREEVIEW)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_DELETEITEM, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTREEVIEW)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -261,8 +261,8 @@ func (me *EventsTreeView) TvnDeleteItem(fun func(p *win.NMTREEVIEW)) {
 // [TVN_ENDLABELEDIT] message handler.
 //
 // [TVN_ENDLABELEDIT]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-endlabeledit
-func (me *EventsTreeView) TvnEndLabelEdit(fun func(p *win.NMTVDISPINFO) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_ENDLABELEDIT, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnEndLabelEdit(fun func(p *win.NMTVDISPINFO) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_ENDLABELEDIT, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMTVDISPINFO)(p)))
 	})
 }
@@ -270,8 +270,8 @@ func (me *EventsTreeView) TvnEndLabelEdit(fun func(p *win.NMTVDISPINFO) bool) {
 // [TVN_GETDISPINFO] message handler.
 //
 // [TVN_GETDISPINFO]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-getdispinfo
-func (me *EventsTreeView) TvnGetDispInfo(fun func(p *win.NMTVDISPINFO)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_GETDISPINFO, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnGetDispInfo(fun func(p *win.NMTVDISPINFO)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_GETDISPINFO, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTVDISPINFO)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -280,8 +280,8 @@ func (me *EventsTreeView) TvnGetDispInfo(fun func(p *win.NMTVDISPINFO)) {
 // [TVN_GETINFOTIP] message handler.
 //
 // [TVN_GETINFOTIP]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-getinfotip
-func (me *EventsTreeView) TvnGetInfoTip(fun func(p *win.NMTVGETINFOTIP)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_GETINFOTIP, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnGetInfoTip(fun func(p *win.NMTVGETINFOTIP)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_GETINFOTIP, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTVGETINFOTIP)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -290,8 +290,8 @@ func (me *EventsTreeView) TvnGetInfoTip(fun func(p *win.NMTVGETINFOTIP)) {
 // [TVN_ITEMCHANGED] message handler.
 //
 // [TVN_ITEMCHANGED]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-itemchanged
-func (me *EventsTreeView) TvnItemChanged(fun func(p *win.NMTVITEMCHANGE)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_ITEMCHANGED, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnItemChanged(fun func(p *win.NMTVITEMCHANGE)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_ITEMCHANGED, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTVITEMCHANGE)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -300,8 +300,8 @@ func (me *EventsTreeView) TvnItemChanged(fun func(p *win.NMTVITEMCHANGE)) {
 // [TVN_ITEMCHANGING] message handler.
 //
 // [TVN_ITEMCHANGING]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-itemchanging
-func (me *EventsTreeView) TvnItemChanging(fun func(p *win.NMTVITEMCHANGE) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_ITEMCHANGING, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnItemChanging(fun func(p *win.NMTVITEMCHANGE) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_ITEMCHANGING, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMTVITEMCHANGE)(p)))
 	})
 }
@@ -309,8 +309,8 @@ func (me *EventsTreeView) TvnItemChanging(fun func(p *win.NMTVITEMCHANGE) bool)
 // [TVN_ITEMEXPANDED] message handler.
 //
 // [TVN_ITEMEXPANDED]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-itemexpanded
-func (me *EventsTreeView) TvnItemExpanded(fun func(p *win.NMTREEVIEW)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_ITEMEXPANDED, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnItemExpanded(fun func(p *win.NMTREEVIEW)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_ITEMEXPANDED, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTREEVIEW)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -319,8 +319,8 @@ func (me *EventsTreeView) TvnItemExpanded(fun func(p *win.NMTREEVIEW)) {
 // [TVN_ITEMEXPANDING] message handler.
 //
 // [TVN_ITEMEXPANDING]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-itemexpanding
-func (me *EventsTreeView) TvnItemExpanding(fun func(p *win.NMTREEVIEW) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_ITEMEXPANDING, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnItemExpanding(fun func(p *win.NMTREEVIEW) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_ITEMEXPANDING, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMTREEVIEW)(p)))
 	})
 }
@@ -328,8 +328,8 @@ func (me *EventsTreeView) TvnItemExpanding(fun func(p *win.NMTREEVIEW) bool) {
 // [TVN_KEYDOWN] message handler.
 //
 // [TVN_KEYDOWN]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-keydown
-func (me *EventsTreeView) TvnKeyDown(fun func(p *win.NMTVKEYDOWN) int) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_KEYDOWN, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnKeyDown(fun func(p *win.NMTVKEYDOWN) int) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_KEYDOWN, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMTVKEYDOWN)(p)))
 	})
 }
@@ -337,8 +337,8 @@ func (me *EventsTreeView) TvnKeyDown(fun func(p *win.NMTVKEYDOWN) int) {
 // [TVN_SELCHANGED] message handler.
 //
 // [TVN_SELCHANGED]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-selchanged
-func (me *EventsTreeView) TvnSelChanged(fun func(p *win.NMTREEVIEW)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_SELCHANGED, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnSelChanged(fun func(p *win.NMTREEVIEW)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_SELCHANGED, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTREEVIEW)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -347,8 +347,8 @@ func (me *EventsTreeView) TvnSelChanged(fun func(p *win.NMTREEVIEW)) {
 // [TVN_SELCHANGING] message handler.
 //
 // [TVN_SELCHANGING]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-selchanging
-func (me *EventsTreeView) TvnSelChanging(fun func(p *win.NMTREEVIEW) bool) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_SELCHANGING, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnSelChanging(fun func(p *win.NMTREEVIEW) bool) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_SELCHANGING, func(p unsafe.Pointer) uintptr {
 		return utl.BoolToUintptr(fun((*win.NMTREEVIEW)(p)))
 	})
 }
@@ -356,8 +356,8 @@ func (me *EventsTreeView) TvnSelChanging(fun func(p *win.NMTREEVIEW) bool) {
 // [TVN_SETDISPINFO] message handler.
 //
 // [TVN_SETDISPINFO]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-setdispinfo
-func (me *EventsTreeView) TvnSetDispInfo(fun func(p *win.NMTVDISPINFO)) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_SETDISPINFO, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnSetDispInfo(fun func(p *win.NMTVDISPINFO)) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_SETDISPINFO, func(p unsafe.Pointer) uintptr {
 		fun((*win.NMTVDISPINFO)(p))
 		return me.parentEvents.defProcVal
 	})
@@ -366,8 +366,8 @@ func (me *EventsTreeView) TvnSetDispInfo(fun func(p *win.NMTVDISPINFO)) {
 // [TVN_SINGLEEXPAND] message handler.
 //
 // [TVN_SINGLEEXPAND]: https://learn.microsoft.com/en-us/windows/win32/controls/tvn-singleexpand
-func (me *EventsTreeView) TvnSingleExpand(fun func(p *win.NMTREEVIEW) co.TVNRET) {
-	me.parentEvents.WmNotify(me.ctrlId, co.TVN_SINGLEEXPAND, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) TvnSingleExpand(fun func(p *win.NMTREEVIEW) co.TVNRET) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.TVN_SINGLEEXPAND, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMTREEVIEW)(p)))
 	})
 }
@@ -375,8 +375,8 @@ func (me *EventsTreeView) TvnSingleExpand(fun func(p *win.NMTREEVIEW) co.TVNRET)
 // [NM_CLICK] message handler.
 //
 // [NM_CLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-click-tree-view
-func (me *EventsTreeView) NmClick(fun func() int) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_CLICK, func(_ unsafe.Pointer) uintptr {
+func (me *EventsTreeView) NmClick(fun func() int) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_CLICK, func(_ unsafe.Pointer) uintptr {
 		return uintptr(fun())
 	})
 }
@@ -384,8 +384,8 @@ func (me *EventsTreeView) NmClick(fun func() int) {
 // [NM_CUSTOMDRAW] message handler.
 //
 // [NM_CUSTOMDRAW]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-customdraw-tree-view
-func (me *EventsTreeView) NmCustomDraw(fun func(p *win.NMTVCUSTOMDRAW) co.CDRF) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_CUSTOMDRAW, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) NmCustomDraw(fun func(p *win.NMTVCUSTOMDRAW) co.CDRF) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_CUSTOMDRAW, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMTVCUSTOMDRAW)(p)))
 	})
 }
@@ -393,8 +393,8 @@ func (me *EventsTreeView) NmCustomDraw(fun func(p *win.NMTVCUSTOMDRAW) co.CDRF)
 // [NM_DBLCLK] message handler.
 //
 // [NM_DBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-dblclk-tree-view
-func (me *EventsTreeView) NmDblClk(fun func() int) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_DBLCLK, func(_ unsafe.Pointer) uintptr {
+func (me *EventsTreeView) NmDblClk(fun func() int) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_DBLCLK, func(_ unsafe.Pointer) uintptr {
 		return uintptr(fun())
 	})
 }
@@ -402,8 +402,8 @@ func (me *EventsTreeView) NmDblClk(fun func() int) {
 // [NM_KILLFOCUS] message handler.
 //
 // [NM_KILLFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-killfocus-tree-view
-func (me *EventsTreeView) NmKillFocus(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_KILLFOCUS, func(_ unsafe.Pointer) uintptr {
+func (me *EventsTreeView) NmKillFocus(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_KILLFOCUS, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -412,8 +412,8 @@ func (me *EventsTreeView) NmKillFocus(fun func()) {
 // [NM_RCLICK] message handler.
 //
 // [NM_RCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-rclick-tree-view
-func (me *EventsTreeView) NmRClick(fun func() int) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RCLICK, func(_ unsafe.Pointer) uintptr {
+func (me *EventsTreeView) NmRClick(fun func() int) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RCLICK, func(_ unsafe.Pointer) uintptr {
 		return uintptr(fun())
 	})
 }
@@ -421,8 +421,8 @@ func (me *EventsTreeView) NmRClick(fun func() int) {
 // [NM_RDBLCLK] message handler.
 //
 // [NM_RDBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-rdblclk-tree-view
-func (me *EventsTreeView) NmRDblClk(fun func() int) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RDBLCLK, func(_ unsafe.Pointer) uintptr {
+func (me *EventsTreeView) NmRDblClk(fun func() int) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RDBLCLK, func(_ unsafe.Pointer) uintptr {
 		return uintptr(fun())
 	})
 }
@@ -430,8 +430,8 @@ func (me *EventsTreeView) NmRDblClk(fun func() int) {
 // [NM_RETURN] message handler.
 //
 // [NM_RETURN]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-return-tree-view-
-func (me *EventsTreeView) NmReturn(fun func() int) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RETURN, func(_ unsafe.Pointer) uintptr {
+func (me *EventsTreeView) NmReturn(fun func() int) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RETURN, func(_ unsafe.Pointer) uintptr {
 		return uintptr(fun())
 	})
 }
@@ -439,8 +439,8 @@ func (me *EventsTreeView) NmReturn(fun func() int) {
 // [NM_SETCURSOR] message handler.
 //
 // [NM_SETCURSOR]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-setcursor-tree-view-
-func (me *EventsTreeView) NmSetCursor(fun func(p *win.NMMOUSE) int) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_SETCURSOR, func(p unsafe.Pointer) uintptr {
+func (me *EventsTreeView) NmSetCursor(fun func(p *win.NMMOUSE) int) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_SETCURSOR, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMMOUSE)(p)))
 	})
 }
@@ -448,8 +448,8 @@ func (me *EventsTreeView) NmSetCursor(fun func(p *win.NMMOUSE) int) {
 // [NM_SETFOCUS] message handler.
 //
 // [NM_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-setfocus-tree-view-
-func (me *EventsTreeView) NmSetFocus(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_SETFOCUS, func(_ unsafe.Pointer) uintptr {
+func (me *EventsTreeView) NmSetFocus(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_SETFOCUS, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
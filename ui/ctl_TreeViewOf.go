@@ -0,0 +1,64 @@
+//go:build windows
+
+package ui
+
+// A [TreeView] whose items carry user-custom data of type T, so the data
+// doesn't need to be type-asserted.
+//
+// The data is released automatically when the items are deleted.
+//
+// # Example
+//
+//	type Folder struct {
+//		Path string
+//	}
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	tv := ui.NewTreeViewOf[*Folder](wndOwner, ui.OptsTreeView())
+//
+//	wndOwner.On().WmCreate(func(_ ui.WmCreate) int {
+//		tv.AddRootWithData(&Folder{"C:\\"}, "C:", -1)
+//		return 0
+//	})
+type TreeViewOf[T any] struct {
+	*TreeView
+}
+
+// Creates a new [TreeViewOf] with [win.CreateWindowEx].
+func NewTreeViewOf[T any](parent Parent, opts *VarOptsTreeView) *TreeViewOf[T] {
+	return &TreeViewOf[T]{NewTreeView(parent, opts)}
+}
+
+// Instantiates a new [TreeViewOf] to be loaded from a dialog resource with
+// [win.HWND.GetDlgItem].
+func NewTreeViewOfDlg[T any](parent Parent, ctrlId uint16, layout LAY) *TreeViewOf[T] {
+	return &TreeViewOf[T]{NewTreeViewDlg(parent, ctrlId, layout)}
+}
+
+// Adds a new child item to the given item, and stores the data for it.
+func (me *TreeViewOf[T]) AddChildWithData(
+	parentItem TreeViewItem, data T, text string, iconIndex int) TreeViewItem {
+
+	item := parentItem.AddChild(text, iconIndex)
+	item.SetData(data)
+	return item
+}
+
+// Adds a new root item, and stores the data for it.
+func (me *TreeViewOf[T]) AddRootWithData(data T, text string, iconIndex int) TreeViewItem {
+	item := me.Items.AddRoot(text, iconIndex)
+	item.SetData(data)
+	return item
+}
+
+// Returns the data stored for the item, if any.
+func (me *TreeViewOf[T]) Data(item TreeViewItem) (T, bool) {
+	data, ok := item.Data().(T)
+	return data, ok
+}
+
+// Stores the data for the item, replacing any previous one.
+func (me *TreeViewOf[T]) SetData(item TreeViewItem, data T) {
+	item.SetData(data)
+}
@@ -86,10 +86,7 @@ func NewUpDownDlg(parent Parent, ctrlId uint16, layout LAY) *UpDown {
 }
 
 // Exposes all the control notifications the can be handled.
-//
-// Panics if called after the control has been created.
 func (me *UpDown) On() *EventsUpDown {
-	me.panicIfAddingEventAfterCreated()
 	return &me.events
 }
 
@@ -260,8 +257,8 @@ type EventsUpDown struct {
 // [NM_RELEASEDCAPTURE] message handler.
 //
 // [NM_RELEASEDCAPTURE]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-releasedcapture-up-down-
-func (me *EventsUpDown) NmReleasedCapture(fun func()) {
-	me.parentEvents.WmNotify(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) uintptr {
+func (me *EventsUpDown) NmReleasedCapture(fun func()) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) uintptr {
 		fun()
 		return me.parentEvents.defProcVal
 	})
@@ -270,8 +267,8 @@ func (me *EventsUpDown) NmReleasedCapture(fun func()) {
 // [UDN_DELTAPOS] message handler.
 //
 // [UDN_DELTAPOS]: https://learn.microsoft.com/en-us/windows/win32/controls/udn-deltapos
-func (me *EventsUpDown) UdnDeltaPos(fun func(p *win.NMUPDOWN) int) {
-	me.parentEvents.WmNotify(me.ctrlId, co.UDN_DELTAPOS, func(p unsafe.Pointer) uintptr {
+func (me *EventsUpDown) UdnDeltaPos(fun func(p *win.NMUPDOWN) int) EventToken {
+	return me.parentEvents.WmNotify(me.ctrlId, co.UDN_DELTAPOS, func(p unsafe.Pointer) uintptr {
 		return uintptr(fun((*win.NMUPDOWN)(p)))
 	})
 }
@@ -35,6 +35,107 @@ func createGlobalUiFont() error {
 	return nil
 }
 
+// UI fonts scaled to DPIs other than the system one, lazily created when a
+// window is moved to a monitor with a different DPI.
+var globalUiFontsDpi = make(map[int]win.HFONT)
+
+// Returns the global UI font scaled to the given DPI. The system DPI returns
+// the global UI font itself.
+func uiFontForDpi(dpi int) win.HFONT {
+	cacheSystemDpi()
+	if dpi == dpiY || globalUiFont == 0 {
+		return globalUiFont
+	}
+	if hFont, ok := globalUiFontsDpi[dpi]; ok {
+		return hFont
+	}
+
+	lf, err := globalUiFont.GetObject()
+	if err != nil {
+		return globalUiFont
+	}
+	lf.LfHeight = int32(DpiScale(int(lf.LfHeight), dpiY, dpi))
+	lf.LfWidth = int32(DpiScale(int(lf.LfWidth), dpiY, dpi))
+
+	hFont, err := win.CreateFontIndirect(&lf)
+	if err != nil {
+		return globalUiFont
+	}
+	globalUiFontsDpi[dpi] = hFont
+	return hFont
+}
+
+// Tells whether the font is the global UI font, at any DPI.
+func isUiFont(hFont win.HFONT) bool {
+	if hFont == globalUiFont {
+		return true
+	}
+	for _, hDpiFont := range globalUiFontsDpi {
+		if hFont == hDpiFont {
+			return true
+		}
+	}
+	return false
+}
+
+func deleteUiFontsDpi() {
+	for dpi, hFont := range globalUiFontsDpi {
+		hFont.DeleteObject()
+		delete(globalUiFontsDpi, dpi)
+	}
+}
+
+// Image lists created by the library, which are replaced by scaled copies when
+// the DPI changes. Image lists created by the user are never touched.
+var globalOwnedImageLists = make(map[win.HIMAGELIST]struct{})
+
+// Creates an image list which is owned by the library.
+func createOwnedImageList(cx, cy int) win.HIMAGELIST {
+	hImg, _ := win.ImageListCreate(uint(cx), uint(cy), co.ILC_COLOR32, 1, 1)
+	if hImg != win.HIMAGELIST(0) {
+		globalOwnedImageLists[hImg] = struct{}{}
+	}
+	return hImg
+}
+
+// Removes the image list from the owned ones, without destroying it; called
+// when the control which holds it destroys it.
+func forgetOwnedImageList(hImg win.HIMAGELIST) {
+	delete(globalOwnedImageLists, hImg)
+}
+
+// Returns the current DPI of the window. Prior to Windows 10 version 1607,
+// returns the system DPI.
+func windowDpi(hWnd win.HWND) int {
+	if hWnd != 0 && isWindows10BuildOrGreater(14393) {
+		if dpi := hWnd.GetDpiForWindow(); dpi != 0 {
+			return int(dpi)
+		}
+	}
+	cacheSystemDpi()
+	return dpiY
+}
+
+// Tells whether the system is Windows 10 with at least the given build number.
+func isWindows10BuildOrGreater(build uint32) bool {
+	ovi := win.OSVERSIONINFOEX{
+		DwMajorVersion: 10,
+		DwBuildNumber:  build,
+	}
+	ovi.SetDwOsVersionInfoSize()
+
+	conditionMask := win.VerSetConditionMask(
+		win.VerSetConditionMask(
+			win.VerSetConditionMask(0, co.VER_MAJORVERSION, co.VER_COND_GREATER_EQUAL),
+			co.VER_MINORVERSION, co.VER_COND_GREATER_EQUAL),
+		co.VER_BUILDNUMBER, co.VER_COND_GREATER_EQUAL)
+
+	ret, _ := win.VerifyVersionInfo(&ovi,
+		co.VER_MAJORVERSION|co.VER_MINORVERSION|co.VER_BUILDNUMBER,
+		conditionMask)
+	return ret
+}
+
 var globalNextCtrlId uint16 = 0xdfff // https://stackoverflow.com/a/18192766/6923555
 
 // Returns an unique child control ID.
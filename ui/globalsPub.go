@@ -138,39 +138,22 @@ func msgBuild(
 	okText string,
 	hasCancel bool,
 ) co.ID {
-	var hParent win.HWND
-	if wnd != nil {
-		hParent = wnd.Hwnd()
-	}
+	opts := OptsTaskDialog().
+		Title(title).
+		Caption(caption).
+		Body(body).
+		Icon(icon)
 
-	var commonButtons co.TDCBF
-	var buttons []win.TASKDIALOG_BUTTON
 	if hasCancel {
 		finalOkText := okText
 		if finalOkText == "" {
 			finalOkText = "&OK"
 		}
-		buttons = []win.TASKDIALOG_BUTTON{
-			{Id: co.ID_OK, Text: finalOkText},
-			{Id: co.ID_CANCEL, Text: "&Cancel"},
-		}
+		opts.Button(co.ID_OK, finalOkText).
+			Button(co.ID_CANCEL, "&Cancel")
 	} else {
-		commonButtons = co.TDCBF_OK
+		opts.CommonButtons(co.TDCBF_OK)
 	}
 
-	ret, err := win.TaskDialogIndirect(win.TASKDIALOGCONFIG{
-		HwndParent:      hParent,
-		WindowTitle:     title,
-		MainInstruction: caption,
-		Content:         body,
-		HMainIcon:       win.TdcIconTdi(icon),
-		CommonButtons:   commonButtons,
-		Flags:           co.TDF_ALLOW_DIALOG_CANCELLATION | co.TDF_POSITION_RELATIVE_TO_WINDOW,
-		Buttons:         buttons,
-	})
-
-	if err != nil {
-		panic(err)
-	}
-	return ret
+	return NewTaskDialog(wnd, opts).ShowModal().Button
 }
@@ -37,7 +37,8 @@ type Parent interface {
 
 	// Exposes all the window notifications the can be handled.
 	//
-	// Cannot be called after the window was created.
+	// Can also be called after the window was created, so handlers can be
+	// added and removed at runtime.
 	On() *EventsWindow
 
 	// This method is analog to [SendMessage] (synchronous), but intended to be
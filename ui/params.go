@@ -222,6 +222,16 @@ type WmDisplayChange struct{ Raw Wm }
 func (p WmDisplayChange) BitsPerPixel() int { return int(p.Raw.WParam) }
 func (p WmDisplayChange) Size() win.SIZE    { return p.Raw.LParam.MakeSize() }
 
+// [WM_DPICHANGED] parameters.
+//
+// [WM_DPICHANGED]: https://learn.microsoft.com/en-us/windows/win32/hidpi/wm-dpichanged
+type WmDpiChanged struct{ Raw Wm }
+
+func (p WmDpiChanged) Dpi() int { return int(p.Raw.WParam.HiWord()) }
+func (p WmDpiChanged) SuggestedRect() *win.RECT {
+	return (*win.RECT)(unsafe.Pointer(p.Raw.LParam))
+}
+
 // [WM_DRAWITEM] parameters.
 //
 // [WM_DRAWITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-drawitem
@@ -425,6 +435,17 @@ type WmKillFocus struct{ Raw Wm }
 
 func (p WmKillFocus) HwndReceivingFocus() win.HWND { return win.HWND(p.Raw.LParam) }
 
+// [WM_MEASUREITEM] parameters.
+//
+// [WM_MEASUREITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-measureitem
+type WmMeasureItem struct{ Raw Wm }
+
+func (p WmMeasureItem) ControlId() int   { return int(p.Raw.WParam) }
+func (p WmMeasureItem) IsFromMenu() bool { return p.Raw.WParam == 0 }
+func (p WmMeasureItem) MeasureItemStruct() *win.MEASUREITEMSTRUCT {
+	return (*win.MEASUREITEMSTRUCT)(unsafe.Pointer(p.Raw.LParam))
+}
+
 // Parameters for:
 //   - [WM_MENUCOMMAND]
 //   - [WM_MENUDRAG]
@@ -17,6 +17,7 @@ type _BaseContainer struct {
 	hWnd   win.HWND
 	wndTy  _WNDTY
 	layout _Layout
+	dpi    int // Current DPI of the window; system DPI until created.
 
 	beforeUserEvents EventsWindow
 	userEvents       EventsWindow
@@ -25,10 +26,12 @@ type _BaseContainer struct {
 
 // Constructor.
 func newBaseContainer(wndTy _WNDTY) _BaseContainer {
+	cacheSystemDpi()
 	return _BaseContainer{
 		hWnd:   win.HWND(0),
 		wndTy:  wndTy,
 		layout: newLayout(),
+		dpi:    dpiY,
 
 		beforeUserEvents: newEventsWindow(_WNDTY_DLG),
 		userEvents:       newEventsWindow(_WNDTY_DLG),
@@ -71,9 +74,136 @@ func (me *_BaseContainer) defaultMessageHandlers() {
 	me.beforeUserEvents.WmSize(func(p WmSize) {
 		me.layout.Rearrange(p)
 	})
+
+	me.dpiMessageHandlers()
+}
+
+// Keeps the children scaled to the DPI of the monitor where the window is.
+// Top-level windows receive WM_DPICHANGED, while child windows receive
+// WM_DPICHANGED_AFTERPARENT, after their parent has been rescaled.
+func (me *_BaseContainer) dpiMessageHandlers() {
+	me.afterUserEvents.Wm(me.wndTy.initMsg(), func(_ Wm) uintptr {
+		// Children were created with the system DPI, but the window may
+		// have been created in a monitor with a different one.
+		if newDpi := windowDpi(me.hWnd); newDpi != me.dpi {
+			me.rescaleDpi(newDpi)
+		}
+		return 0 // ignored
+	})
+
+	me.beforeUserEvents.Wm(co.WM_DPICHANGED, func(p Wm) uintptr {
+		me.rescaleDpi(int(p.WParam.HiWord()))
+		if me.wndTy == _WNDTY_RAW { // dialogs are moved by the system
+			rc := (*win.RECT)(unsafe.Pointer(p.LParam)) // suggested by the system
+			me.hWnd.SetWindowPos(win.HWND(0), int(rc.Left), int(rc.Top),
+				uint(rc.Right-rc.Left), uint(rc.Bottom-rc.Top),
+				co.SWP_NOZORDER|co.SWP_NOACTIVATE)
+		}
+		return 0 // ignored
+	})
+
+	me.beforeUserEvents.Wm(co.WM_DPICHANGED_AFTERPARENT, func(_ Wm) uintptr {
+		if newDpi := windowDpi(me.hWnd); newDpi != me.dpi {
+			me.rescaleDpi(newDpi)
+		}
+		return 0 // ignored
+	})
+}
+
+// Rescales the direct children of the window, their fonts and image lists,
+// and the layout, from the current DPI to the new one.
+func (me *_BaseContainer) rescaleDpi(newDpi int) {
+	oldDpi := me.dpi
+	me.dpi = newDpi
+	if oldDpi == newDpi {
+		return
+	}
+
+	me.layout.rescale(oldDpi, newDpi)
+
+	for _, hChild := range me.hWnd.EnumChildWindows() {
+		if hParent, _ := hChild.GetAncestor(co.GA_PARENT); hParent != me.hWnd {
+			continue // grandchildren are rescaled by their own parents
+		}
+
+		// Under per-monitor v2, the system already rescales the controls of
+		// dialog boxes, along with the dialog font.
+		if me.wndTy == _WNDTY_RAW {
+			rc, _ := hChild.GetWindowRect() // relative to screen
+			me.hWnd.ScreenToClientRc(&rc)   // now relative to parent
+			rc = dpiScaleRect(rc, oldDpi, newDpi)
+			hChild.SetWindowPos(win.HWND(0), int(rc.Left), int(rc.Top),
+				uint(rc.Right-rc.Left), uint(rc.Bottom-rc.Top),
+				co.SWP_NOZORDER|co.SWP_NOACTIVATE)
+
+			hFont, _ := hChild.SendMessage(co.WM_GETFONT, 0, 0)
+			if hFont != 0 && isUiFont(win.HFONT(hFont)) {
+				hChild.SendMessage(co.WM_SETFONT, win.WPARAM(uiFontForDpi(newDpi)), win.LPARAM(1))
+			}
+		}
+
+		rescaleChildImageLists(hChild, oldDpi, newDpi)
+	}
+}
+
+// Replaces the image lists of list views and tree views with scaled copies.
+// Only the image lists created by the library are replaced; the ones created
+// by the user, or shared among list views, are left alone.
+func rescaleChildImageLists(hChild win.HWND, oldDpi, newDpi int) {
+	className, _ := hChild.GetClassName()
+	switch className {
+	case "SysListView32":
+		if style, _ := hChild.Style(); (co.LVS(style) & co.LVS_SHAREIMAGELISTS) != 0 {
+			return // image lists are not owned by the control
+		}
+		for _, which := range []co.LVSIL{co.LVSIL_NORMAL, co.LVSIL_SMALL} {
+			h, _ := hChild.SendMessage(co.LVM_GETIMAGELIST, win.WPARAM(which), 0)
+			if hNew, ok := scaledImageList(win.HIMAGELIST(h), oldDpi, newDpi); ok {
+				hChild.SendMessage(co.LVM_SETIMAGELIST, win.WPARAM(which), win.LPARAM(hNew))
+				forgetOwnedImageList(win.HIMAGELIST(h))
+				win.HIMAGELIST(h).Destroy()
+			}
+		}
+	case "SysTreeView32":
+		h, _ := hChild.SendMessage(co.TVM_GETIMAGELIST, win.WPARAM(co.TVSIL_NORMAL), 0)
+		if hNew, ok := scaledImageList(win.HIMAGELIST(h), oldDpi, newDpi); ok {
+			hChild.SendMessage(co.TVM_SETIMAGELIST, win.WPARAM(co.TVSIL_NORMAL), win.LPARAM(hNew))
+			forgetOwnedImageList(win.HIMAGELIST(h))
+			win.HIMAGELIST(h).Destroy()
+		}
+	}
 }
 
-func (me *_BaseContainer) runMainLoop(hAccel win.HACCEL, processDlgMsgs bool) int {
+// Creates a new owned image list with the icons of the given one, scaled to
+// the new DPI. The original image list is left untouched. Fails if the given
+// image list is not owned by the library.
+func scaledImageList(hImg win.HIMAGELIST, oldDpi, newDpi int) (win.HIMAGELIST, bool) {
+	if _, owned := globalOwnedImageLists[hImg]; !owned {
+		return win.HIMAGELIST(0), false
+	}
+	szOld, err := hImg.GetIconSize()
+	if err != nil {
+		return win.HIMAGELIST(0), false
+	}
+	szNew := dpiScaleSize(szOld, oldDpi, newDpi)
+	count := hImg.GetImageCount()
+
+	hNew, err := win.ImageListCreate(uint(szNew.Cx), uint(szNew.Cy),
+		co.ILC_COLOR32, count, 1)
+	if err != nil {
+		return win.HIMAGELIST(0), false
+	}
+	for i := 0; i < int(count); i++ {
+		if hIcon, err := hImg.GetIcon(i, co.ILD_NORMAL); err == nil {
+			hNew.AddIcon(hIcon)
+			hIcon.DestroyIcon()
+		}
+	}
+	globalOwnedImageLists[hNew] = struct{}{}
+	return hNew, true
+}
+
+func (me *_BaseContainer) runMainLoop(hAccel win.HACCEL, hMdiClient win.HWND, processDlgMsgs bool) int {
 	vecMsg := win.NewVecSized(1, win.MSG{})
 	defer vecMsg.Free()
 	pMsg := vecMsg.Get(0) // OS-allocated
@@ -96,6 +226,11 @@ func (me *_BaseContainer) runMainLoop(hAccel win.HACCEL, processDlgMsgs bool) in
 			hTopLevel = pMsg.HWnd
 		}
 
+		// If we have an MDI client, try to translate the MDI keystrokes, like Ctrl+F4.
+		if hMdiClient != 0 && hMdiClient.TranslateMDISysAccel(pMsg) {
+			continue // message translated
+		}
+
 		// If we have an accelerator table, try to translate the message.
 		if hAccel != 0 && hTopLevel.TranslateAccelerator(hAccel, pMsg) == nil {
 			continue // message translated
@@ -11,22 +11,44 @@ import (
 )
 
 // Base to all dialog-based windows created with CreateDialogParam and
-// DialogBoxParam.
+// DialogBoxParam, or their Indirect counterparts.
 type _BaseDlg struct {
 	_BaseContainer
-	dlgId uint16
+	dlgId    uint16
+	template []byte // serialized DLGTEMPLATEEX, used instead of dlgId
 }
 
-// Constructor.
-func newBaseDlg(dlgId uint16) _BaseDlg {
-	if dlgId == 0 {
-		panic("Dialog ID must be specified.")
+// Constructor. If template is not nil, it's used instead of dlgId.
+func newBaseDlg(dlgId uint16, template *DlgTemplate) _BaseDlg {
+	if dlgId == 0 && template == nil {
+		panic("Dialog ID or template must be specified.")
 	}
 
-	return _BaseDlg{
+	me := _BaseDlg{
 		_BaseContainer: newBaseContainer(_WNDTY_DLG),
 		dlgId:          dlgId,
 	}
+	if template != nil {
+		me.template = serializeDlgTemplate(template)
+	}
+	return me
+}
+
+// Serializes the in-memory dialog template.
+//
+// Panics on error.
+func serializeDlgTemplate(template *DlgTemplate) []byte {
+	data, err := template.serialize()
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// Returns the serialized DLGTEMPLATEEX, which the Indirect functions accept in
+// place of a DLGTEMPLATE.
+func (me *_BaseDlg) pTemplate() *win.DLGTEMPLATE {
+	return (*win.DLGTEMPLATE)(unsafe.Pointer(&me.template[0]))
 }
 
 func (me *_BaseDlg) createDialogParam(hInst win.HINSTANCE, hParent win.HWND) {
@@ -36,8 +58,14 @@ func (me *_BaseDlg) createDialogParam(hInst win.HINSTANCE, hParent win.HWND) {
 	dlgProcCallback()
 
 	// The hWnd member is saved in WM_INITDIALOG processing in dlgProc.
-	_, err := hInst.CreateDialogParam(win.ResIdInt(me.dlgId), hParent, dlgProcCallback(),
-		win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	var err error
+	if me.template != nil {
+		_, err = hInst.CreateDialogIndirectParam(me.pTemplate(), hParent, dlgProcCallback(),
+			win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	} else {
+		_, err = hInst.CreateDialogParam(win.ResIdInt(me.dlgId), hParent, dlgProcCallback(),
+			win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -49,8 +77,14 @@ func (me *_BaseDlg) dialogBoxParam(hInst win.HINSTANCE, hParent win.HWND) {
 	}
 
 	// The hWnd member is saved in WM_INITDIALOG processing in dlgProc.
-	_, err := hInst.DialogBoxParam(win.ResIdInt(me.dlgId), hParent, dlgProcCallback(),
-		win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	var err error
+	if me.template != nil {
+		_, err = hInst.DialogBoxIndirectParam(me.pTemplate(), hParent, dlgProcCallback(),
+			win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	} else {
+		_, err = hInst.DialogBoxParam(win.ResIdInt(me.dlgId), hParent, dlgProcCallback(),
+			win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -81,57 +115,58 @@ func dlgProcCallback() uintptr {
 		return _dlgProcCallback
 	}
 
-	_dlgProcCallback = syscall.NewCallback(
-		func(hDlg win.HWND, uMsg co.WM, wParam win.WPARAM, lParam win.LPARAM) uintptr {
-			var pMe *_BaseDlg
-
-			if uMsg == co.WM_INITDIALOG {
-				pMe = (*_BaseDlg)(unsafe.Pointer(lParam))
-				pMe.hWnd = hDlg
-				hDlg.SetWindowLongPtr(co.GWLP_DWLP_USER, uintptr(unsafe.Pointer(pMe)))
-			} else {
-				ptr, _ := hDlg.GetWindowLongPtr(co.GWLP_DWLP_USER) // retrieve
-				pMe = (*_BaseDlg)(unsafe.Pointer(ptr))
-			}
-
-			// If no pointer stored, then no processing is done.
-			// Prevents processing before WM_INITDIALOG and after WM_NCDESTROY.
-			if pMe == nil {
-				return 0 // FALSE
-			}
-
-			// Execute before-user closures, keep track if at least one was executed.
-			msg := Wm{uMsg, wParam, lParam}
-			atLeastOneBeforeUser := pMe.beforeUserEvents.processAllMessages(msg)
-
-			// Execute user closure, if any.
-			userRet, hasUserRet := pMe.userEvents.processLastMessage(msg)
-
-			// Execute post-user closures, keep track if at least one was executed.
-			atLeastOneAfterUser := pMe.afterUserEvents.processAllMessages(msg)
-
-			switch uMsg {
-			case co.WM_INITDIALOG:
-				pMe.removeWmCreateInitdialog() // will release all memory in these closures
-			case co.WM_NCDESTROY: // always check
-				hDlg.SetWindowLongPtr(co.GWLP_DWLP_USER, 0)
-				pMe.hWnd = win.HWND(0)
-				pMe.clearMessages()
-			}
-
-			if hasUserRet {
-				if uMsg == co.WM_GETDLGCODE { // demands special treatment
-					hDlg.SetWindowLongPtr(co.GWLP_DWLP_MSGRESULT, userRet)
-					return 1 // TRUE
-				} else {
-					return userRet
-				}
-			} else if atLeastOneBeforeUser || atLeastOneAfterUser {
-				return 1 // TRUE
-			} else {
-				return 0 // FALSE
-			}
-		},
-	)
+	_dlgProcCallback = syscall.NewCallback(dlgProc)
 	return _dlgProcCallback
 }
+
+// Dialog procedure shared by all dialog-based windows.
+func dlgProc(hDlg win.HWND, uMsg co.WM, wParam win.WPARAM, lParam win.LPARAM) uintptr {
+	var pMe *_BaseDlg
+
+	if uMsg == co.WM_INITDIALOG {
+		pMe = (*_BaseDlg)(unsafe.Pointer(lParam))
+		pMe.hWnd = hDlg
+		hDlg.SetWindowLongPtr(co.GWLP_DWLP_USER, uintptr(unsafe.Pointer(pMe)))
+	} else {
+		ptr, _ := hDlg.GetWindowLongPtr(co.GWLP_DWLP_USER) // retrieve
+		pMe = (*_BaseDlg)(unsafe.Pointer(ptr))
+	}
+
+	// If no pointer stored, then no processing is done.
+	// Prevents processing before WM_INITDIALOG and after WM_NCDESTROY.
+	if pMe == nil {
+		return 0 // FALSE
+	}
+
+	// Execute before-user closures, keep track if at least one was executed.
+	msg := Wm{uMsg, wParam, lParam}
+	atLeastOneBeforeUser := pMe.beforeUserEvents.processAllMessages(msg)
+
+	// Execute user closure, if any.
+	userRet, hasUserRet := pMe.userEvents.processLastMessage(msg)
+
+	// Execute post-user closures, keep track if at least one was executed.
+	atLeastOneAfterUser := pMe.afterUserEvents.processAllMessages(msg)
+
+	switch uMsg {
+	case co.WM_INITDIALOG:
+		pMe.removeWmCreateInitdialog() // will release all memory in these closures
+	case co.WM_NCDESTROY: // always check
+		hDlg.SetWindowLongPtr(co.GWLP_DWLP_USER, 0)
+		pMe.hWnd = win.HWND(0)
+		pMe.clearMessages()
+	}
+
+	if hasUserRet {
+		if uMsg == co.WM_GETDLGCODE { // demands special treatment
+			hDlg.SetWindowLongPtr(co.GWLP_DWLP_MSGRESULT, userRet)
+			return 1 // TRUE
+		} else {
+			return userRet
+		}
+	} else if atLeastOneBeforeUser || atLeastOneAfterUser {
+		return 1 // TRUE
+	} else {
+		return 0 // FALSE
+	}
+}
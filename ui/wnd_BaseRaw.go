@@ -15,6 +15,8 @@ import (
 // Base to all windows created with CreateWindowEx.
 type _BaseRaw struct {
 	_BaseContainer
+	defProc       func(hWnd win.HWND, uMsg co.WM, wParam win.WPARAM, lParam win.LPARAM) uintptr // Replaces DefWindowProc, if set.
+	alwaysDefProc []co.WM                                                                       // Passed to defProc even if handled.
 }
 
 // Constructor.
@@ -100,6 +102,24 @@ func (me *_BaseRaw) createWindow(
 	}
 }
 
+// Calls DefWindowProc, or the procedure which replaces it, like DefFrameProc
+// and DefMDIChildProc for MDI windows.
+func (me *_BaseRaw) defWindowProc(hWnd win.HWND, uMsg co.WM, wParam win.WPARAM, lParam win.LPARAM) uintptr {
+	if me.defProc != nil {
+		return me.defProc(hWnd, uMsg, wParam, lParam)
+	}
+	return hWnd.DefWindowProc(uMsg, wParam, lParam)
+}
+
+func (me *_BaseRaw) isAlwaysDefProc(uMsg co.WM) bool {
+	for _, m := range me.alwaysDefProc {
+		if m == uMsg {
+			return true
+		}
+	}
+	return false
+}
+
 func (me *_BaseRaw) delegateFocusToFirstChild() error {
 	if hFocus := win.GetFocus(); hFocus == me.hWnd {
 		// https://stackoverflow.com/a/2835220/6923555
@@ -125,7 +145,13 @@ func wndProcCallback() uintptr {
 
 			if uMsg == co.WM_NCCREATE {
 				cs := (*win.CREATESTRUCT)(unsafe.Pointer(lParam))
-				pMe = (*_BaseRaw)(unsafe.Pointer(cs.LpCreateParams))
+				if (cs.ExStyle & co.WS_EX_MDICHILD) != 0 {
+					// MDI children receive our pointer wrapped in MDICREATESTRUCT.
+					mcs := (*win.MDICREATESTRUCT)(unsafe.Pointer(cs.LpCreateParams))
+					pMe = (*_BaseRaw)(unsafe.Pointer(mcs.LParam))
+				} else {
+					pMe = (*_BaseRaw)(unsafe.Pointer(cs.LpCreateParams))
+				}
 				pMe.hWnd = hWnd
 				hWnd.SetWindowLongPtr(co.GWLP_USERDATA, uintptr(unsafe.Pointer(pMe))) // store
 			} else {
@@ -158,12 +184,19 @@ func wndProcCallback() uintptr {
 				pMe.clearMessages()
 			}
 
+			if !hasUserRet && !atLeastOneBeforeUser && !atLeastOneAfterUser {
+				return pMe.defWindowProc(hWnd, uMsg, wParam, lParam)
+			} else if pMe.isAlwaysDefProc(uMsg) { // some MDI messages must reach the default procedure
+				defRet := pMe.defWindowProc(hWnd, uMsg, wParam, lParam)
+				if !hasUserRet {
+					return defRet
+				}
+			}
+
 			if hasUserRet {
 				return userRet
-			} else if atLeastOneBeforeUser || atLeastOneAfterUser {
-				return 0
 			} else {
-				return hWnd.DefWindowProc(uMsg, wParam, lParam)
+				return 0
 			}
 		},
 	)
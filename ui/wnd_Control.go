@@ -29,7 +29,8 @@ func NewControl(parent Parent, opts *VarOptsControl) *Control {
 	}
 }
 
-// Creates a new dialog-based custom control with [CreateDialogParam].
+// Creates a new dialog-based custom control with [CreateDialogParam], or with
+// CreateDialogIndirectParam if [VarOptsControlDlg.Template] is informed.
 //
 // [CreateDialogParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogparamw
 func NewControlDlg(parent Parent, opts *VarOptsControlDlg) *Control {
@@ -90,12 +91,9 @@ func (me *Control) Focus() {
 //
 // Implements [Parent].
 //
-// Panics if called after the window has been created.
+// Can also be called after the window has been created, so handlers can be
+// added and removed at runtime; see [EventsWindow] for details.
 func (me *Control) On() *EventsWindow {
-	if me.Hwnd() != 0 {
-		panic("Cannot add event handling after the window has been created.")
-	}
-
 	if me.raw != nil {
 		return &me.raw.userEvents
 	} else {
@@ -135,6 +133,16 @@ func (me *Control) UiThread(fun func()) {
 	}
 }
 
+// Returns the current DPI of the window, which changes when it's moved to a
+// monitor with a different scaling factor. Before the window is created,
+// returns the system DPI.
+//
+// Values computed with [DpiX] and [DpiY] can be converted to this DPI with
+// [DpiScale].
+func (me *Control) Dpi() int {
+	return me.base().dpi
+}
+
 // Implements [Parent].
 func (me *Control) base() *_BaseContainer {
 	if me.raw != nil {
@@ -16,7 +16,7 @@ type _ControlDlg struct {
 func newControlDlg(parent Parent, opts *VarOptsControlDlg) *_ControlDlg {
 	setUniqueCtrlId(&opts.ctrlId)
 	me := &_ControlDlg{
-		_BaseDlg: newBaseDlg(opts.dlgId),
+		_BaseDlg: newBaseDlg(opts.dlgId, opts.template),
 		ctrlId:   opts.ctrlId,
 	}
 
@@ -39,11 +39,14 @@ func (me *_ControlDlg) defaultMessageHandlers() {
 	me.userEvents.WmNcPaint(func(p WmNcPaint) {
 		paintThemedBorders(me.hWnd, p)
 	})
+
+	me._BaseDlg._BaseContainer.dpiMessageHandlers()
 }
 
 // Options for [NewControlDlg]; returned by [OptsControlDlg].
 type VarOptsControlDlg struct {
 	dlgId    uint16
+	template *DlgTemplate
 	ctrlId   uint16
 	layout   LAY
 	position win.POINT
@@ -56,11 +59,23 @@ func OptsControlDlg() *VarOptsControlDlg {
 
 // Dialog resource ID passed to [CreateDialogParam].
 //
-// Panics if not informed.
+// Panics if neither this nor [VarOptsControlDlg.Template] is informed.
 //
 // [CreateDialogParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogparamw
 func (o *VarOptsControlDlg) DlgId(id uint16) *VarOptsControlDlg { o.dlgId = id; return o }
 
+// In-memory dialog template passed to [CreateDialogIndirectParam], so no
+// dialog resource is needed. Overrides [VarOptsControlDlg.DlgId].
+//
+// The template must have the WS_CHILD style, and it should have the
+// co.DS_CONTROL and co.DS_SHELLFONT dialog styles, with an 8-point
+// "MS Shell Dlg" font.
+//
+// Defaults to none.
+//
+// [CreateDialogIndirectParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogindirectparamw
+func (o *VarOptsControlDlg) Template(t *DlgTemplate) *VarOptsControlDlg { o.template = t; return o }
+
 // Control ID. Must be unique within a same parent window.
 //
 // Defaults to an auto-generated ID.
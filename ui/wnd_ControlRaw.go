@@ -38,6 +38,8 @@ func (me *_ControlRaw) defaultMessageHandlers() {
 	me.userEvents.WmNcPaint(func(p WmNcPaint) {
 		paintThemedBorders(me.hWnd, p)
 	})
+
+	me._BaseRaw._BaseContainer.dpiMessageHandlers()
 }
 
 // Options for [NewControl]; returned by [OptsControl].
@@ -12,20 +12,25 @@ import (
 
 type (
 	_StorageMsg struct { // ordinary WM messages
-		id  co.WM
-		fun func(p Wm) uintptr
+		tokenId uint32
+		id      co.WM
+		ctrlId  uint16 // if not zero, only messages whose WPARAM is this control ID
+		fun     func(p Wm) uintptr
 	}
 	_StorageCmd struct { // WM_COMMAND
+		tokenId   uint32
 		cmdId     uint16
 		notifCode co.CMD
 		fun       func()
 	}
 	_StorageNfy struct { // WM_NOTIFY
-		idFrom uint16
-		code   co.NM
-		fun    func(p unsafe.Pointer) uintptr
+		tokenId uint32
+		idFrom  uint16
+		code    co.NM
+		fun     func(p unsafe.Pointer) uintptr
 	}
 	_StorageTmr struct { // WM_TIMER
+		tokenId uint32
 		timerId uintptr
 		fun     func()
 	}
@@ -36,9 +41,26 @@ type (
 // You cannot create this object directly, it will be created automatically
 // by the owning window.
 //
+// Handlers can be added before or after the window is created, except for
+// [EventsWindow.WmCreate] and [EventsWindow.WmInitDialog], which must be added
+// before. Each handler returns an [EventToken], which can be used to remove it.
+//
+// # Ordering
+//
+// For each message, the window runs:
+//   - all the internal library handlers, which must run before the user's;
+//   - the most recently added user handler for that message – previously
+//     added handlers are kept, but not run, until the newer ones are removed;
+//   - all the internal library handlers which must run after the user's.
+//
+// Handlers added or removed while a message is being processed take effect
+// from the next message onwards.
+//
 // [messages]: https://learn.microsoft.com/en-us/windows/win32/winmsg/about-messages-and-message-queues
 type EventsWindow struct {
-	defProcVal uintptr // 0 for ordinary windows, TRUE for dialogs
+	defProcVal  uintptr // 0 for ordinary windows, TRUE for dialogs
+	nextTokenId uint32  // incremented at each new handler
+	created     bool    // WM_CREATE/INITDIALOG already processed
 
 	// We use simple arrays instead of maps, because the closures are never too
 	// many, therefore a simple linear search is more efficient.
@@ -52,6 +74,37 @@ type EventsWindow struct {
 	tmrs []_StorageTmr // WM_TIMER
 }
 
+// Identifies a message handler added to an [EventsWindow], so it can be removed
+// later with [EventToken.Remove].
+//
+// Handlers which don't need to be removed can simply ignore the token.
+type EventToken struct {
+	owner *EventsWindow
+	id    uint32
+}
+
+// Removes the message handler identified by this token. If the handler was
+// overriding a previously added one, the previous one will be run again.
+//
+// Does nothing if the handler was already removed, or if the window was
+// destroyed.
+//
+// # Example
+//
+//	var wnd ui.Parent // initialized somewhere
+//
+//	token := wnd.On().WmMouseMove(func(p ui.WmMouse) {
+//		println(p.Pos().X, p.Pos().Y)
+//	})
+//
+//	// later on...
+//	token.Remove()
+func (t EventToken) Remove() {
+	if t.owner != nil {
+		t.owner.remove(t.id)
+	}
+}
+
 // Constructor.
 func newEventsWindow(wndTy _WNDTY) EventsWindow {
 	defProcVal := 0
@@ -89,6 +142,48 @@ func (me *EventsWindow) hasMessage() bool {
 		len(me.tmrs) > 0
 }
 
+func (obj *_StorageMsg) matches(p Wm) bool {
+	return obj.id == p.Msg && (obj.ctrlId == 0 || obj.ctrlId == uint16(p.WParam))
+}
+
+func (me *EventsWindow) newToken() EventToken {
+	me.nextTokenId++
+	return EventToken{me, me.nextTokenId}
+}
+
+// Removes all closures with the given token ID. The slices are always
+// reallocated, because they may be being iterated by a message processing.
+func (me *EventsWindow) remove(tokenId uint32) {
+	msgs := make([]_StorageMsg, 0, len(me.msgs))
+	for _, obj := range me.msgs {
+		if obj.tokenId != tokenId {
+			msgs = append(msgs, obj)
+		}
+	}
+	cmds := make([]_StorageCmd, 0, len(me.cmds))
+	for _, obj := range me.cmds {
+		if obj.tokenId != tokenId {
+			cmds = append(cmds, obj)
+		}
+	}
+	nfys := make([]_StorageNfy, 0, len(me.nfys))
+	for _, obj := range me.nfys {
+		if obj.tokenId != tokenId {
+			nfys = append(nfys, obj)
+		}
+	}
+	tmrs := make([]_StorageTmr, 0, len(me.tmrs))
+	for _, obj := range me.tmrs {
+		if obj.tokenId != tokenId {
+			tmrs = append(tmrs, obj)
+		}
+	}
+
+	if me.msgs != nil { // after clear(), window is gone, keep everything nil
+		me.msgs, me.cmds, me.nfys, me.tmrs = msgs, cmds, nfys, tmrs
+	}
+}
+
 // For library-defined events, to run before and after user events. We run them
 // all, discarding the result.
 func (me *EventsWindow) processAllMessages(p Wm) (atLeastOne bool) {
@@ -131,7 +226,7 @@ func (me *EventsWindow) processAllMessages(p Wm) (atLeastOne bool) {
 	}
 
 	for _, obj := range me.msgs {
-		if obj.id == p.Msg {
+		if obj.matches(p) {
 			obj.fun(p)
 			atLeastOne = true
 		}
@@ -140,8 +235,8 @@ func (me *EventsWindow) processAllMessages(p Wm) (atLeastOne bool) {
 }
 
 // For user events. When the user adds a message handler, it will overwrite a
-// previously added one. It would be too costly to search and remove the
-// previous one, so we just keep them all, and run the last one.
+// previously added one. We keep them all, and run the last one, so removing a
+// handler brings back the previous one.
 func (me *EventsWindow) processLastMessage(p Wm) (userRet uintptr, wasHandled bool) {
 	switch p.Msg {
 	case co.WM_CREATE:
@@ -180,7 +275,7 @@ func (me *EventsWindow) processLastMessage(p Wm) (userRet uintptr, wasHandled bo
 		}
 	default:
 		for i := len(me.msgs) - 1; i >= 0; i-- {
-			if me.msgs[i].id == p.Msg {
+			if me.msgs[i].matches(p) {
 				return me.msgs[i].fun(p), true // handled, stop here
 			}
 		}
@@ -193,10 +288,13 @@ func (me *EventsWindow) processLastMessage(p Wm) (userRet uintptr, wasHandled bo
 func (me *EventsWindow) removeWmCreateInitdialog() {
 	me.wmCreates = nil
 	me.wmInitDlgs = nil
+	me.created = true
 }
 
 // [WM_CREATE] message handler.
 //
+// Panics if called after the window has been created.
+//
 // Return 0 to continue window creation, or -1 to abort it.
 //
 // Sent only to windows created with [CreateWindowEx]; dialog windows will
@@ -213,11 +311,16 @@ func (me *EventsWindow) removeWmCreateInitdialog() {
 // [WM_CREATE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-create
 // [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
 func (me *EventsWindow) WmCreate(fun func(p WmCreate) int) {
+	if me.created {
+		panic("Cannot add WM_CREATE handling after the window has been created.")
+	}
 	me.wmCreates = append(me.wmCreates, fun)
 }
 
 // [WM_INITDIALOG] message handler.
 //
+// Panics if called after the window has been created.
+//
 // Return true to direct the system to set the keyboard focus to the first
 // control.
 //
@@ -235,6 +338,9 @@ func (me *EventsWindow) WmCreate(fun func(p WmCreate) int) {
 // [WM_INITDIALOG]: https://learn.microsoft.com/en-us/windows/win32/dlgbox/wm-initdialog
 // [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
 func (me *EventsWindow) WmInitDialog(fun func(p WmInitDialog) bool) {
+	if me.created {
+		panic("Cannot add WM_INITDIALOG handling after the window has been created.")
+	}
 	me.wmInitDlgs = append(me.wmInitDlgs, fun)
 }
 
@@ -243,8 +349,19 @@ func (me *EventsWindow) WmInitDialog(fun func(p WmInitDialog) bool) {
 // Avoid this method, prefer the specific message handlers.
 //
 // [message handler]: https://learn.microsoft.com/en-us/windows/win32/learnwin32/window-messages
-func (me *EventsWindow) Wm(id co.WM, fun func(p Wm) uintptr) {
-	me.msgs = append(me.msgs, _StorageMsg{id, fun})
+func (me *EventsWindow) Wm(id co.WM, fun func(p Wm) uintptr) EventToken {
+	token := me.newToken()
+	me.msgs = append(me.msgs, _StorageMsg{token.id, id, 0, fun})
+	return token
+}
+
+// Message handler for messages sent to the parent on behalf of a child
+// control, whose WPARAM is the control ID, like WM_DRAWITEM. Handlers of
+// different controls don't override each other.
+func (me *EventsWindow) wmCtrl(id co.WM, ctrlId uint16, fun func(p Wm) uintptr) EventToken {
+	token := me.newToken()
+	me.msgs = append(me.msgs, _StorageMsg{token.id, id, ctrlId, fun})
+	return token
 }
 
 // Generic [WM_COMMAND] handler.
@@ -252,17 +369,24 @@ func (me *EventsWindow) Wm(id co.WM, fun func(p Wm) uintptr) {
 // Avoid this method, prefer the specific command notification handlers.
 //
 // [WM_COMMAND]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-command
-func (me *EventsWindow) WmCommand(cmdId uint16, notifCode co.CMD, fun func()) {
-	me.cmds = append(me.cmds, _StorageCmd{cmdId, notifCode, fun})
+func (me *EventsWindow) WmCommand(cmdId uint16, notifCode co.CMD, fun func()) EventToken {
+	token := me.newToken()
+	me.cmds = append(me.cmds, _StorageCmd{token.id, cmdId, notifCode, fun})
+	return token
 }
 
 // [WM_COMMAND] handler for both accelerator and menu events. Ideal for IDs
 // shared between accelerator keys and menu items.
 //
+// The returned token removes both handlers at once.
+//
 // [WM_COMMAND]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-command
-func (me *EventsWindow) WmCommandAccelMenu(cmdId uint16, fun func()) {
-	me.WmCommand(cmdId, co.CMD_MENU, fun)
-	me.WmCommand(cmdId, co.CMD_ACCELERATOR, fun)
+func (me *EventsWindow) WmCommandAccelMenu(cmdId uint16, fun func()) EventToken {
+	token := me.newToken()
+	me.cmds = append(me.cmds,
+		_StorageCmd{token.id, cmdId, co.CMD_MENU, fun},
+		_StorageCmd{token.id, cmdId, co.CMD_ACCELERATOR, fun})
+	return token
 }
 
 // Generic [WM_NOTIFY] handler.
@@ -270,22 +394,26 @@ func (me *EventsWindow) WmCommandAccelMenu(cmdId uint16, fun func()) {
 // Avoid this method, prefer the specific notification handlers.
 //
 // [WM_NOTIFY]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-notify
-func (me *EventsWindow) WmNotify(idFrom uint16, code co.NM, fun func(p unsafe.Pointer) uintptr) {
-	me.nfys = append(me.nfys, _StorageNfy{idFrom, code, fun})
+func (me *EventsWindow) WmNotify(idFrom uint16, code co.NM, fun func(p unsafe.Pointer) uintptr) EventToken {
+	token := me.newToken()
+	me.nfys = append(me.nfys, _StorageNfy{token.id, idFrom, code, fun})
+	return token
 }
 
 // [WM_TIMER] message handler.
 //
 // [WM_TIMER]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-timer
-func (me *EventsWindow) WmTimer(timerId uintptr, fun func()) {
-	me.tmrs = append(me.tmrs, _StorageTmr{timerId, fun})
+func (me *EventsWindow) WmTimer(timerId uintptr, fun func()) EventToken {
+	token := me.newToken()
+	me.tmrs = append(me.tmrs, _StorageTmr{token.id, timerId, fun})
+	return token
 }
 
 // [WM_ACTIVATE] message handler.
 //
 // [WM_ACTIVATE]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-activate
-func (me *EventsWindow) WmActivate(fun func(p WmActivate)) {
-	me.Wm(co.WM_ACTIVATE, func(p Wm) uintptr {
+func (me *EventsWindow) WmActivate(fun func(p WmActivate)) EventToken {
+	return me.Wm(co.WM_ACTIVATE, func(p Wm) uintptr {
 		fun(WmActivate{Raw: p})
 		return me.defProcVal
 	})
@@ -294,8 +422,8 @@ func (me *EventsWindow) WmActivate(fun func(p WmActivate)) {
 // [WM_ACTIVATEAPP] message handler.
 //
 // [WM_ACTIVATEAPP]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-activateapp
-func (me *EventsWindow) WmActivateApp(fun func(p WmActivateApp)) {
-	me.Wm(co.WM_ACTIVATEAPP, func(p Wm) uintptr {
+func (me *EventsWindow) WmActivateApp(fun func(p WmActivateApp)) EventToken {
+	return me.Wm(co.WM_ACTIVATEAPP, func(p Wm) uintptr {
 		fun(WmActivateApp{Raw: p})
 		return me.defProcVal
 	})
@@ -304,8 +432,8 @@ func (me *EventsWindow) WmActivateApp(fun func(p WmActivateApp)) {
 // [WM_APPCOMMAND] message handler.
 //
 // [WM_APPCOMMAND]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-appcommand
-func (me *EventsWindow) WmAppCommand(fun func(p WmAppCommand)) {
-	me.Wm(co.WM_APPCOMMAND, func(p Wm) uintptr {
+func (me *EventsWindow) WmAppCommand(fun func(p WmAppCommand)) EventToken {
+	return me.Wm(co.WM_APPCOMMAND, func(p Wm) uintptr {
 		fun(WmAppCommand{Raw: p})
 		return 1
 	})
@@ -314,8 +442,8 @@ func (me *EventsWindow) WmAppCommand(fun func(p WmAppCommand)) {
 // [WM_ASKCBFORMATNAME] message handler.
 //
 // [WM_ASKCBFORMATNAME]: https://learn.microsoft.com/en-us/windows/win32/dataxchg/wm-askcbformatname
-func (me *EventsWindow) WmAskCbFormatName(fun func(p WmAskCbFormatName)) {
-	me.Wm(co.WM_ASKCBFORMATNAME, func(p Wm) uintptr {
+func (me *EventsWindow) WmAskCbFormatName(fun func(p WmAskCbFormatName)) EventToken {
+	return me.Wm(co.WM_ASKCBFORMATNAME, func(p Wm) uintptr {
 		fun(WmAskCbFormatName{Raw: p})
 		return me.defProcVal
 	})
@@ -324,8 +452,8 @@ func (me *EventsWindow) WmAskCbFormatName(fun func(p WmAskCbFormatName)) {
 // [WM_CANCELMODE] message handler.
 //
 // [WM_CANCELMODE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-cancelmode
-func (me *EventsWindow) WmCancelMode(fun func()) {
-	me.Wm(co.WM_CANCELMODE, func(_ Wm) uintptr {
+func (me *EventsWindow) WmCancelMode(fun func()) EventToken {
+	return me.Wm(co.WM_CANCELMODE, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -334,8 +462,8 @@ func (me *EventsWindow) WmCancelMode(fun func()) {
 // [WM_CAPTURECHANGED] message handler.
 //
 // [WM_CAPTURECHANGED]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-capturechanged
-func (me *EventsWindow) WmCaptureChanged(fun func(p WmCaptureChanged)) {
-	me.Wm(co.WM_CAPTURECHANGED, func(p Wm) uintptr {
+func (me *EventsWindow) WmCaptureChanged(fun func(p WmCaptureChanged)) EventToken {
+	return me.Wm(co.WM_CAPTURECHANGED, func(p Wm) uintptr {
 		fun(WmCaptureChanged{Raw: p})
 		return me.defProcVal
 	})
@@ -344,8 +472,8 @@ func (me *EventsWindow) WmCaptureChanged(fun func(p WmCaptureChanged)) {
 // [WM_CHANGECBCHAIN] message handler.
 //
 // [WM_CHANGECBCHAIN]: https://learn.microsoft.com/en-us/windows/win32/dataxchg/wm-changecbchain
-func (me *EventsWindow) WmChangeCbChain(fun func(p WmChangeCbChain)) {
-	me.Wm(co.WM_CHANGECBCHAIN, func(p Wm) uintptr {
+func (me *EventsWindow) WmChangeCbChain(fun func(p WmChangeCbChain)) EventToken {
+	return me.Wm(co.WM_CHANGECBCHAIN, func(p Wm) uintptr {
 		fun(WmChangeCbChain{Raw: p})
 		return me.defProcVal
 	})
@@ -354,8 +482,8 @@ func (me *EventsWindow) WmChangeCbChain(fun func(p WmChangeCbChain)) {
 // [WM_CHAR] message handler.
 //
 // [WM_CHAR]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-char
-func (me *EventsWindow) WmChar(fun func(p WmChar)) {
-	me.Wm(co.WM_CHAR, func(p Wm) uintptr {
+func (me *EventsWindow) WmChar(fun func(p WmChar)) EventToken {
+	return me.Wm(co.WM_CHAR, func(p Wm) uintptr {
 		fun(WmChar{Raw: p})
 		return me.defProcVal
 	})
@@ -364,8 +492,8 @@ func (me *EventsWindow) WmChar(fun func(p WmChar)) {
 // [WM_CHARTOITEM] message handler.
 //
 // [WM_CHARTOITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-chartoitem
-func (me *EventsWindow) WmCharToItem(fun func(p WmCharToItem) int) {
-	me.Wm(co.WM_CHARTOITEM, func(p Wm) uintptr {
+func (me *EventsWindow) WmCharToItem(fun func(p WmCharToItem) int) EventToken {
+	return me.Wm(co.WM_CHARTOITEM, func(p Wm) uintptr {
 		return uintptr(fun(WmCharToItem{Raw: p}))
 	})
 }
@@ -373,8 +501,8 @@ func (me *EventsWindow) WmCharToItem(fun func(p WmCharToItem) int) {
 // [WM_CHILDACTIVATE] message handler.
 //
 // [WM_CHILDACTIVATE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-childactivate
-func (me *EventsWindow) WmChildActivate(fun func()) {
-	me.Wm(co.WM_CHILDACTIVATE, func(_ Wm) uintptr {
+func (me *EventsWindow) WmChildActivate(fun func()) EventToken {
+	return me.Wm(co.WM_CHILDACTIVATE, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -383,8 +511,8 @@ func (me *EventsWindow) WmChildActivate(fun func()) {
 // [WM_CLIPBOARDUPDATE] message handler.
 //
 // [WM_CLIPBOARDUPDATE]: https://learn.microsoft.com/en-us/windows/win32/dataxchg/wm-clipboardupdate
-func (me *EventsWindow) WmClipboardUpdate(fun func()) {
-	me.Wm(co.WM_CLIPBOARDUPDATE, func(_ Wm) uintptr {
+func (me *EventsWindow) WmClipboardUpdate(fun func()) EventToken {
+	return me.Wm(co.WM_CLIPBOARDUPDATE, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -401,8 +529,8 @@ func (me *EventsWindow) WmClipboardUpdate(fun func()) {
 // [WM_CLOSE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-close
 // [DestroyWindow]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-destroywindow
 // [EndDialog]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-enddialog
-func (me *EventsWindow) WmClose(fun func()) {
-	me.Wm(co.WM_CLOSE, func(_ Wm) uintptr {
+func (me *EventsWindow) WmClose(fun func()) EventToken {
+	return me.Wm(co.WM_CLOSE, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -411,8 +539,8 @@ func (me *EventsWindow) WmClose(fun func()) {
 // [WM_COMPAREITEM] message handler.
 //
 // [WM_COMPAREITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-compareitem
-func (me *EventsWindow) WmCompareItem(fun func(p WmCompareItem) int) {
-	me.Wm(co.WM_COMPAREITEM, func(p Wm) uintptr {
+func (me *EventsWindow) WmCompareItem(fun func(p WmCompareItem) int) EventToken {
+	return me.Wm(co.WM_COMPAREITEM, func(p Wm) uintptr {
 		return uintptr(fun(WmCompareItem{Raw: p}))
 	})
 }
@@ -420,8 +548,8 @@ func (me *EventsWindow) WmCompareItem(fun func(p WmCompareItem) int) {
 // [WM_CONTEXTMENU] message handler.
 //
 // [WM_CONTEXTMENU]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-contextmenu
-func (me *EventsWindow) WmContextMenu(fun func(p WmContextMenu)) {
-	me.Wm(co.WM_CONTEXTMENU, func(p Wm) uintptr {
+func (me *EventsWindow) WmContextMenu(fun func(p WmContextMenu)) EventToken {
+	return me.Wm(co.WM_CONTEXTMENU, func(p Wm) uintptr {
 		fun(WmContextMenu{Raw: p})
 		return me.defProcVal
 	})
@@ -430,8 +558,8 @@ func (me *EventsWindow) WmContextMenu(fun func(p WmContextMenu)) {
 // [WM_COPYDATA] message handler.
 //
 // [WM_COPYDATA]: https://learn.microsoft.com/en-us/windows/win32/dataxchg/wm-copydata
-func (me *EventsWindow) WmCopyData(fun func(p WmCopyData) bool) {
-	me.Wm(co.WM_COPYDATA, func(p Wm) uintptr {
+func (me *EventsWindow) WmCopyData(fun func(p WmCopyData) bool) EventToken {
+	return me.Wm(co.WM_COPYDATA, func(p Wm) uintptr {
 		return utl.BoolToUintptr(fun(WmCopyData{Raw: p}))
 	})
 }
@@ -439,8 +567,8 @@ func (me *EventsWindow) WmCopyData(fun func(p WmCopyData) bool) {
 // [WM_CTLCOLORBTN] message handler.
 //
 // [WM_CTLCOLORBTN]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-ctlcolorbtn
-func (me *EventsWindow) WmCtlColorBtn(fun func(p WmCtlColor) win.HBRUSH) {
-	me.Wm(co.WM_CTLCOLORBTN, func(p Wm) uintptr {
+func (me *EventsWindow) WmCtlColorBtn(fun func(p WmCtlColor) win.HBRUSH) EventToken {
+	return me.Wm(co.WM_CTLCOLORBTN, func(p Wm) uintptr {
 		return uintptr(fun(WmCtlColor{Raw: p}))
 	})
 }
@@ -448,8 +576,8 @@ func (me *EventsWindow) WmCtlColorBtn(fun func(p WmCtlColor) win.HBRUSH) {
 // [WM_CTLCOLORDLG] message handler.
 //
 // [WM_CTLCOLORDLG]: https://learn.microsoft.com/en-us/windows/win32/dlgbox/wm-ctlcolordlg
-func (me *EventsWindow) WmCtlColorDlg(fun func(p WmCtlColor) win.HBRUSH) {
-	me.Wm(co.WM_CTLCOLORDLG, func(p Wm) uintptr {
+func (me *EventsWindow) WmCtlColorDlg(fun func(p WmCtlColor) win.HBRUSH) EventToken {
+	return me.Wm(co.WM_CTLCOLORDLG, func(p Wm) uintptr {
 		return uintptr(fun(WmCtlColor{Raw: p}))
 	})
 }
@@ -457,8 +585,8 @@ func (me *EventsWindow) WmCtlColorDlg(fun func(p WmCtlColor) win.HBRUSH) {
 // [WM_CTLCOLOREDIT] message handler.
 //
 // [WM_CTLCOLOREDIT]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-ctlcoloredit
-func (me *EventsWindow) WmCtlColorEdit(fun func(p WmCtlColor) win.HBRUSH) {
-	me.Wm(co.WM_CTLCOLOREDIT, func(p Wm) uintptr {
+func (me *EventsWindow) WmCtlColorEdit(fun func(p WmCtlColor) win.HBRUSH) EventToken {
+	return me.Wm(co.WM_CTLCOLOREDIT, func(p Wm) uintptr {
 		return uintptr(fun(WmCtlColor{Raw: p}))
 	})
 }
@@ -466,8 +594,8 @@ func (me *EventsWindow) WmCtlColorEdit(fun func(p WmCtlColor) win.HBRUSH) {
 // [WM_CTLCOLORLISTBOX] message handler.
 //
 // [WM_CTLCOLORLISTBOX]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-ctlcolorlistbox
-func (me *EventsWindow) WmCtlColorListBox(fun func(p WmCtlColor) win.HBRUSH) {
-	me.Wm(co.WM_CTLCOLORLISTBOX, func(p Wm) uintptr {
+func (me *EventsWindow) WmCtlColorListBox(fun func(p WmCtlColor) win.HBRUSH) EventToken {
+	return me.Wm(co.WM_CTLCOLORLISTBOX, func(p Wm) uintptr {
 		return uintptr(fun(WmCtlColor{Raw: p}))
 	})
 }
@@ -475,8 +603,8 @@ func (me *EventsWindow) WmCtlColorListBox(fun func(p WmCtlColor) win.HBRUSH) {
 // [WM_CTLCOLORSCROLLBAR] message handler.
 //
 // [WM_CTLCOLORSCROLLBAR]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-ctlcolorscrollbar
-func (me *EventsWindow) WmCtlColorScrollBar(fun func(p WmCtlColor) win.HBRUSH) {
-	me.Wm(co.WM_CTLCOLORSCROLLBAR, func(p Wm) uintptr {
+func (me *EventsWindow) WmCtlColorScrollBar(fun func(p WmCtlColor) win.HBRUSH) EventToken {
+	return me.Wm(co.WM_CTLCOLORSCROLLBAR, func(p Wm) uintptr {
 		return uintptr(fun(WmCtlColor{Raw: p}))
 	})
 }
@@ -484,8 +612,8 @@ func (me *EventsWindow) WmCtlColorScrollBar(fun func(p WmCtlColor) win.HBRUSH) {
 // [WM_CTLCOLORSTATIC] message handler.
 //
 // [WM_CTLCOLORSTATIC]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-ctlcolorstatic
-func (me *EventsWindow) WmCtlColorStatic(fun func(p WmCtlColor) win.HBRUSH) {
-	me.Wm(co.WM_CTLCOLORSTATIC, func(p Wm) uintptr {
+func (me *EventsWindow) WmCtlColorStatic(fun func(p WmCtlColor) win.HBRUSH) EventToken {
+	return me.Wm(co.WM_CTLCOLORSTATIC, func(p Wm) uintptr {
 		return uintptr(fun(WmCtlColor{Raw: p}))
 	})
 }
@@ -493,8 +621,8 @@ func (me *EventsWindow) WmCtlColorStatic(fun func(p WmCtlColor) win.HBRUSH) {
 // [WM_DEADCHAR] message handler.
 //
 // [WM_DEADCHAR]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-deadchar
-func (me *EventsWindow) WmDeadChar(fun func(p WmChar)) {
-	me.Wm(co.WM_DEADCHAR, func(p Wm) uintptr {
+func (me *EventsWindow) WmDeadChar(fun func(p WmChar)) EventToken {
+	return me.Wm(co.WM_DEADCHAR, func(p Wm) uintptr {
 		fun(WmChar{Raw: p})
 		return me.defProcVal
 	})
@@ -503,8 +631,8 @@ func (me *EventsWindow) WmDeadChar(fun func(p WmChar)) {
 // [WM_DELETEITEM] message handler.
 //
 // [WM_DELETEITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-deleteitem
-func (me *EventsWindow) WmDeleteItem(fun func(p WmDeleteItem)) {
-	me.Wm(co.WM_DELETEITEM, func(p Wm) uintptr {
+func (me *EventsWindow) WmDeleteItem(fun func(p WmDeleteItem)) EventToken {
+	return me.Wm(co.WM_DELETEITEM, func(p Wm) uintptr {
 		fun(WmDeleteItem{Raw: p})
 		return 1
 	})
@@ -513,8 +641,8 @@ func (me *EventsWindow) WmDeleteItem(fun func(p WmDeleteItem)) {
 // [WM_DESTROY] message handler.
 //
 // [WM_DESTROY]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-destroy
-func (me *EventsWindow) WmDestroy(fun func()) {
-	me.Wm(co.WM_DESTROY, func(_ Wm) uintptr {
+func (me *EventsWindow) WmDestroy(fun func()) EventToken {
+	return me.Wm(co.WM_DESTROY, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -523,8 +651,8 @@ func (me *EventsWindow) WmDestroy(fun func()) {
 // [WM_DESTROYCLIPBOARD] message handler.
 //
 // [WM_DESTROYCLIPBOARD]: https://learn.microsoft.com/en-us/windows/win32/dataxchg/wm-destroyclipboard
-func (me *EventsWindow) WmDestroyClipboard(fun func()) {
-	me.Wm(co.WM_DESTROYCLIPBOARD, func(_ Wm) uintptr {
+func (me *EventsWindow) WmDestroyClipboard(fun func()) EventToken {
+	return me.Wm(co.WM_DESTROYCLIPBOARD, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -533,8 +661,8 @@ func (me *EventsWindow) WmDestroyClipboard(fun func()) {
 // [WM_DEVICECHANGE] message handler.
 //
 // [WM_DEVICECHANGE]: https://learn.microsoft.com/en-us/windows/win32/devio/wm-devicechange
-func (me *EventsWindow) WmDeviceChange(fun func(WmDeviceChange) co.BROADCAST_QUERY) {
-	me.Wm(co.WM_DEVICECHANGE, func(p Wm) uintptr {
+func (me *EventsWindow) WmDeviceChange(fun func(WmDeviceChange) co.BROADCAST_QUERY) EventToken {
+	return me.Wm(co.WM_DEVICECHANGE, func(p Wm) uintptr {
 		return uintptr(fun(WmDeviceChange{Raw: p}))
 	})
 }
@@ -542,8 +670,8 @@ func (me *EventsWindow) WmDeviceChange(fun func(WmDeviceChange) co.BROADCAST_QUE
 // [WM_DEVMODECHANGE] message handler.
 //
 // [WM_DEVMODECHANGE]: https://learn.microsoft.com/en-us/windows/win32/gdi/wm-devmodechange
-func (me *EventsWindow) WmDevModeChange(fun func(WmDevModeChange)) {
-	me.Wm(co.WM_DEVMODECHANGE, func(p Wm) uintptr {
+func (me *EventsWindow) WmDevModeChange(fun func(WmDevModeChange)) EventToken {
+	return me.Wm(co.WM_DEVMODECHANGE, func(p Wm) uintptr {
 		fun(WmDevModeChange{Raw: p})
 		return me.defProcVal
 	})
@@ -552,18 +680,31 @@ func (me *EventsWindow) WmDevModeChange(fun func(WmDevModeChange)) {
 // [WM_DISPLAYCHANGE] message handler.
 //
 // [WM_DISPLAYCHANGE]: https://learn.microsoft.com/en-us/windows/win32/gdi/wm-displaychange
-func (me *EventsWindow) WmDisplayChange(fun func(p WmDisplayChange)) {
-	me.Wm(co.WM_DISPLAYCHANGE, func(p Wm) uintptr {
+func (me *EventsWindow) WmDisplayChange(fun func(p WmDisplayChange)) EventToken {
+	return me.Wm(co.WM_DISPLAYCHANGE, func(p Wm) uintptr {
 		fun(WmDisplayChange{Raw: p})
 		return me.defProcVal
 	})
 }
 
+// [WM_DPICHANGED] message handler.
+//
+// The window and its children are automatically rescaled before this handler
+// is called.
+//
+// [WM_DPICHANGED]: https://learn.microsoft.com/en-us/windows/win32/hidpi/wm-dpichanged
+func (me *EventsWindow) WmDpiChanged(fun func(p WmDpiChanged)) EventToken {
+	return me.Wm(co.WM_DPICHANGED, func(p Wm) uintptr {
+		fun(WmDpiChanged{Raw: p})
+		return 0
+	})
+}
+
 // [WM_DRAWCLIPBOARD] message handler.
 //
 // [WM_DRAWCLIPBOARD]: https://learn.microsoft.com/en-us/windows/win32/dataxchg/wm-drawclipboard
-func (me *EventsWindow) WmDrawClipboard(fun func()) {
-	me.Wm(co.WM_DRAWCLIPBOARD, func(_ Wm) uintptr {
+func (me *EventsWindow) WmDrawClipboard(fun func()) EventToken {
+	return me.Wm(co.WM_DRAWCLIPBOARD, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -572,8 +713,8 @@ func (me *EventsWindow) WmDrawClipboard(fun func()) {
 // [WM_DRAWITEM] message handler.
 //
 // [WM_DRAWITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-drawitem
-func (me *EventsWindow) WmDrawItem(fun func(p WmDrawItem)) {
-	me.Wm(co.WM_DRAWITEM, func(p Wm) uintptr {
+func (me *EventsWindow) WmDrawItem(fun func(p WmDrawItem)) EventToken {
+	return me.Wm(co.WM_DRAWITEM, func(p Wm) uintptr {
 		fun(WmDrawItem{Raw: p})
 		return 1
 	})
@@ -582,8 +723,8 @@ func (me *EventsWindow) WmDrawItem(fun func(p WmDrawItem)) {
 // [WM_DROPFILES] message handler.
 //
 // [WM_DROPFILES]: https://learn.microsoft.com/en-us/windows/win32/shell/wm-dropfiles
-func (me *EventsWindow) WmDropFiles(fun func(p WmDropFiles)) {
-	me.Wm(co.WM_DROPFILES, func(p Wm) uintptr {
+func (me *EventsWindow) WmDropFiles(fun func(p WmDropFiles)) EventToken {
+	return me.Wm(co.WM_DROPFILES, func(p Wm) uintptr {
 		fun(WmDropFiles{Raw: p})
 		return me.defProcVal
 	})
@@ -592,8 +733,8 @@ func (me *EventsWindow) WmDropFiles(fun func(p WmDropFiles)) {
 // [WM_DWMCOLORIZATIONCOLORCHANGED] message handler.
 //
 // [WM_DWMCOLORIZATIONCOLORCHANGED]: https://learn.microsoft.com/en-us/windows/win32/dwm/wm-dwmcolorizationcolorchanged
-func (me *EventsWindow) WmDwmColorizationColorChanged(fun func(p WmDwmColorizationColorChanged)) {
-	me.Wm(co.WM_DWMCOLORIZATIONCOLORCHANGED, func(p Wm) uintptr {
+func (me *EventsWindow) WmDwmColorizationColorChanged(fun func(p WmDwmColorizationColorChanged)) EventToken {
+	return me.Wm(co.WM_DWMCOLORIZATIONCOLORCHANGED, func(p Wm) uintptr {
 		fun(WmDwmColorizationColorChanged{Raw: p})
 		return me.defProcVal
 	})
@@ -602,8 +743,8 @@ func (me *EventsWindow) WmDwmColorizationColorChanged(fun func(p WmDwmColorizati
 // [WM_DWMCOMPOSITIONCHANGED] message handler.
 //
 // [WM_DWMCOMPOSITIONCHANGED]: https://learn.microsoft.com/en-us/windows/win32/dwm/wm-dwmcompositionchanged
-func (me *EventsWindow) WmDwmCompositionChanged(fun func()) {
-	me.Wm(co.WM_DWMCOMPOSITIONCHANGED, func(_ Wm) uintptr {
+func (me *EventsWindow) WmDwmCompositionChanged(fun func()) EventToken {
+	return me.Wm(co.WM_DWMCOMPOSITIONCHANGED, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -612,8 +753,8 @@ func (me *EventsWindow) WmDwmCompositionChanged(fun func()) {
 // [WM_DWMNCRENDERINGCHANGED] message handler.
 //
 // [WM_DWMNCRENDERINGCHANGED]: https://learn.microsoft.com/en-us/windows/win32/dwm/wm-dwmncrenderingchanged
-func (me *EventsWindow) WmDwmNcRenderingChanged(fun func(p WmDwmNcRenderingChanged)) {
-	me.Wm(co.WM_DWMNCRENDERINGCHANGED, func(p Wm) uintptr {
+func (me *EventsWindow) WmDwmNcRenderingChanged(fun func(p WmDwmNcRenderingChanged)) EventToken {
+	return me.Wm(co.WM_DWMNCRENDERINGCHANGED, func(p Wm) uintptr {
 		fun(WmDwmNcRenderingChanged{Raw: p})
 		return me.defProcVal
 	})
@@ -622,8 +763,8 @@ func (me *EventsWindow) WmDwmNcRenderingChanged(fun func(p WmDwmNcRenderingChang
 // [WM_DWMSENDICONICLIVEPREVIEWBITMAP] message handler.
 //
 // [WM_DWMSENDICONICLIVEPREVIEWBITMAP]: https://learn.microsoft.com/en-us/windows/win32/dwm/wm-dwmsendiconiclivepreviewbitmap
-func (me *EventsWindow) WmDwmSendIconicLivePreviewBitmap(fun func()) {
-	me.Wm(co.WM_DWMSENDICONICLIVEPREVIEWBITMAP, func(_ Wm) uintptr {
+func (me *EventsWindow) WmDwmSendIconicLivePreviewBitmap(fun func()) EventToken {
+	return me.Wm(co.WM_DWMSENDICONICLIVEPREVIEWBITMAP, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -632,8 +773,8 @@ func (me *EventsWindow) WmDwmSendIconicLivePreviewBitmap(fun func()) {
 // [WM_DWMSENDICONICTHUMBNAIL] message handler.
 //
 // [WM_DWMSENDICONICTHUMBNAIL]: https://learn.microsoft.com/en-us/windows/win32/dwm/wm-dwmsendiconicthumbnail
-func (me *EventsWindow) WmDwmSendIconicThumbnail(fun func(p WmDwmSendIconicThumbnail)) {
-	me.Wm(co.WM_DWMSENDICONICTHUMBNAIL, func(p Wm) uintptr {
+func (me *EventsWindow) WmDwmSendIconicThumbnail(fun func(p WmDwmSendIconicThumbnail)) EventToken {
+	return me.Wm(co.WM_DWMSENDICONICTHUMBNAIL, func(p Wm) uintptr {
 		fun(WmDwmSendIconicThumbnail{Raw: p})
 		return me.defProcVal
 	})
@@ -642,8 +783,8 @@ func (me *EventsWindow) WmDwmSendIconicThumbnail(fun func(p WmDwmSendIconicThumb
 // [WM_DWMWINDOWMAXIMIZEDCHANGE] message handler.
 //
 // [WM_DWMWINDOWMAXIMIZEDCHANGE]: https://learn.microsoft.com/en-us/windows/win32/dwm/wm-dwmwindowmaximizedchange
-func (me *EventsWindow) WmDwmWindowMaximizedChange(fun func(p WmDwmWindowMaximizedChange)) {
-	me.Wm(co.WM_DWMWINDOWMAXIMIZEDCHANGE, func(p Wm) uintptr {
+func (me *EventsWindow) WmDwmWindowMaximizedChange(fun func(p WmDwmWindowMaximizedChange)) EventToken {
+	return me.Wm(co.WM_DWMWINDOWMAXIMIZEDCHANGE, func(p Wm) uintptr {
 		fun(WmDwmWindowMaximizedChange{Raw: p})
 		return me.defProcVal
 	})
@@ -652,8 +793,8 @@ func (me *EventsWindow) WmDwmWindowMaximizedChange(fun func(p WmDwmWindowMaximiz
 // [WM_ENABLE] message handler.
 //
 // [WM_ENABLE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-enable
-func (me *EventsWindow) WmEnable(fun func(p WmEnable)) {
-	me.Wm(co.WM_ENABLE, func(p Wm) uintptr {
+func (me *EventsWindow) WmEnable(fun func(p WmEnable)) EventToken {
+	return me.Wm(co.WM_ENABLE, func(p Wm) uintptr {
 		fun(WmEnable{Raw: p})
 		return me.defProcVal
 	})
@@ -662,8 +803,8 @@ func (me *EventsWindow) WmEnable(fun func(p WmEnable)) {
 // [WM_ENDSESSION] message handler.
 //
 // [WM_ENDSESSION]: https://learn.microsoft.com/en-us/windows/win32/shutdown/wm-endsession
-func (me *EventsWindow) WmEndSession(fun func(p WmEndSession)) {
-	me.Wm(co.WM_ENDSESSION, func(p Wm) uintptr {
+func (me *EventsWindow) WmEndSession(fun func(p WmEndSession)) EventToken {
+	return me.Wm(co.WM_ENDSESSION, func(p Wm) uintptr {
 		fun(WmEndSession{Raw: p})
 		return me.defProcVal
 	})
@@ -672,8 +813,8 @@ func (me *EventsWindow) WmEndSession(fun func(p WmEndSession)) {
 // [WM_ENTERIDLE] message handler.
 //
 // [WM_ENTERIDLE]: https://learn.microsoft.com/en-us/windows/win32/dlgbox/wm-enteridle
-func (me *EventsWindow) WmEnterIdle(fun func(p WmEnterIdle)) {
-	me.Wm(co.WM_ENTERIDLE, func(p Wm) uintptr {
+func (me *EventsWindow) WmEnterIdle(fun func(p WmEnterIdle)) EventToken {
+	return me.Wm(co.WM_ENTERIDLE, func(p Wm) uintptr {
 		fun(WmEnterIdle{Raw: p})
 		return me.defProcVal
 	})
@@ -682,8 +823,8 @@ func (me *EventsWindow) WmEnterIdle(fun func(p WmEnterIdle)) {
 // [WM_ENTERMENULOOP] message handler.
 //
 // [WM_ENTERMENULOOP]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-entermenuloop
-func (me *EventsWindow) WmEnterMenuLoop(fun func(WmEnterMenuLoop)) {
-	me.Wm(co.WM_ENTERMENULOOP, func(p Wm) uintptr {
+func (me *EventsWindow) WmEnterMenuLoop(fun func(WmEnterMenuLoop)) EventToken {
+	return me.Wm(co.WM_ENTERMENULOOP, func(p Wm) uintptr {
 		fun(WmEnterMenuLoop{Raw: p})
 		return me.defProcVal
 	})
@@ -692,8 +833,8 @@ func (me *EventsWindow) WmEnterMenuLoop(fun func(WmEnterMenuLoop)) {
 // [WM_ENTERSIZEMOVE] message handler.
 //
 // [WM_ENTERSIZEMOVE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-entersizemove
-func (me *EventsWindow) WmEnterSizeMove(fun func()) {
-	me.Wm(co.WM_ENTERSIZEMOVE, func(_ Wm) uintptr {
+func (me *EventsWindow) WmEnterSizeMove(fun func()) EventToken {
+	return me.Wm(co.WM_ENTERSIZEMOVE, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -702,8 +843,8 @@ func (me *EventsWindow) WmEnterSizeMove(fun func()) {
 // [WM_ERASEBKGND] message handler.
 //
 // [WM_ERASEBKGND]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-erasebkgnd
-func (me *EventsWindow) WmEraseBkgnd(fun func(WmEraseBkgnd) int) {
-	me.Wm(co.WM_ERASEBKGND, func(p Wm) uintptr {
+func (me *EventsWindow) WmEraseBkgnd(fun func(WmEraseBkgnd) int) EventToken {
+	return me.Wm(co.WM_ERASEBKGND, func(p Wm) uintptr {
 		return uintptr(fun(WmEraseBkgnd{Raw: p}))
 	})
 }
@@ -711,8 +852,8 @@ func (me *EventsWindow) WmEraseBkgnd(fun func(WmEraseBkgnd) int) {
 // [WM_EXITMENULOOP] message handler.
 //
 // [WM_EXITMENULOOP]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-exitmenuloop
-func (me *EventsWindow) WmExitMenuLoop(fun func(WmExitMenuLoop)) {
-	me.Wm(co.WM_EXITMENULOOP, func(p Wm) uintptr {
+func (me *EventsWindow) WmExitMenuLoop(fun func(WmExitMenuLoop)) EventToken {
+	return me.Wm(co.WM_EXITMENULOOP, func(p Wm) uintptr {
 		fun(WmExitMenuLoop{Raw: p})
 		return me.defProcVal
 	})
@@ -721,8 +862,8 @@ func (me *EventsWindow) WmExitMenuLoop(fun func(WmExitMenuLoop)) {
 // [WM_EXITSIZEMOVE] message handler.
 //
 // [WM_EXITSIZEMOVE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-exitsizemove
-func (me *EventsWindow) WmExitSizeMove(fun func()) {
-	me.Wm(co.WM_EXITSIZEMOVE, func(_ Wm) uintptr {
+func (me *EventsWindow) WmExitSizeMove(fun func()) EventToken {
+	return me.Wm(co.WM_EXITSIZEMOVE, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -731,8 +872,8 @@ func (me *EventsWindow) WmExitSizeMove(fun func()) {
 // [WM_FONTCHANGE] message handler.
 //
 // [WM_FONTCHANGE]: https://learn.microsoft.com/en-us/windows/win32/gdi/wm-fontchange
-func (me *EventsWindow) WmFontChange(fun func()) {
-	me.Wm(co.WM_FONTCHANGE, func(p Wm) uintptr {
+func (me *EventsWindow) WmFontChange(fun func()) EventToken {
+	return me.Wm(co.WM_FONTCHANGE, func(p Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -741,8 +882,8 @@ func (me *EventsWindow) WmFontChange(fun func()) {
 // [WM_GETDLGCODE] message handler.
 //
 // [WM_GETDLGCODE]: https://learn.microsoft.com/en-us/windows/win32/dlgbox/wm-getdlgcode
-func (me *EventsWindow) WmGetDlgCode(fun func(p WmGetDlgCode) co.DLGC) {
-	me.Wm(co.WM_GETDLGCODE, func(p Wm) uintptr {
+func (me *EventsWindow) WmGetDlgCode(fun func(p WmGetDlgCode) co.DLGC) EventToken {
+	return me.Wm(co.WM_GETDLGCODE, func(p Wm) uintptr {
 		return uintptr(fun(WmGetDlgCode{Raw: p}))
 	})
 }
@@ -750,8 +891,8 @@ func (me *EventsWindow) WmGetDlgCode(fun func(p WmGetDlgCode) co.DLGC) {
 // [WM_GETFONT] message handler.
 //
 // [WM_GETFONT]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-getfont
-func (me *EventsWindow) WmGetFont(fun func() win.HFONT) {
-	me.Wm(co.WM_GETFONT, func(_ Wm) uintptr {
+func (me *EventsWindow) WmGetFont(fun func() win.HFONT) EventToken {
+	return me.Wm(co.WM_GETFONT, func(_ Wm) uintptr {
 		return uintptr(fun())
 	})
 }
@@ -759,8 +900,8 @@ func (me *EventsWindow) WmGetFont(fun func() win.HFONT) {
 // [MN_GETHMENU] message handler.
 //
 // [MN_GETHMENU]: https://learn.microsoft.com/en-us/windows/win32/winmsg/mn-gethmenu
-func (me *EventsWindow) WmGetHMenu(fun func() win.HMENU) {
-	me.Wm(co.WM_MN_GETHMENU, func(_ Wm) uintptr {
+func (me *EventsWindow) WmGetHMenu(fun func() win.HMENU) EventToken {
+	return me.Wm(co.WM_MN_GETHMENU, func(_ Wm) uintptr {
 		return uintptr(fun())
 	})
 }
@@ -768,8 +909,8 @@ func (me *EventsWindow) WmGetHMenu(fun func() win.HMENU) {
 // [WM_GETICON] message handler.
 //
 // [WM_GETICON]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-geticon
-func (me *EventsWindow) WmGetIcon(fun func(p WmGetIcon) win.HICON) {
-	me.Wm(co.WM_GETICON, func(p Wm) uintptr {
+func (me *EventsWindow) WmGetIcon(fun func(p WmGetIcon) win.HICON) EventToken {
+	return me.Wm(co.WM_GETICON, func(p Wm) uintptr {
 		return uintptr(fun(WmGetIcon{Raw: p}))
 	})
 }
@@ -777,8 +918,8 @@ func (me *EventsWindow) WmGetIcon(fun func(p WmGetIcon) win.HICON) {
 // [WM_GETMINMAXINFO] message handler.
 //
 // [WM_GETMINMAXINFO]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-getminmaxinfo
-func (me *EventsWindow) WmGetMinMaxInfo(fun func(p WmGetMinMaxInfo)) {
-	me.Wm(co.WM_GETMINMAXINFO, func(p Wm) uintptr {
+func (me *EventsWindow) WmGetMinMaxInfo(fun func(p WmGetMinMaxInfo)) EventToken {
+	return me.Wm(co.WM_GETMINMAXINFO, func(p Wm) uintptr {
 		fun(WmGetMinMaxInfo{Raw: p})
 		return me.defProcVal
 	})
@@ -787,8 +928,8 @@ func (me *EventsWindow) WmGetMinMaxInfo(fun func(p WmGetMinMaxInfo)) {
 // [WM_GETTEXT] message handler.
 //
 // [WM_GETTEXT]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-gettext
-func (me *EventsWindow) WmGetText(fun func(p WmGetText) uint) {
-	me.Wm(co.WM_GETTEXT, func(p Wm) uintptr {
+func (me *EventsWindow) WmGetText(fun func(p WmGetText) uint) EventToken {
+	return me.Wm(co.WM_GETTEXT, func(p Wm) uintptr {
 		return uintptr(fun(WmGetText{Raw: p}))
 	})
 }
@@ -796,8 +937,8 @@ func (me *EventsWindow) WmGetText(fun func(p WmGetText) uint) {
 // [WM_GETTEXTLENGTH] message handler.
 //
 // [WM_GETTEXTLENGTH]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-gettextlength
-func (me *EventsWindow) WmGetTextLength(fun func() uint) {
-	me.Wm(co.WM_GETTEXTLENGTH, func(p Wm) uintptr {
+func (me *EventsWindow) WmGetTextLength(fun func() uint) EventToken {
+	return me.Wm(co.WM_GETTEXTLENGTH, func(p Wm) uintptr {
 		return uintptr(fun())
 	})
 }
@@ -805,8 +946,8 @@ func (me *EventsWindow) WmGetTextLength(fun func() uint) {
 // [WM_GETTITLEBARINFOEX] message handler.
 //
 // [WM_GETTITLEBARINFOEX]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-gettitlebarinfoex
-func (me *EventsWindow) WmGetTitleBarInfoEx(fun func(p WmGetTitleBarInfoEx)) {
-	me.Wm(co.WM_GETTITLEBARINFOEX, func(p Wm) uintptr {
+func (me *EventsWindow) WmGetTitleBarInfoEx(fun func(p WmGetTitleBarInfoEx)) EventToken {
+	return me.Wm(co.WM_GETTITLEBARINFOEX, func(p Wm) uintptr {
 		fun(WmGetTitleBarInfoEx{Raw: p})
 		return me.defProcVal
 	})
@@ -815,8 +956,8 @@ func (me *EventsWindow) WmGetTitleBarInfoEx(fun func(p WmGetTitleBarInfoEx)) {
 // [WM_HELP] message handler.
 //
 // [WM_HELP]: https://learn.microsoft.com/en-us/windows/win32/shell/wm-help
-func (me *EventsWindow) WmHelp(fun func(p WmHelp)) {
-	me.Wm(co.WM_HELP, func(p Wm) uintptr {
+func (me *EventsWindow) WmHelp(fun func(p WmHelp)) EventToken {
+	return me.Wm(co.WM_HELP, func(p Wm) uintptr {
 		fun(WmHelp{Raw: p})
 		return 1
 	})
@@ -825,8 +966,8 @@ func (me *EventsWindow) WmHelp(fun func(p WmHelp)) {
 // [WM_HOTKEY] message handler.
 //
 // [WM_HOTKEY]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-hotkey
-func (me *EventsWindow) WmHotKey(fun func(p WmHotKey)) {
-	me.Wm(co.WM_HOTKEY, func(p Wm) uintptr {
+func (me *EventsWindow) WmHotKey(fun func(p WmHotKey)) EventToken {
+	return me.Wm(co.WM_HOTKEY, func(p Wm) uintptr {
 		fun(WmHotKey{Raw: p})
 		return me.defProcVal
 	})
@@ -835,8 +976,8 @@ func (me *EventsWindow) WmHotKey(fun func(p WmHotKey)) {
 // [WM_HSCROLL] message handler.
 //
 // [WM_HSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-hscroll
-func (me *EventsWindow) WmHScroll(fun func(p WmScroll)) {
-	me.Wm(co.WM_HSCROLL, func(p Wm) uintptr {
+func (me *EventsWindow) WmHScroll(fun func(p WmScroll)) EventToken {
+	return me.Wm(co.WM_HSCROLL, func(p Wm) uintptr {
 		fun(WmScroll{Raw: p})
 		return me.defProcVal
 	})
@@ -845,8 +986,8 @@ func (me *EventsWindow) WmHScroll(fun func(p WmScroll)) {
 // [WM_HSCROLLCLIPBOARD] message handler.
 //
 // [WM_HSCROLLCLIPBOARD]: https://learn.microsoft.com/en-us/windows/win32/dataxchg/wm-hscrollclipboard
-func (me *EventsWindow) WmHScrollClipboard(fun func(p WmScrollClipboard)) {
-	me.Wm(co.WM_HSCROLLCLIPBOARD, func(p Wm) uintptr {
+func (me *EventsWindow) WmHScrollClipboard(fun func(p WmScrollClipboard)) EventToken {
+	return me.Wm(co.WM_HSCROLLCLIPBOARD, func(p Wm) uintptr {
 		fun(WmScrollClipboard{Raw: p})
 		return me.defProcVal
 	})
@@ -855,8 +996,8 @@ func (me *EventsWindow) WmHScrollClipboard(fun func(p WmScrollClipboard)) {
 // [WM_INITMENUPOPUP] message handler.
 //
 // [WM_INITMENUPOPUP]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-initmenupopup
-func (me *EventsWindow) WmInitMenuPopup(fun func(p WmInitMenuPopup)) {
-	me.Wm(co.WM_INITMENUPOPUP, func(p Wm) uintptr {
+func (me *EventsWindow) WmInitMenuPopup(fun func(p WmInitMenuPopup)) EventToken {
+	return me.Wm(co.WM_INITMENUPOPUP, func(p Wm) uintptr {
 		fun(WmInitMenuPopup{Raw: p})
 		return me.defProcVal
 	})
@@ -865,8 +1006,8 @@ func (me *EventsWindow) WmInitMenuPopup(fun func(p WmInitMenuPopup)) {
 // [WM_KEYDOWN] message handler.
 //
 // [WM_KEYDOWN]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-keydown
-func (me *EventsWindow) WmKeyDown(fun func(p WmKey)) {
-	me.Wm(co.WM_KEYDOWN, func(p Wm) uintptr {
+func (me *EventsWindow) WmKeyDown(fun func(p WmKey)) EventToken {
+	return me.Wm(co.WM_KEYDOWN, func(p Wm) uintptr {
 		fun(WmKey{Raw: p})
 		return me.defProcVal
 	})
@@ -875,8 +1016,8 @@ func (me *EventsWindow) WmKeyDown(fun func(p WmKey)) {
 // [WM_KEYUP] message handler.
 //
 // [WM_KEYUP]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-keyup
-func (me *EventsWindow) WmKeyUp(fun func(p WmKey)) {
-	me.Wm(co.WM_KEYUP, func(p Wm) uintptr {
+func (me *EventsWindow) WmKeyUp(fun func(p WmKey)) EventToken {
+	return me.Wm(co.WM_KEYUP, func(p Wm) uintptr {
 		fun(WmKey{Raw: p})
 		return me.defProcVal
 	})
@@ -885,8 +1026,8 @@ func (me *EventsWindow) WmKeyUp(fun func(p WmKey)) {
 // [WM_KILLFOCUS] message handler.
 //
 // [WM_KILLFOCUS]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-killfocus
-func (me *EventsWindow) WmKillFocus(fun func(p WmKillFocus)) {
-	me.Wm(co.WM_KILLFOCUS, func(p Wm) uintptr {
+func (me *EventsWindow) WmKillFocus(fun func(p WmKillFocus)) EventToken {
+	return me.Wm(co.WM_KILLFOCUS, func(p Wm) uintptr {
 		fun(WmKillFocus{Raw: p})
 		return me.defProcVal
 	})
@@ -895,8 +1036,8 @@ func (me *EventsWindow) WmKillFocus(fun func(p WmKillFocus)) {
 // [WM_LBUTTONDBLCLK] message handler.
 //
 // [WM_LBUTTONDBLCLK]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-lbuttondblclk
-func (me *EventsWindow) WmLButtonDblClk(fun func(p WmMouse)) {
-	me.Wm(co.WM_LBUTTONDBLCLK, func(p Wm) uintptr {
+func (me *EventsWindow) WmLButtonDblClk(fun func(p WmMouse)) EventToken {
+	return me.Wm(co.WM_LBUTTONDBLCLK, func(p Wm) uintptr {
 		fun(WmMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -905,8 +1046,8 @@ func (me *EventsWindow) WmLButtonDblClk(fun func(p WmMouse)) {
 // [WM_LBUTTONDOWN] message handler.
 //
 // [WM_LBUTTONDOWN]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-lbuttondown
-func (me *EventsWindow) WmLButtonDown(fun func(p WmMouse)) {
-	me.Wm(co.WM_LBUTTONDOWN, func(p Wm) uintptr {
+func (me *EventsWindow) WmLButtonDown(fun func(p WmMouse)) EventToken {
+	return me.Wm(co.WM_LBUTTONDOWN, func(p Wm) uintptr {
 		fun(WmMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -915,8 +1056,8 @@ func (me *EventsWindow) WmLButtonDown(fun func(p WmMouse)) {
 // [WM_LBUTTONUP] message handler.
 //
 // [WM_LBUTTONUP]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-lbuttonup
-func (me *EventsWindow) WmLButtonUp(fun func(p WmMouse)) {
-	me.Wm(co.WM_LBUTTONUP, func(p Wm) uintptr {
+func (me *EventsWindow) WmLButtonUp(fun func(p WmMouse)) EventToken {
+	return me.Wm(co.WM_LBUTTONUP, func(p Wm) uintptr {
 		fun(WmMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -925,8 +1066,8 @@ func (me *EventsWindow) WmLButtonUp(fun func(p WmMouse)) {
 // [WM_MBUTTONDBLCLK] message handler.
 //
 // [WM_MBUTTONDBLCLK]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-mbuttondblclk
-func (me *EventsWindow) WmMButtonDblClk(fun func(p WmMouse)) {
-	me.Wm(co.WM_MBUTTONDBLCLK, func(p Wm) uintptr {
+func (me *EventsWindow) WmMButtonDblClk(fun func(p WmMouse)) EventToken {
+	return me.Wm(co.WM_MBUTTONDBLCLK, func(p Wm) uintptr {
 		fun(WmMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -935,8 +1076,8 @@ func (me *EventsWindow) WmMButtonDblClk(fun func(p WmMouse)) {
 // [WM_MBUTTONDOWN] message handler.
 //
 // [WM_MBUTTONDOWN]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-mbuttondown
-func (me *EventsWindow) WmMButtonDown(fun func(p WmMouse)) {
-	me.Wm(co.WM_MBUTTONDOWN, func(p Wm) uintptr {
+func (me *EventsWindow) WmMButtonDown(fun func(p WmMouse)) EventToken {
+	return me.Wm(co.WM_MBUTTONDOWN, func(p Wm) uintptr {
 		fun(WmMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -945,18 +1086,28 @@ func (me *EventsWindow) WmMButtonDown(fun func(p WmMouse)) {
 // [WM_MBUTTONUP] message handler.
 //
 // [WM_MBUTTONUP]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-mbuttonup
-func (me *EventsWindow) WmMButtonUp(fun func(p WmMouse)) {
-	me.Wm(co.WM_MBUTTONUP, func(p Wm) uintptr {
+func (me *EventsWindow) WmMButtonUp(fun func(p WmMouse)) EventToken {
+	return me.Wm(co.WM_MBUTTONUP, func(p Wm) uintptr {
 		fun(WmMouse{Raw: p})
 		return me.defProcVal
 	})
 }
 
+// [WM_MEASUREITEM] message handler.
+//
+// [WM_MEASUREITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-measureitem
+func (me *EventsWindow) WmMeasureItem(fun func(p WmMeasureItem)) EventToken {
+	return me.Wm(co.WM_MEASUREITEM, func(p Wm) uintptr {
+		fun(WmMeasureItem{Raw: p})
+		return 1
+	})
+}
+
 // [WM_MENUCHAR] message handler.
 //
 // [WM_MENUCHAR]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-menuchar
-func (me *EventsWindow) WmMenuChar(fun func(p WmMenuChar) co.MNC) {
-	me.Wm(co.WM_MENUCHAR, func(p Wm) uintptr {
+func (me *EventsWindow) WmMenuChar(fun func(p WmMenuChar) co.MNC) EventToken {
+	return me.Wm(co.WM_MENUCHAR, func(p Wm) uintptr {
 		return uintptr(fun(WmMenuChar{Raw: p}))
 	})
 }
@@ -964,8 +1115,8 @@ func (me *EventsWindow) WmMenuChar(fun func(p WmMenuChar) co.MNC) {
 // [WM_MENUCOMMAND] message handler.
 //
 // [WM_MENUCOMMAND]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-menucommand
-func (me *EventsWindow) WmMenuCommand(fun func(p WmMenu)) {
-	me.Wm(co.WM_MENUCOMMAND, func(p Wm) uintptr {
+func (me *EventsWindow) WmMenuCommand(fun func(p WmMenu)) EventToken {
+	return me.Wm(co.WM_MENUCOMMAND, func(p Wm) uintptr {
 		fun(WmMenu{Raw: p})
 		return me.defProcVal
 	})
@@ -974,8 +1125,8 @@ func (me *EventsWindow) WmMenuCommand(fun func(p WmMenu)) {
 // [WM_MENUDRAG] message handler.
 //
 // [WM_MENUDRAG]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-menudrag
-func (me *EventsWindow) WmMenuDrag(fun func(p WmMenu) co.MND) {
-	me.Wm(co.WM_MENUDRAG, func(p Wm) uintptr {
+func (me *EventsWindow) WmMenuDrag(fun func(p WmMenu) co.MND) EventToken {
+	return me.Wm(co.WM_MENUDRAG, func(p Wm) uintptr {
 		return uintptr(fun(WmMenu{Raw: p}))
 	})
 }
@@ -983,8 +1134,8 @@ func (me *EventsWindow) WmMenuDrag(fun func(p WmMenu) co.MND) {
 // [WM_MENUGETOBJECT] message handler.
 //
 // [WM_MENUGETOBJECT]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-menugetobject
-func (me *EventsWindow) WmMenuGetObject(fun func(p WmMenuGetObject) co.MNGO) {
-	me.Wm(co.WM_MENUGETOBJECT, func(p Wm) uintptr {
+func (me *EventsWindow) WmMenuGetObject(fun func(p WmMenuGetObject) co.MNGO) EventToken {
+	return me.Wm(co.WM_MENUGETOBJECT, func(p Wm) uintptr {
 		return uintptr(fun(WmMenuGetObject{Raw: p}))
 	})
 }
@@ -992,8 +1143,8 @@ func (me *EventsWindow) WmMenuGetObject(fun func(p WmMenuGetObject) co.MNGO) {
 // [WM_MENURBUTTONUP] message handler.
 //
 // [WM_MENURBUTTONUP]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-menurbuttonup
-func (me *EventsWindow) WmMenuRButtonUp(fun func(p WmMenu)) {
-	me.Wm(co.WM_MENURBUTTONUP, func(p Wm) uintptr {
+func (me *EventsWindow) WmMenuRButtonUp(fun func(p WmMenu)) EventToken {
+	return me.Wm(co.WM_MENURBUTTONUP, func(p Wm) uintptr {
 		fun(WmMenu{Raw: p})
 		return me.defProcVal
 	})
@@ -1002,8 +1153,8 @@ func (me *EventsWindow) WmMenuRButtonUp(fun func(p WmMenu)) {
 // [WM_MENUSELECT] message handler.
 //
 // [WM_MENUSELECT]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-menuselect
-func (me *EventsWindow) WmMenuSelect(fun func(p WmMenuSelect)) {
-	me.Wm(co.WM_MENUSELECT, func(p Wm) uintptr {
+func (me *EventsWindow) WmMenuSelect(fun func(p WmMenuSelect)) EventToken {
+	return me.Wm(co.WM_MENUSELECT, func(p Wm) uintptr {
 		fun(WmMenuSelect{Raw: p})
 		return me.defProcVal
 	})
@@ -1012,8 +1163,8 @@ func (me *EventsWindow) WmMenuSelect(fun func(p WmMenuSelect)) {
 // [WM_MOUSEHOVER] message handler.
 //
 // [WM_MOUSEHOVER]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-mousehover
-func (me *EventsWindow) WmMouseHover(fun func(p WmMouse)) {
-	me.Wm(co.WM_MOUSEHOVER, func(p Wm) uintptr {
+func (me *EventsWindow) WmMouseHover(fun func(p WmMouse)) EventToken {
+	return me.Wm(co.WM_MOUSEHOVER, func(p Wm) uintptr {
 		fun(WmMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -1022,8 +1173,8 @@ func (me *EventsWindow) WmMouseHover(fun func(p WmMouse)) {
 // [WM_MOUSELEAVE] message handler.
 //
 // [WM_MOUSELEAVE]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-mouseleave
-func (me *EventsWindow) WmMouseLeave(fun func()) {
-	me.Wm(co.WM_MOUSELEAVE, func(_ Wm) uintptr {
+func (me *EventsWindow) WmMouseLeave(fun func()) EventToken {
+	return me.Wm(co.WM_MOUSELEAVE, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -1032,8 +1183,8 @@ func (me *EventsWindow) WmMouseLeave(fun func()) {
 // [WM_MOUSEMOVE] message handler.
 //
 // [WM_MOUSEMOVE]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-mousemove
-func (me *EventsWindow) WmMouseMove(fun func(p WmMouse)) {
-	me.Wm(co.WM_MOUSEMOVE, func(p Wm) uintptr {
+func (me *EventsWindow) WmMouseMove(fun func(p WmMouse)) EventToken {
+	return me.Wm(co.WM_MOUSEMOVE, func(p Wm) uintptr {
 		fun(WmMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -1042,8 +1193,8 @@ func (me *EventsWindow) WmMouseMove(fun func(p WmMouse)) {
 // [WM_MOVE] message handler.
 //
 // [WM_MOVE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-move
-func (me *EventsWindow) WmMove(fun func(p WmMove)) {
-	me.Wm(co.WM_MOVE, func(p Wm) uintptr {
+func (me *EventsWindow) WmMove(fun func(p WmMove)) EventToken {
+	return me.Wm(co.WM_MOVE, func(p Wm) uintptr {
 		fun(WmMove{Raw: p})
 		return me.defProcVal
 	})
@@ -1052,8 +1203,8 @@ func (me *EventsWindow) WmMove(fun func(p WmMove)) {
 // [WM_MOVING] message handler.
 //
 // [WM_MOVING]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-moving
-func (me *EventsWindow) WmMoving(fun func(p WmMoving)) {
-	me.Wm(co.WM_MOVING, func(p Wm) uintptr {
+func (me *EventsWindow) WmMoving(fun func(p WmMoving)) EventToken {
+	return me.Wm(co.WM_MOVING, func(p Wm) uintptr {
 		fun(WmMoving{Raw: p})
 		return 1
 	})
@@ -1062,8 +1213,8 @@ func (me *EventsWindow) WmMoving(fun func(p WmMoving)) {
 // [WM_NCACTIVATE] message handler.
 //
 // [WM_NCACTIVATE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-ncactivate
-func (me *EventsWindow) WmNcActivate(fun func(p WmNcActivate) bool) {
-	me.Wm(co.WM_NCACTIVATE, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcActivate(fun func(p WmNcActivate) bool) EventToken {
+	return me.Wm(co.WM_NCACTIVATE, func(p Wm) uintptr {
 		return utl.BoolToUintptr(fun(WmNcActivate{Raw: p}))
 	})
 }
@@ -1071,8 +1222,8 @@ func (me *EventsWindow) WmNcActivate(fun func(p WmNcActivate) bool) {
 // [WM_NCCALCSIZE] message handler.
 //
 // [WM_NCCALCSIZE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-nccalcsize
-func (me *EventsWindow) WmNcCalcSize(fun func(p WmNcCalcSize) co.WVR) {
-	me.Wm(co.WM_NCCALCSIZE, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcCalcSize(fun func(p WmNcCalcSize) co.WVR) EventToken {
+	return me.Wm(co.WM_NCCALCSIZE, func(p Wm) uintptr {
 		return uintptr(fun(WmNcCalcSize{Raw: p}))
 	})
 }
@@ -1080,8 +1231,8 @@ func (me *EventsWindow) WmNcCalcSize(fun func(p WmNcCalcSize) co.WVR) {
 // [WM_NCCREATE] message handler.
 //
 // [WM_NCCREATE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-nccreate
-func (me *EventsWindow) WmNcCreate(fun func(p WmCreate) bool) {
-	me.Wm(co.WM_NCCREATE, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcCreate(fun func(p WmCreate) bool) EventToken {
+	return me.Wm(co.WM_NCCREATE, func(p Wm) uintptr {
 		return utl.BoolToUintptr(fun(WmCreate{Raw: p}))
 	})
 }
@@ -1093,8 +1244,8 @@ func (me *EventsWindow) WmNcCreate(fun func(p WmCreate) bool) {
 //
 // [WM_NCDESTROY]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-ncdestroy
 // [PostQuitMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-postquitmessage
-func (me *EventsWindow) WmNcDestroy(fun func()) {
-	me.Wm(co.WM_NCDESTROY, func(_ Wm) uintptr {
+func (me *EventsWindow) WmNcDestroy(fun func()) EventToken {
+	return me.Wm(co.WM_NCDESTROY, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -1103,8 +1254,8 @@ func (me *EventsWindow) WmNcDestroy(fun func()) {
 // [WM_NCHITTEST] message handler.
 //
 // [WM_NCHITTEST]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-nchittest
-func (me *EventsWindow) WmNcHitTest(fun func(WmNcHitTest) co.HT) {
-	me.Wm(co.WM_NCHITTEST, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcHitTest(fun func(WmNcHitTest) co.HT) EventToken {
+	return me.Wm(co.WM_NCHITTEST, func(p Wm) uintptr {
 		return uintptr(fun(WmNcHitTest{Raw: p}))
 	})
 }
@@ -1112,8 +1263,8 @@ func (me *EventsWindow) WmNcHitTest(fun func(WmNcHitTest) co.HT) {
 // [WM_NCLBUTTONDBLCLK] message handler.
 //
 // [WM_NCLBUTTONDBLCLK]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-nclbuttondblclk
-func (me *EventsWindow) WmNcLButtonDblClk(fun func(p WmNcMouse)) {
-	me.Wm(co.WM_NCLBUTTONDBLCLK, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcLButtonDblClk(fun func(p WmNcMouse)) EventToken {
+	return me.Wm(co.WM_NCLBUTTONDBLCLK, func(p Wm) uintptr {
 		fun(WmNcMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -1122,8 +1273,8 @@ func (me *EventsWindow) WmNcLButtonDblClk(fun func(p WmNcMouse)) {
 // [WM_NCLBUTTONDOWN] message handler.
 //
 // [WM_NCLBUTTONDOWN]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-nclbuttondown
-func (me *EventsWindow) WmNcLButtonDown(fun func(p WmNcMouse)) {
-	me.Wm(co.WM_NCLBUTTONDOWN, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcLButtonDown(fun func(p WmNcMouse)) EventToken {
+	return me.Wm(co.WM_NCLBUTTONDOWN, func(p Wm) uintptr {
 		fun(WmNcMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -1132,8 +1283,8 @@ func (me *EventsWindow) WmNcLButtonDown(fun func(p WmNcMouse)) {
 // [WM_NCLBUTTONUP] message handler.
 //
 // [WM_NCLBUTTONUP]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-nclbuttonup
-func (me *EventsWindow) WmNcLButtonUp(fun func(p WmNcMouse)) {
-	me.Wm(co.WM_NCLBUTTONUP, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcLButtonUp(fun func(p WmNcMouse)) EventToken {
+	return me.Wm(co.WM_NCLBUTTONUP, func(p Wm) uintptr {
 		fun(WmNcMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -1142,8 +1293,8 @@ func (me *EventsWindow) WmNcLButtonUp(fun func(p WmNcMouse)) {
 // [WM_NCMBUTTONDBLCLK] message handler.
 //
 // [WM_NCMBUTTONDBLCLK]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-ncmbuttondblclk
-func (me *EventsWindow) WmNcMButtonDblClk(fun func(p WmNcMouse)) {
-	me.Wm(co.WM_NCMBUTTONDBLCLK, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcMButtonDblClk(fun func(p WmNcMouse)) EventToken {
+	return me.Wm(co.WM_NCMBUTTONDBLCLK, func(p Wm) uintptr {
 		fun(WmNcMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -1152,8 +1303,8 @@ func (me *EventsWindow) WmNcMButtonDblClk(fun func(p WmNcMouse)) {
 // [WM_NCMBUTTONDOWN] message handler.
 //
 // [WM_NCMBUTTONDOWN]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-ncmbuttondown
-func (me *EventsWindow) WmNcMButtonDown(fun func(p WmNcMouse)) {
-	me.Wm(co.WM_NCMBUTTONDOWN, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcMButtonDown(fun func(p WmNcMouse)) EventToken {
+	return me.Wm(co.WM_NCMBUTTONDOWN, func(p Wm) uintptr {
 		fun(WmNcMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -1162,8 +1313,8 @@ func (me *EventsWindow) WmNcMButtonDown(fun func(p WmNcMouse)) {
 // [WM_NCMBUTTONUP] message handler.
 //
 // [WM_NCMBUTTONUP]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-ncmbuttonup
-func (me *EventsWindow) WmNcMButtonUp(fun func(p WmNcMouse)) {
-	me.Wm(co.WM_NCMBUTTONUP, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcMButtonUp(fun func(p WmNcMouse)) EventToken {
+	return me.Wm(co.WM_NCMBUTTONUP, func(p Wm) uintptr {
 		fun(WmNcMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -1172,8 +1323,8 @@ func (me *EventsWindow) WmNcMButtonUp(fun func(p WmNcMouse)) {
 // [WM_NCMOUSEHOVER] message handler.
 //
 // [WM_NCMOUSEHOVER]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-ncmousehover
-func (me *EventsWindow) WmNcMouseHover(fun func(p WmNcMouse)) {
-	me.Wm(co.WM_NCMOUSEHOVER, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcMouseHover(fun func(p WmNcMouse)) EventToken {
+	return me.Wm(co.WM_NCMOUSEHOVER, func(p Wm) uintptr {
 		fun(WmNcMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -1182,8 +1333,8 @@ func (me *EventsWindow) WmNcMouseHover(fun func(p WmNcMouse)) {
 // [WM_NCMOUSELEAVE] message handler.
 //
 // [WM_NCMOUSELEAVE]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-ncmouseleave
-func (me *EventsWindow) WmNcMouseLeave(fun func()) {
-	me.Wm(co.WM_NCMOUSELEAVE, func(_ Wm) uintptr {
+func (me *EventsWindow) WmNcMouseLeave(fun func()) EventToken {
+	return me.Wm(co.WM_NCMOUSELEAVE, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -1192,8 +1343,8 @@ func (me *EventsWindow) WmNcMouseLeave(fun func()) {
 // [WM_NCMOUSEMOVE] message handler.
 //
 // [WM_NCMOUSEMOVE]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-ncmousemove
-func (me *EventsWindow) WmNcMouseMove(fun func(p WmNcMouse)) {
-	me.Wm(co.WM_NCMOUSEMOVE, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcMouseMove(fun func(p WmNcMouse)) EventToken {
+	return me.Wm(co.WM_NCMOUSEMOVE, func(p Wm) uintptr {
 		fun(WmNcMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -1202,8 +1353,8 @@ func (me *EventsWindow) WmNcMouseMove(fun func(p WmNcMouse)) {
 // [WM_NCPAINT] message handler.
 //
 // [WM_NCPAINT]: https://learn.microsoft.com/en-us/windows/win32/gdi/wm-ncpaint
-func (me *EventsWindow) WmNcPaint(fun func(p WmNcPaint)) {
-	me.Wm(co.WM_NCPAINT, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcPaint(fun func(p WmNcPaint)) EventToken {
+	return me.Wm(co.WM_NCPAINT, func(p Wm) uintptr {
 		fun(WmNcPaint{Raw: p})
 		return me.defProcVal
 	})
@@ -1212,8 +1363,8 @@ func (me *EventsWindow) WmNcPaint(fun func(p WmNcPaint)) {
 // [WM_NCRBUTTONDBLCLK] message handler.
 //
 // [WM_NCRBUTTONDBLCLK]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-ncrbuttondblclk
-func (me *EventsWindow) WmNcRButtonDblClk(fun func(p WmNcMouse)) {
-	me.Wm(co.WM_NCRBUTTONDBLCLK, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcRButtonDblClk(fun func(p WmNcMouse)) EventToken {
+	return me.Wm(co.WM_NCRBUTTONDBLCLK, func(p Wm) uintptr {
 		fun(WmNcMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -1222,8 +1373,8 @@ func (me *EventsWindow) WmNcRButtonDblClk(fun func(p WmNcMouse)) {
 // [WM_NCRBUTTONDOWN] message handler.
 //
 // [WM_NCRBUTTONDOWN]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-ncrbuttondown
-func (me *EventsWindow) WmNcRButtonDown(fun func(p WmNcMouse)) {
-	me.Wm(co.WM_NCRBUTTONDOWN, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcRButtonDown(fun func(p WmNcMouse)) EventToken {
+	return me.Wm(co.WM_NCRBUTTONDOWN, func(p Wm) uintptr {
 		fun(WmNcMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -1232,8 +1383,8 @@ func (me *EventsWindow) WmNcRButtonDown(fun func(p WmNcMouse)) {
 // [WM_NCRBUTTONUP] message handler.
 //
 // [WM_NCRBUTTONUP]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-ncrbuttonup
-func (me *EventsWindow) WmNcRButtonUp(fun func(p WmNcMouse)) {
-	me.Wm(co.WM_NCRBUTTONUP, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcRButtonUp(fun func(p WmNcMouse)) EventToken {
+	return me.Wm(co.WM_NCRBUTTONUP, func(p Wm) uintptr {
 		fun(WmNcMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -1242,8 +1393,8 @@ func (me *EventsWindow) WmNcRButtonUp(fun func(p WmNcMouse)) {
 // [WM_NCXBUTTONDBLCLK] message handler.
 //
 // [WM_NCXBUTTONDBLCLK]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-ncxbuttondblclk
-func (me *EventsWindow) WmNcXButtonDblClk(fun func(p WmNcMouseX)) {
-	me.Wm(co.WM_NCXBUTTONDBLCLK, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcXButtonDblClk(fun func(p WmNcMouseX)) EventToken {
+	return me.Wm(co.WM_NCXBUTTONDBLCLK, func(p Wm) uintptr {
 		fun(WmNcMouseX{Raw: p})
 		return 1
 	})
@@ -1252,8 +1403,8 @@ func (me *EventsWindow) WmNcXButtonDblClk(fun func(p WmNcMouseX)) {
 // [WM_NCXBUTTONDOWN] message handler.
 //
 // [WM_NCXBUTTONDOWN]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-ncxbuttondown
-func (me *EventsWindow) WmNcXButtonDown(fun func(p WmNcMouseX)) {
-	me.Wm(co.WM_NCXBUTTONDOWN, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcXButtonDown(fun func(p WmNcMouseX)) EventToken {
+	return me.Wm(co.WM_NCXBUTTONDOWN, func(p Wm) uintptr {
 		fun(WmNcMouseX{Raw: p})
 		return 1
 	})
@@ -1262,8 +1413,8 @@ func (me *EventsWindow) WmNcXButtonDown(fun func(p WmNcMouseX)) {
 // [WM_NCXBUTTONUP] message handler.
 //
 // [WM_NCXBUTTONUP]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-ncxbuttonup\
-func (me *EventsWindow) WmNcXButtonUp(fun func(p WmNcMouseX)) {
-	me.Wm(co.WM_NCXBUTTONUP, func(p Wm) uintptr {
+func (me *EventsWindow) WmNcXButtonUp(fun func(p WmNcMouseX)) EventToken {
+	return me.Wm(co.WM_NCXBUTTONUP, func(p Wm) uintptr {
 		fun(WmNcMouseX{Raw: p})
 		return 1
 	})
@@ -1272,8 +1423,8 @@ func (me *EventsWindow) WmNcXButtonUp(fun func(p WmNcMouseX)) {
 // [WM_NEXTDLGCTL] message handler.
 //
 // [WM_NEXTDLGCTL]: https://learn.microsoft.com/en-us/windows/win32/dlgbox/wm-nextdlgctl
-func (me *EventsWindow) WmNextDlgCtl(fun func(p WmNextDlgCtl)) {
-	me.Wm(co.WM_NEXTDLGCTL, func(p Wm) uintptr {
+func (me *EventsWindow) WmNextDlgCtl(fun func(p WmNextDlgCtl)) EventToken {
+	return me.Wm(co.WM_NEXTDLGCTL, func(p Wm) uintptr {
 		fun(WmNextDlgCtl{Raw: p})
 		return me.defProcVal
 	})
@@ -1282,8 +1433,8 @@ func (me *EventsWindow) WmNextDlgCtl(fun func(p WmNextDlgCtl)) {
 // [WM_NEXTMENU] message handler.
 //
 // [WM_NEXTMENU]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-nextmenu
-func (me *EventsWindow) WmNextMenu(fun func(p WmNextMenu)) {
-	me.Wm(co.WM_NEXTMENU, func(p Wm) uintptr {
+func (me *EventsWindow) WmNextMenu(fun func(p WmNextMenu)) EventToken {
+	return me.Wm(co.WM_NEXTMENU, func(p Wm) uintptr {
 		fun(WmNextMenu{Raw: p})
 		return me.defProcVal
 	})
@@ -1292,8 +1443,8 @@ func (me *EventsWindow) WmNextMenu(fun func(p WmNextMenu)) {
 // [WM_NULL] message handler.
 //
 // [WM_NULL]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-null
-func (me *EventsWindow) WmNull(fun func()) {
-	me.Wm(co.WM_NULL, func(p Wm) uintptr {
+func (me *EventsWindow) WmNull(fun func()) EventToken {
+	return me.Wm(co.WM_NULL, func(p Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -1316,8 +1467,8 @@ func (me *EventsWindow) WmNull(fun func()) {
 //	}
 //
 // [WM_PAINT]: https://learn.microsoft.com/en-us/windows/win32/gdi/wm-paint
-func (me *EventsWindow) WmPaint(fun func()) {
-	me.Wm(co.WM_PAINT, func(_ Wm) uintptr {
+func (me *EventsWindow) WmPaint(fun func()) EventToken {
+	return me.Wm(co.WM_PAINT, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -1326,8 +1477,8 @@ func (me *EventsWindow) WmPaint(fun func()) {
 // [WM_PAINTCLIPBOARD] message handler.
 //
 // [WM_PAINTCLIPBOARD]: https://learn.microsoft.com/en-us/windows/win32/dataxchg/wm-paintclipboard
-func (me *EventsWindow) WmPaintClipboard(fun func(WmPaintClipboard)) {
-	me.Wm(co.WM_PAINTCLIPBOARD, func(p Wm) uintptr {
+func (me *EventsWindow) WmPaintClipboard(fun func(WmPaintClipboard)) EventToken {
+	return me.Wm(co.WM_PAINTCLIPBOARD, func(p Wm) uintptr {
 		fun(WmPaintClipboard{Raw: p})
 		return me.defProcVal
 	})
@@ -1336,8 +1487,8 @@ func (me *EventsWindow) WmPaintClipboard(fun func(WmPaintClipboard)) {
 // [WM_PARENTNOTIFY] message handler.
 //
 // [WM_PARENTNOTIFY]: https://learn.microsoft.com/en-us/windows/win32/inputmsg/wm-parentnotify
-func (me *EventsWindow) WmParentNotify(fun func(WmParentNotify)) {
-	me.Wm(co.WM_PARENTNOTIFY, func(p Wm) uintptr {
+func (me *EventsWindow) WmParentNotify(fun func(WmParentNotify)) EventToken {
+	return me.Wm(co.WM_PARENTNOTIFY, func(p Wm) uintptr {
 		fun(WmParentNotify{Raw: p})
 		return me.defProcVal
 	})
@@ -1346,8 +1497,8 @@ func (me *EventsWindow) WmParentNotify(fun func(WmParentNotify)) {
 // [WM_POWERBROADCAST] message handler.
 //
 // [WM_POWERBROADCAST]: https://learn.microsoft.com/en-us/windows/win32/power/wm-powerbroadcast
-func (me *EventsWindow) WmPowerBroadcast(fun func(p WmPowerBroadcast)) {
-	me.Wm(co.WM_POWERBROADCAST, func(p Wm) uintptr {
+func (me *EventsWindow) WmPowerBroadcast(fun func(p WmPowerBroadcast)) EventToken {
+	return me.Wm(co.WM_POWERBROADCAST, func(p Wm) uintptr {
 		fun(WmPowerBroadcast{Raw: p})
 		return 1
 	})
@@ -1356,8 +1507,8 @@ func (me *EventsWindow) WmPowerBroadcast(fun func(p WmPowerBroadcast)) {
 // [WM_PRINT] message handler.
 //
 // [WM_PRINT]: https://learn.microsoft.com/en-us/windows/win32/gdi/wm-print
-func (me *EventsWindow) WmPrint(fun func(p WmPrint)) {
-	me.Wm(co.WM_PRINT, func(p Wm) uintptr {
+func (me *EventsWindow) WmPrint(fun func(p WmPrint)) EventToken {
+	return me.Wm(co.WM_PRINT, func(p Wm) uintptr {
 		fun(WmPrint{Raw: p})
 		return me.defProcVal
 	})
@@ -1366,8 +1517,8 @@ func (me *EventsWindow) WmPrint(fun func(p WmPrint)) {
 // [WM_QUERYDRAGICON] message handler.
 //
 // [WM_QUERYDRAGICON]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-querydragicon
-func (me *EventsWindow) WmQueryDragIcon(fun func() win.HICON) {
-	me.Wm(co.WM_QUERYDRAGICON, func(p Wm) uintptr {
+func (me *EventsWindow) WmQueryDragIcon(fun func() win.HICON) EventToken {
+	return me.Wm(co.WM_QUERYDRAGICON, func(p Wm) uintptr {
 		return uintptr(fun())
 	})
 }
@@ -1375,8 +1526,8 @@ func (me *EventsWindow) WmQueryDragIcon(fun func() win.HICON) {
 // [WM_QUERYOPEN] message handler.
 //
 // [WM_QUERYOPEN]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-queryopen
-func (me *EventsWindow) WmQueryOpen(fun func() bool) {
-	me.Wm(co.WM_QUERYOPEN, func(p Wm) uintptr {
+func (me *EventsWindow) WmQueryOpen(fun func() bool) EventToken {
+	return me.Wm(co.WM_QUERYOPEN, func(p Wm) uintptr {
 		return utl.BoolToUintptr(fun())
 	})
 }
@@ -1384,8 +1535,8 @@ func (me *EventsWindow) WmQueryOpen(fun func() bool) {
 // [WM_RBUTTONDBLCLK] message handler.
 //
 // [WM_RBUTTONDBLCLK]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-rbuttondblclk
-func (me *EventsWindow) WmRButtonDblClk(fun func(p WmMouse)) {
-	me.Wm(co.WM_RBUTTONDBLCLK, func(p Wm) uintptr {
+func (me *EventsWindow) WmRButtonDblClk(fun func(p WmMouse)) EventToken {
+	return me.Wm(co.WM_RBUTTONDBLCLK, func(p Wm) uintptr {
 		fun(WmMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -1394,8 +1545,8 @@ func (me *EventsWindow) WmRButtonDblClk(fun func(p WmMouse)) {
 // [WM_RBUTTONDOWN] message handler.
 //
 // [WM_RBUTTONDOWN]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-rbuttondown
-func (me *EventsWindow) WmRButtonDown(fun func(p WmMouse)) {
-	me.Wm(co.WM_RBUTTONDOWN, func(p Wm) uintptr {
+func (me *EventsWindow) WmRButtonDown(fun func(p WmMouse)) EventToken {
+	return me.Wm(co.WM_RBUTTONDOWN, func(p Wm) uintptr {
 		fun(WmMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -1404,8 +1555,8 @@ func (me *EventsWindow) WmRButtonDown(fun func(p WmMouse)) {
 // [WM_RBUTTONUP] message handler.
 //
 // [WM_RBUTTONUP]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-rbuttonup
-func (me *EventsWindow) WmRButtonUp(fun func(p WmMouse)) {
-	me.Wm(co.WM_RBUTTONUP, func(p Wm) uintptr {
+func (me *EventsWindow) WmRButtonUp(fun func(p WmMouse)) EventToken {
+	return me.Wm(co.WM_RBUTTONUP, func(p Wm) uintptr {
 		fun(WmMouse{Raw: p})
 		return me.defProcVal
 	})
@@ -1414,8 +1565,8 @@ func (me *EventsWindow) WmRButtonUp(fun func(p WmMouse)) {
 // [WM_RENDERALLFORMATS] message handler.
 //
 // [WM_RENDERALLFORMATS]: https://learn.microsoft.com/en-us/windows/win32/dataxchg/wm-renderallformats
-func (me *EventsWindow) WmRenderAllFormats(fun func()) {
-	me.Wm(co.WM_RENDERALLFORMATS, func(_ Wm) uintptr {
+func (me *EventsWindow) WmRenderAllFormats(fun func()) EventToken {
+	return me.Wm(co.WM_RENDERALLFORMATS, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -1424,8 +1575,8 @@ func (me *EventsWindow) WmRenderAllFormats(fun func()) {
 // [WM_RENDERFORMAT] message handler.
 //
 // [WM_RENDERFORMAT]: https://learn.microsoft.com/en-us/windows/win32/dataxchg/wm-renderformat
-func (me *EventsWindow) WmRenderFormat(fun func(p WmRenderFormat)) {
-	me.Wm(co.WM_RENDERFORMAT, func(p Wm) uintptr {
+func (me *EventsWindow) WmRenderFormat(fun func(p WmRenderFormat)) EventToken {
+	return me.Wm(co.WM_RENDERFORMAT, func(p Wm) uintptr {
 		fun(WmRenderFormat{Raw: p})
 		return me.defProcVal
 	})
@@ -1434,8 +1585,8 @@ func (me *EventsWindow) WmRenderFormat(fun func(p WmRenderFormat)) {
 // [WM_SETFOCUS] message handler.
 //
 // [WM_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-setfocus
-func (me *EventsWindow) WmSetFocus(fun func(p WmSetFocus)) {
-	me.Wm(co.WM_SETFOCUS, func(p Wm) uintptr {
+func (me *EventsWindow) WmSetFocus(fun func(p WmSetFocus)) EventToken {
+	return me.Wm(co.WM_SETFOCUS, func(p Wm) uintptr {
 		fun(WmSetFocus{Raw: p})
 		return me.defProcVal
 	})
@@ -1444,8 +1595,8 @@ func (me *EventsWindow) WmSetFocus(fun func(p WmSetFocus)) {
 // [WM_SETFONT] message handler.
 //
 // [WM_SETFONT]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-setfont
-func (me *EventsWindow) WmSetFont(fun func(p WmSetFont)) {
-	me.Wm(co.WM_SETFONT, func(p Wm) uintptr {
+func (me *EventsWindow) WmSetFont(fun func(p WmSetFont)) EventToken {
+	return me.Wm(co.WM_SETFONT, func(p Wm) uintptr {
 		fun(WmSetFont{Raw: p})
 		return me.defProcVal
 	})
@@ -1454,8 +1605,8 @@ func (me *EventsWindow) WmSetFont(fun func(p WmSetFont)) {
 // [WM_SETICON] message handler.
 //
 // [WM_SETICON]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-seticon
-func (me *EventsWindow) WmSetIcon(fun func(p WmSetIcon) win.HICON) {
-	me.Wm(co.WM_SETICON, func(p Wm) uintptr {
+func (me *EventsWindow) WmSetIcon(fun func(p WmSetIcon) win.HICON) EventToken {
+	return me.Wm(co.WM_SETICON, func(p Wm) uintptr {
 		return uintptr(fun(WmSetIcon{Raw: p}))
 	})
 }
@@ -1463,8 +1614,8 @@ func (me *EventsWindow) WmSetIcon(fun func(p WmSetIcon) win.HICON) {
 // [WM_SETREDRAW] message handler.
 //
 // [WM_SETREDRAW]: https://learn.microsoft.com/en-us/windows/win32/gdi/wm-setredraw
-func (me *EventsWindow) WmSetRedraw(fun func(p WmSetRedraw)) {
-	me.Wm(co.WM_SETREDRAW, func(p Wm) uintptr {
+func (me *EventsWindow) WmSetRedraw(fun func(p WmSetRedraw)) EventToken {
+	return me.Wm(co.WM_SETREDRAW, func(p Wm) uintptr {
 		fun(WmSetRedraw{Raw: p})
 		return me.defProcVal
 	})
@@ -1473,8 +1624,8 @@ func (me *EventsWindow) WmSetRedraw(fun func(p WmSetRedraw)) {
 // [WM_SETTEXT] message handler.
 //
 // [WM_SETTEXT]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-settext
-func (me *EventsWindow) WmSetText(fun func(p WmSetText) uintptr) {
-	me.Wm(co.WM_SETTEXT, func(p Wm) uintptr {
+func (me *EventsWindow) WmSetText(fun func(p WmSetText) uintptr) EventToken {
+	return me.Wm(co.WM_SETTEXT, func(p Wm) uintptr {
 		return fun(WmSetText{Raw: p})
 	})
 }
@@ -1482,8 +1633,8 @@ func (me *EventsWindow) WmSetText(fun func(p WmSetText) uintptr) {
 // [WM_SHOWWINDOW] message handler.
 //
 // [WM_SHOWWINDOW]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-showwindow
-func (me *EventsWindow) WmShowWindow(fun func(p WmShowWindow)) {
-	me.Wm(co.WM_SHOWWINDOW, func(p Wm) uintptr {
+func (me *EventsWindow) WmShowWindow(fun func(p WmShowWindow)) EventToken {
+	return me.Wm(co.WM_SHOWWINDOW, func(p Wm) uintptr {
 		fun(WmShowWindow{Raw: p})
 		return me.defProcVal
 	})
@@ -1492,8 +1643,8 @@ func (me *EventsWindow) WmShowWindow(fun func(p WmShowWindow)) {
 // [WM_SIZE] message handler.
 //
 // [WM_SIZE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-size
-func (me *EventsWindow) WmSize(fun func(p WmSize)) {
-	me.Wm(co.WM_SIZE, func(p Wm) uintptr {
+func (me *EventsWindow) WmSize(fun func(p WmSize)) EventToken {
+	return me.Wm(co.WM_SIZE, func(p Wm) uintptr {
 		fun(WmSize{Raw: p})
 		return me.defProcVal
 	})
@@ -1502,8 +1653,8 @@ func (me *EventsWindow) WmSize(fun func(p WmSize)) {
 // [WM_SIZECLIPBOARD] message handler.
 //
 // [WM_SIZECLIPBOARD]: https://learn.microsoft.com/en-us/windows/win32/dataxchg/wm-sizeclipboard
-func (me *EventsWindow) WmSizeClipboard(fun func(p WmSizeClipboard)) {
-	me.Wm(co.WM_SIZECLIPBOARD, func(p Wm) uintptr {
+func (me *EventsWindow) WmSizeClipboard(fun func(p WmSizeClipboard)) EventToken {
+	return me.Wm(co.WM_SIZECLIPBOARD, func(p Wm) uintptr {
 		fun(WmSizeClipboard{Raw: p})
 		return me.defProcVal
 	})
@@ -1512,8 +1663,8 @@ func (me *EventsWindow) WmSizeClipboard(fun func(p WmSizeClipboard)) {
 // [WM_SIZING] message handler.
 //
 // [WM_SIZING]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-sizing
-func (me *EventsWindow) WmSizing(fun func(p WmSizing)) {
-	me.Wm(co.WM_SIZING, func(p Wm) uintptr {
+func (me *EventsWindow) WmSizing(fun func(p WmSizing)) EventToken {
+	return me.Wm(co.WM_SIZING, func(p Wm) uintptr {
 		fun(WmSizing{Raw: p})
 		return 1
 	})
@@ -1522,8 +1673,8 @@ func (me *EventsWindow) WmSizing(fun func(p WmSizing)) {
 // [WM_STYLECHANGED] message handler.
 //
 // [WM_STYLECHANGED]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-stylechanged
-func (me *EventsWindow) WmStyleChanged(fun func(p WmStyles)) {
-	me.Wm(co.WM_STYLECHANGED, func(p Wm) uintptr {
+func (me *EventsWindow) WmStyleChanged(fun func(p WmStyles)) EventToken {
+	return me.Wm(co.WM_STYLECHANGED, func(p Wm) uintptr {
 		fun(WmStyles{Raw: p})
 		return me.defProcVal
 	})
@@ -1532,8 +1683,8 @@ func (me *EventsWindow) WmStyleChanged(fun func(p WmStyles)) {
 // [WM_STYLECHANGING] message handler.
 //
 // [WM_STYLECHANGING]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-stylechanging
-func (me *EventsWindow) WmStyleChanging(fun func(p WmStyles)) {
-	me.Wm(co.WM_STYLECHANGING, func(p Wm) uintptr {
+func (me *EventsWindow) WmStyleChanging(fun func(p WmStyles)) EventToken {
+	return me.Wm(co.WM_STYLECHANGING, func(p Wm) uintptr {
 		fun(WmStyles{Raw: p})
 		return me.defProcVal
 	})
@@ -1542,8 +1693,8 @@ func (me *EventsWindow) WmStyleChanging(fun func(p WmStyles)) {
 // [WM_SYNCPAINT] message handler.
 //
 // [WM_SYNCPAINT]: https://learn.microsoft.com/en-us/windows/win32/gdi/wm-syncpaint
-func (me *EventsWindow) WmSyncPaint(fun func()) {
-	me.Wm(co.WM_SYNCPAINT, func(p Wm) uintptr {
+func (me *EventsWindow) WmSyncPaint(fun func()) EventToken {
+	return me.Wm(co.WM_SYNCPAINT, func(p Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -1552,8 +1703,8 @@ func (me *EventsWindow) WmSyncPaint(fun func()) {
 // [WM_SYSCHAR] message handler.
 //
 // [WM_SYSCHAR]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-syschar
-func (me *EventsWindow) WmSysChar(fun func(p WmChar)) {
-	me.Wm(co.WM_SYSCHAR, func(p Wm) uintptr {
+func (me *EventsWindow) WmSysChar(fun func(p WmChar)) EventToken {
+	return me.Wm(co.WM_SYSCHAR, func(p Wm) uintptr {
 		fun(WmChar{Raw: p})
 		return me.defProcVal
 	})
@@ -1562,8 +1713,8 @@ func (me *EventsWindow) WmSysChar(fun func(p WmChar)) {
 // [WM_SYSCOMMAND] message handler.
 //
 // [WM_SYSCOMMAND]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-syscommand
-func (me *EventsWindow) WmSysCommand(fun func(p WmSysCommand)) {
-	me.Wm(co.WM_SYSCOMMAND, func(p Wm) uintptr {
+func (me *EventsWindow) WmSysCommand(fun func(p WmSysCommand)) EventToken {
+	return me.Wm(co.WM_SYSCOMMAND, func(p Wm) uintptr {
 		fun(WmSysCommand{Raw: p})
 		return me.defProcVal
 	})
@@ -1572,8 +1723,8 @@ func (me *EventsWindow) WmSysCommand(fun func(p WmSysCommand)) {
 // [WM_SYSDEADCHAR] message handler.
 //
 // [WM_SYSDEADCHAR]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-sysdeadchar
-func (me *EventsWindow) WmSysDeadChar(fun func(p WmChar)) {
-	me.Wm(co.WM_SYSDEADCHAR, func(p Wm) uintptr {
+func (me *EventsWindow) WmSysDeadChar(fun func(p WmChar)) EventToken {
+	return me.Wm(co.WM_SYSDEADCHAR, func(p Wm) uintptr {
 		fun(WmChar{Raw: p})
 		return me.defProcVal
 	})
@@ -1582,8 +1733,8 @@ func (me *EventsWindow) WmSysDeadChar(fun func(p WmChar)) {
 // [WM_SYSKEYDOWN] message handler.
 //
 // [WM_SYSKEYDOWN]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-syskeydown
-func (me *EventsWindow) WmSysKeyDown(fun func(p WmKey)) {
-	me.Wm(co.WM_SYSKEYDOWN, func(p Wm) uintptr {
+func (me *EventsWindow) WmSysKeyDown(fun func(p WmKey)) EventToken {
+	return me.Wm(co.WM_SYSKEYDOWN, func(p Wm) uintptr {
 		fun(WmKey{Raw: p})
 		return me.defProcVal
 	})
@@ -1592,8 +1743,8 @@ func (me *EventsWindow) WmSysKeyDown(fun func(p WmKey)) {
 // [WM_SYSKEYUP] message handler.
 //
 // [WM_SYSKEYUP]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-syskeyup
-func (me *EventsWindow) WmSysKeyUp(fun func(p WmKey)) {
-	me.Wm(co.WM_SYSKEYUP, func(p Wm) uintptr {
+func (me *EventsWindow) WmSysKeyUp(fun func(p WmKey)) EventToken {
+	return me.Wm(co.WM_SYSKEYUP, func(p Wm) uintptr {
 		fun(WmKey{Raw: p})
 		return me.defProcVal
 	})
@@ -1602,8 +1753,8 @@ func (me *EventsWindow) WmSysKeyUp(fun func(p WmKey)) {
 // [WM_THEMECHANGED] message handler.
 //
 // [WM_THEMECHANGED]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-themechanged
-func (me *EventsWindow) WmThemeChanged(fun func()) {
-	me.Wm(co.WM_THEMECHANGED, func(p Wm) uintptr {
+func (me *EventsWindow) WmThemeChanged(fun func()) EventToken {
+	return me.Wm(co.WM_THEMECHANGED, func(p Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -1612,8 +1763,8 @@ func (me *EventsWindow) WmThemeChanged(fun func()) {
 // [WM_TIMECHANGE] message handler.
 //
 // [WM_TIMECHANGE]: https://learn.microsoft.com/en-us/windows/win32/sysinfo/wm-timechange
-func (me *EventsWindow) WmTimeChange(fun func()) {
-	me.Wm(co.WM_TIMECHANGE, func(_ Wm) uintptr {
+func (me *EventsWindow) WmTimeChange(fun func()) EventToken {
+	return me.Wm(co.WM_TIMECHANGE, func(_ Wm) uintptr {
 		fun()
 		return me.defProcVal
 	})
@@ -1622,8 +1773,8 @@ func (me *EventsWindow) WmTimeChange(fun func()) {
 // [WM_UNINITMENUPOPUP] message handler.
 //
 // [WM_UNINITMENUPOPUP]: https://learn.microsoft.com/en-us/windows/win32/menurc/wm-uninitmenupopup
-func (me *EventsWindow) WmUnInitMenuPopup(fun func(p WmUnInitMenuPopup)) {
-	me.Wm(co.WM_UNINITMENUPOPUP, func(p Wm) uintptr {
+func (me *EventsWindow) WmUnInitMenuPopup(fun func(p WmUnInitMenuPopup)) EventToken {
+	return me.Wm(co.WM_UNINITMENUPOPUP, func(p Wm) uintptr {
 		fun(WmUnInitMenuPopup{Raw: p})
 		return me.defProcVal
 	})
@@ -1632,8 +1783,8 @@ func (me *EventsWindow) WmUnInitMenuPopup(fun func(p WmUnInitMenuPopup)) {
 // [WM_UNDO] message handler.
 //
 // [WM_UNDO]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-undo
-func (me *EventsWindow) WmUndo(fun func() bool) {
-	me.Wm(co.WM_UNDO, func(p Wm) uintptr {
+func (me *EventsWindow) WmUndo(fun func() bool) EventToken {
+	return me.Wm(co.WM_UNDO, func(p Wm) uintptr {
 		return utl.BoolToUintptr(fun())
 	})
 }
@@ -1641,8 +1792,8 @@ func (me *EventsWindow) WmUndo(fun func() bool) {
 // [WM_VSCROLL] message handler.
 //
 // [WM_VSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-vscroll
-func (me *EventsWindow) WmVScroll(fun func(p WmScroll)) {
-	me.Wm(co.WM_VSCROLL, func(p Wm) uintptr {
+func (me *EventsWindow) WmVScroll(fun func(p WmScroll)) EventToken {
+	return me.Wm(co.WM_VSCROLL, func(p Wm) uintptr {
 		fun(WmScroll{Raw: p})
 		return me.defProcVal
 	})
@@ -1651,8 +1802,8 @@ func (me *EventsWindow) WmVScroll(fun func(p WmScroll)) {
 // [WM_VSCROLLCLIPBOARD] message handler.
 //
 // [WM_VSCROLLCLIPBOARD]: https://learn.microsoft.com/en-us/windows/win32/dataxchg/wm-vscrollclipboard
-func (me *EventsWindow) WmVScrollClipboard(fun func(p WmScrollClipboard)) {
-	me.Wm(co.WM_VSCROLLCLIPBOARD, func(p Wm) uintptr {
+func (me *EventsWindow) WmVScrollClipboard(fun func(p WmScrollClipboard)) EventToken {
+	return me.Wm(co.WM_VSCROLLCLIPBOARD, func(p Wm) uintptr {
 		fun(WmScrollClipboard{Raw: p})
 		return me.defProcVal
 	})
@@ -1661,8 +1812,8 @@ func (me *EventsWindow) WmVScrollClipboard(fun func(p WmScrollClipboard)) {
 // [WM_WINDOWPOSCHANGED] message handler.
 //
 // [WM_WINDOWPOSCHANGED]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-windowposchanged
-func (me *EventsWindow) WmWindowPosChanged(fun func(p WmWindowPos)) {
-	me.Wm(co.WM_WINDOWPOSCHANGED, func(p Wm) uintptr {
+func (me *EventsWindow) WmWindowPosChanged(fun func(p WmWindowPos)) EventToken {
+	return me.Wm(co.WM_WINDOWPOSCHANGED, func(p Wm) uintptr {
 		fun(WmWindowPos{Raw: p})
 		return me.defProcVal
 	})
@@ -1671,8 +1822,8 @@ func (me *EventsWindow) WmWindowPosChanged(fun func(p WmWindowPos)) {
 // [WM_WINDOWPOSCHANGING] message handler.
 //
 // [WM_WINDOWPOSCHANGING]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-windowposchanging
-func (me *EventsWindow) WmWindowPosChanging(fun func(p WmWindowPos)) {
-	me.Wm(co.WM_WINDOWPOSCHANGING, func(p Wm) uintptr {
+func (me *EventsWindow) WmWindowPosChanging(fun func(p WmWindowPos)) EventToken {
+	return me.Wm(co.WM_WINDOWPOSCHANGING, func(p Wm) uintptr {
 		fun(WmWindowPos{Raw: p})
 		return me.defProcVal
 	})
@@ -1681,8 +1832,8 @@ func (me *EventsWindow) WmWindowPosChanging(fun func(p WmWindowPos)) {
 // [WM_WTSSESSION_CHANGE] message handler.
 //
 // [WM_WTSSESSION_CHANGE]: https://learn.microsoft.com/en-us/windows/win32/termserv/wm-wtssession-change
-func (me *EventsWindow) WmWtsSessionChange(fun func(p WmWtsSessionChange)) {
-	me.Wm(co.WM_WTSSESSION_CHANGE, func(p Wm) uintptr {
+func (me *EventsWindow) WmWtsSessionChange(fun func(p WmWtsSessionChange)) EventToken {
+	return me.Wm(co.WM_WTSSESSION_CHANGE, func(p Wm) uintptr {
 		fun(WmWtsSessionChange{Raw: p})
 		return me.defProcVal
 	})
@@ -1691,8 +1842,8 @@ func (me *EventsWindow) WmWtsSessionChange(fun func(p WmWtsSessionChange)) {
 // [WM_XBUTTONDBLCLK] message handler.
 //
 // [WM_XBUTTONDBLCLK]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-xbuttondblclk
-func (me *EventsWindow) WmXButtonDblClk(fun func(p WmMouse)) {
-	me.Wm(co.WM_XBUTTONDBLCLK, func(p Wm) uintptr {
+func (me *EventsWindow) WmXButtonDblClk(fun func(p WmMouse)) EventToken {
+	return me.Wm(co.WM_XBUTTONDBLCLK, func(p Wm) uintptr {
 		fun(WmMouse{Raw: p})
 		return 1
 	})
@@ -1701,8 +1852,8 @@ func (me *EventsWindow) WmXButtonDblClk(fun func(p WmMouse)) {
 // [WM_XBUTTONDOWN] message handler.
 //
 // [WM_XBUTTONDOWN]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-xbuttondown
-func (me *EventsWindow) WmXButtonDown(fun func(p WmMouse)) {
-	me.Wm(co.WM_XBUTTONDOWN, func(p Wm) uintptr {
+func (me *EventsWindow) WmXButtonDown(fun func(p WmMouse)) EventToken {
+	return me.Wm(co.WM_XBUTTONDOWN, func(p Wm) uintptr {
 		fun(WmMouse{Raw: p})
 		return 1
 	})
@@ -1711,8 +1862,8 @@ func (me *EventsWindow) WmXButtonDown(fun func(p WmMouse)) {
 // [WM_XBUTTONUP] message handler.
 //
 // [WM_XBUTTONUP]: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-xbuttonup
-func (me *EventsWindow) WmXButtonUp(fun func(p WmMouse)) {
-	me.Wm(co.WM_XBUTTONUP, func(p Wm) uintptr {
+func (me *EventsWindow) WmXButtonUp(fun func(p WmMouse)) EventToken {
+	return me.Wm(co.WM_XBUTTONUP, func(p Wm) uintptr {
 		fun(WmMouse{Raw: p})
 		return 1
 	})
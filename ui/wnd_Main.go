@@ -43,7 +43,8 @@ func NewMain(opts *VarOptsMain) *Main {
 	}
 }
 
-// Creates a new dialog-based Main with [CreateDialogParam].
+// Creates a new dialog-based Main with [CreateDialogParam], or with
+// CreateDialogIndirectParam if [VarOptsMainDlg.Template] is informed.
 //
 // # Example
 //
@@ -76,7 +77,22 @@ func NewMainDlg(opts *VarOptsMainDlg) *Main {
 //
 // Panics on error.
 func (me *Main) RunAsMain() int {
-	if win.IsWindowsVistaOrGreater() {
+	return runAsMain(func(hInst win.HINSTANCE) int {
+		if me.raw != nil {
+			return me.raw.runAsMain(hInst)
+		} else {
+			return me.dlg.runAsMain(hInst)
+		}
+	})
+}
+
+// Sets up the process-wide state of the application, then creates the main
+// window and runs the main loop, with the given function.
+func runAsMain(createAndLoop func(hInst win.HINSTANCE) int) int {
+	if isWindows10BuildOrGreater(15063) { // Windows 10 version 1703
+		// Fails if the awareness was already set in the manifest, which is fine.
+		win.SetProcessDpiAwarenessContext(co.DPI_AWARENESS_CONTEXT_PER_MONITOR_AWARE_V2)
+	} else if win.IsWindowsVistaOrGreater() {
 		if err := win.SetProcessDPIAware(); err != nil {
 			panic(err)
 		}
@@ -100,13 +116,10 @@ func (me *Main) RunAsMain() int {
 
 	createGlobalUiFont() // will be applied to native controls
 	defer globalUiFont.DeleteObject()
+	defer deleteUiFontsDpi()
 
 	hInst, _ := win.GetModuleHandle("")
-	if me.raw != nil {
-		return me.raw.runAsMain(hInst)
-	} else {
-		return me.dlg.runAsMain(hInst)
-	}
+	return createAndLoop(hInst)
 }
 
 // Returns the underlying HWND handle of this window.
@@ -126,12 +139,9 @@ func (me *Main) Hwnd() win.HWND {
 //
 // Implements [Parent].
 //
-// Panics if called after the window has been created.
+// Can also be called after the window has been created, so handlers can be
+// added and removed at runtime; see [EventsWindow] for details.
 func (me *Main) On() *EventsWindow {
-	if me.Hwnd() != 0 {
-		panic("Cannot add event handling after the window has been created.")
-	}
-
 	if me.raw != nil {
 		return &me.raw.userEvents
 	} else {
@@ -171,6 +181,16 @@ func (me *Main) UiThread(fun func()) {
 	}
 }
 
+// Returns the current DPI of the window, which changes when it's moved to a
+// monitor with a different scaling factor. Before the window is created,
+// returns the system DPI.
+//
+// Values computed with [DpiX] and [DpiY] can be converted to this DPI with
+// [DpiScale].
+func (me *Main) Dpi() int {
+	return me.base().dpi
+}
+
 // Implements [Parent].
 func (me *Main) base() *_BaseContainer {
 	if me.raw != nil {
@@ -16,7 +16,7 @@ type _MainDlg struct {
 // Constructor.
 func newMainDlg(opts *VarOptsMainDlg) *_MainDlg {
 	me := &_MainDlg{
-		_BaseDlg:     newBaseDlg(opts.dlgId),
+		_BaseDlg:     newBaseDlg(opts.dlgId, opts.template),
 		iconId:       opts.iconId,
 		accelTableId: opts.accelTableId,
 	}
@@ -41,7 +41,7 @@ func (me *_MainDlg) runAsMain(hInst win.HINSTANCE) int {
 	}
 
 	me.hWnd.ShowWindow(co.SW_SHOW)
-	return me.runMainLoop(hAccel, true)
+	return me.runMainLoop(hAccel, win.HWND(0), true)
 }
 
 func (me *_MainDlg) defaultMessageHandlers() {
@@ -59,6 +59,7 @@ func (me *_MainDlg) defaultMessageHandlers() {
 // Options for [NewMainDlg]; returned by [OptsMainDlg].
 type VarOptsMainDlg struct {
 	dlgId        uint16
+	template     *DlgTemplate
 	iconId       uint16
 	accelTableId uint16
 }
@@ -70,11 +71,23 @@ func OptsMainDlg() *VarOptsMainDlg {
 
 // Dialog resource ID passed to [CreateDialogParam].
 //
-// Panics if not informed.
+// Panics if neither this nor [VarOptsMainDlg.Template] is informed.
 //
 // [CreateDialogParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogparamw
 func (o *VarOptsMainDlg) DlgId(id uint16) *VarOptsMainDlg { o.dlgId = id; return o }
 
+// In-memory dialog template passed to [CreateDialogIndirectParam], so no
+// dialog resource is needed. Overrides [VarOptsMainDlg.DlgId].
+//
+// The template should have the WS_CAPTION, WS_SYSMENU and WS_MINIMIZEBOX
+// styles, and the co.DS_SHELLFONT dialog style, with an 8-point "MS Shell Dlg"
+// font.
+//
+// Defaults to none.
+//
+// [CreateDialogIndirectParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogindirectparamw
+func (o *VarOptsMainDlg) Template(t *DlgTemplate) *VarOptsMainDlg { o.template = t; return o }
+
 // Dialog icon ID passed to [WM_SETICON].
 //
 // Defaults to none.
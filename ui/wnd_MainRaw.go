@@ -57,7 +57,7 @@ func (me *_MainRaw) runAsMain(hInst win.HINSTANCE) int {
 	accelTable := me.opts.accelTable
 	processDlgMsgs := me.opts.processDlgMsgs
 	me.opts = nil
-	return me.runMainLoop(accelTable, processDlgMsgs)
+	return me.runMainLoop(accelTable, win.HWND(0), processDlgMsgs)
 }
 
 func (me *_MainRaw) defaultMessageHandlers() {
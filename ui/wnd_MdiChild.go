@@ -0,0 +1,291 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+const _CW_USEDEFAULT = -0x8000_0000 // Lets the system choose the position of the window.
+
+// Document window of a [multiple-document interface] (MDI), hosted inside the
+// MDICLIENT window of a [MdiFrame].
+//
+// Implements:
+//   - [Window]
+//   - [Parent]
+//
+// [multiple-document interface]: https://learn.microsoft.com/en-us/windows/win32/winmsg/multiple-document-interface
+type MdiChild struct {
+	_BaseRaw
+	frame           *MdiFrame
+	opts            *VarOptsMdiChild
+	hChildPrevFocus win.HWND
+}
+
+// Creates a new MDI child window. It will be physically created only when
+// [MdiChild.Create] is called, so the events can be handled before it.
+//
+// Messages not handled are passed to [DefMDIChildProc].
+//
+// # Example
+//
+//	var wnd *ui.MdiFrame // initialized somewhere
+//
+//	child := ui.NewMdiChild(
+//		wnd,
+//		ui.OptsMdiChild().
+//			Title("Untitled"),
+//	)
+//	ui.NewEdit(
+//		child,
+//		ui.OptsEdit().
+//			Position(ui.Dpi(10, 10)),
+//	)
+//	child.Create()
+//
+// [DefMDIChildProc]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-defmdichildprocw
+func NewMdiChild(frame *MdiFrame, opts *VarOptsMdiChild) *MdiChild {
+	me := &MdiChild{
+		_BaseRaw:        newBaseRaw(),
+		frame:           frame,
+		opts:            opts,
+		hChildPrevFocus: win.HWND(0),
+	}
+	me.defProc = func(hWnd win.HWND, uMsg co.WM, wParam win.WPARAM, lParam win.LPARAM) uintptr {
+		return hWnd.DefMDIChildProc(uMsg, wParam, lParam)
+	}
+	me.alwaysDefProc = []co.WM{co.WM_CHILDACTIVATE, co.WM_GETMINMAXINFO,
+		co.WM_MENUCHAR, co.WM_MOVE, co.WM_NEXTMENU, co.WM_SETFOCUS, co.WM_SIZE,
+		co.WM_SYSCOMMAND}
+	me.defaultMessageHandlers()
+	return me
+}
+
+// Physically creates the window with [CreateWindowEx], using the
+// co.WS_EX_MDICHILD and co.WS_EX_CONTROLPARENT extended styles. The new window becomes the active one.
+//
+// The frame must have been already created. Panics on error.
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (me *MdiChild) Create() {
+	if me.frame.hMdiClient == 0 {
+		panic("Cannot create a MDI child before its frame.")
+	}
+
+	hInst, _ := me.frame.hWnd.HInstance()
+	atom := me.registerClass(hInst, me.opts.className, me.opts.classStyle,
+		me.opts.classIconId, me.opts.classBrush, me.opts.classCursor)
+
+	rcWnd := win.RECT{ // client area, will be adjusted to size with title bar and borders
+		Left:   0,
+		Top:    0,
+		Right:  me.opts.size.Cx,
+		Bottom: me.opts.size.Cy,
+	}
+	exStyle := me.opts.exStyle | co.WS_EX_MDICHILD | co.WS_EX_CONTROLPARENT
+	win.AdjustWindowRectEx(&rcWnd, me.opts.style, false, exStyle)
+
+	me.frame.children = append(me.frame.children, me)
+	me.createWindow(exStyle, atom, me.opts.title, me.opts.style,
+		win.POINT{X: _CW_USEDEFAULT, Y: _CW_USEDEFAULT},
+		win.SIZE{Cx: rcWnd.Right - rcWnd.Left, Cy: rcWnd.Bottom - rcWnd.Top},
+		me.frame.hMdiClient, win.HMENU(0), hInst)
+	me.opts = nil
+}
+
+func (me *MdiChild) defaultMessageHandlers() {
+	me._BaseRaw._BaseContainer.defaultMessageHandlers()
+
+	me.beforeUserEvents.Wm(co.WM_MDIACTIVATE, func(p Wm) uintptr {
+		if win.HWND(p.WParam) == me.hWnd { // being deactivated
+			if hCurFocus := win.GetFocus(); hCurFocus != 0 && me.hWnd.IsChild(hCurFocus) {
+				me.hChildPrevFocus = hCurFocus // save previously focused control
+			}
+		}
+		return 0 // ignored
+	})
+
+	me.beforeUserEvents.WmSetFocus(func(_ WmSetFocus) {
+		if me.hChildPrevFocus != 0 && me.hWnd.IsChild(me.hChildPrevFocus) {
+			me.hChildPrevFocus.SetFocus() // put focus back
+		} else {
+			me.delegateFocusToFirstChild()
+		}
+	})
+
+	me.beforeUserEvents.WmNcDestroy(func() {
+		me.frame.removeChild(me)
+	})
+}
+
+// Returns the underlying HWND handle of this window.
+//
+// Implements [Window].
+//
+// Note that this handle is initially zero, existing only after window creation.
+func (me *MdiChild) Hwnd() win.HWND {
+	return me.hWnd
+}
+
+// Exposes all the window notifications the can be handled.
+//
+// Implements [Parent].
+//
+// Can also be called after the window has been created, so handlers can be
+// added and removed at runtime; see [EventsWindow] for details.
+func (me *MdiChild) On() *EventsWindow {
+	return &me.userEvents
+}
+
+// This method is analog to [SendMessage] (synchronous), but intended to be
+// called from another thread, so a callback function can, tunelled by
+// [WNDPROC], run in the original thread of the window, thus allowing GUI
+// updates. With this, the user doesn't have to deal with a custom WM_ message.
+//
+// Implements [Parent].
+//
+// [SendMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-sendmessagew
+// [WNDPROC]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nc-winuser-wndproc
+func (me *MdiChild) UiThread(fun func()) {
+	me.uiThread(fun)
+}
+
+// Returns the current DPI of the window, which changes when it's moved to a
+// monitor with a different scaling factor. Before the window is created,
+// returns the system DPI.
+func (me *MdiChild) Dpi() int {
+	return me.dpi
+}
+
+// Implements [Parent].
+func (me *MdiChild) base() *_BaseContainer {
+	return &me._BaseContainer
+}
+
+// Returns the frame which hosts this MDI child.
+func (me *MdiChild) Frame() *MdiFrame {
+	return me.frame
+}
+
+// Activates the window by sending [WM_MDIACTIVATE] to the MDICLIENT window.
+//
+// [WM_MDIACTIVATE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-mdiactivate
+func (me *MdiChild) Activate() {
+	me.frame.hMdiClient.SendMessage(co.WM_MDIACTIVATE, win.WPARAM(me.hWnd), 0)
+}
+
+// Maximizes the window by sending [WM_MDIMAXIMIZE] to the MDICLIENT window.
+//
+// [WM_MDIMAXIMIZE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-mdimaximize
+func (me *MdiChild) Maximize() {
+	me.frame.hMdiClient.SendMessage(co.WM_MDIMAXIMIZE, win.WPARAM(me.hWnd), 0)
+}
+
+// Restores the window from maximized or minimized state by sending
+// [WM_MDIRESTORE] to the MDICLIENT window.
+//
+// [WM_MDIRESTORE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-mdirestore
+func (me *MdiChild) Restore() {
+	me.frame.hMdiClient.SendMessage(co.WM_MDIRESTORE, win.WPARAM(me.hWnd), 0)
+}
+
+// Options for [NewMdiChild]; returned by [OptsMdiChild].
+type VarOptsMdiChild struct {
+	className   string
+	classStyle  co.CS
+	classIconId uint16
+	classCursor win.HCURSOR
+	classBrush  win.HBRUSH
+
+	title   string
+	size    win.SIZE
+	style   co.WS
+	exStyle co.WS_EX
+}
+
+// Options for [NewMdiChild].
+func OptsMdiChild() *VarOptsMdiChild {
+	hCursor, _ := win.HINSTANCE(0).LoadCursor(win.CursorResIdc(co.IDC_ARROW))
+	return &VarOptsMdiChild{
+		classStyle:  co.CS_DBLCLKS,
+		classCursor: hCursor,
+		classBrush:  win.HBRUSH(co.COLOR_BTNFACE + 1),
+		style:       co.WS_OVERLAPPEDWINDOW | co.WS_CLIPCHILDREN | co.WS_VISIBLE,
+		size:        win.SIZE{Cx: int32(DpiX(400)), Cy: int32(DpiY(300))},
+	}
+}
+
+// Class name registered with [RegisterClassEx].
+//
+// Defaults to a computed hash.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+func (o *VarOptsMdiChild) ClassName(s string) *VarOptsMdiChild { o.className = s; return o }
+
+// Window class style, passed to [RegisterClassEx].
+//
+// Defaults to co.CS_DBLCLKS.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+func (o *VarOptsMdiChild) ClassStyle(s co.CS) *VarOptsMdiChild { o.classStyle = s; return o }
+
+// Icon associated to the window, shown in its title bar and in the frame menu
+// when maximized, passed to [RegisterClassEx]. This icon is loaded from the
+// resources with [LoadIcon], using the given resource ID.
+//
+// Defaults to none.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+// [LoadIcon]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-loadiconw
+func (o *VarOptsMdiChild) ClassIconId(i uint16) *VarOptsMdiChild { o.classIconId = i; return o }
+
+// Window cursor, passed to [RegisterClassEx].
+//
+// Defaults to stock co.IDC_ARROW.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+func (o *VarOptsMdiChild) ClassCursor(h win.HCURSOR) *VarOptsMdiChild { o.classCursor = h; return o }
+
+// Window background brush, passed to [RegisterClassEx].
+//
+// Defaults to co.COLOR_BTNFACE color.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+func (o *VarOptsMdiChild) ClassBrush(h win.HBRUSH) *VarOptsMdiChild { o.classBrush = h; return o }
+
+// Title of the window, passed to [CreateWindowEx]. It's also the text of the
+// window in the window menu of the frame.
+//
+// Defaults to empty string.
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsMdiChild) Title(t string) *VarOptsMdiChild { o.title = t; return o }
+
+// Size of client area in pixels, passed to [CreateWindowEx]. The position is
+// chosen by the MDICLIENT window.
+//
+// Defaults to ui.Dpi(400, 300).
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsMdiChild) Size(cx int, cy int) *VarOptsMdiChild {
+	o.size.Cx = int32(cx)
+	o.size.Cy = int32(cy)
+	return o
+}
+
+// Window style, passed to [CreateWindowEx].
+//
+// Defaults to co.WS_OVERLAPPEDWINDOW | co.WS_CLIPCHILDREN | co.WS_VISIBLE.
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsMdiChild) Style(s co.WS) *VarOptsMdiChild { o.style = s; return o }
+
+// Extended window style, passed to [CreateWindowEx]. The co.WS_EX_MDICHILD
+// style is always added.
+//
+// Defaults to co.WS_EX_LEFT.
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsMdiChild) ExStyle(s co.WS_EX) *VarOptsMdiChild { o.exStyle = s; return o }
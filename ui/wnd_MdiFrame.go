@@ -0,0 +1,462 @@
+//go:build windows
+
+package ui
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Main application window of a [multiple-document interface] (MDI), which
+// hosts [MdiChild] windows inside its MDICLIENT window.
+//
+// Implements:
+//   - [Window]
+//   - [Parent]
+//
+// [multiple-document interface]: https://learn.microsoft.com/en-us/windows/win32/winmsg/multiple-document-interface
+type MdiFrame struct {
+	_BaseRaw
+	opts       *VarOptsMdiFrame
+	hMdiClient win.HWND
+	children   []*MdiChild
+}
+
+// Creates a new MDI frame window with [CreateWindowEx]. The MDICLIENT window
+// is created along with it, and fills its client area, except for child
+// windows docked at the top or at the bottom edges, like toolbars and status
+// bars.
+//
+// Messages not handled are passed to [DefFrameProc].
+//
+// # Example
+//
+//	runtime.LockOSThread()
+//
+//	var wnd *ui.MdiFrame
+//
+//	menu := ui.NewMenuBar(
+//		ui.MenuPopup("&File",
+//			ui.MenuCmd("&New", func() {
+//				ui.NewMdiChild(wnd, ui.OptsMdiChild().Title("Untitled")).Create()
+//			}).Accel(co.ACCELF_CONTROL, co.VK_N),
+//		),
+//		ui.MenuPopup("&Window",
+//			ui.MenuCmd("&Cascade", func() { wnd.Cascade() }),
+//			ui.MenuCmd("&Tile", func() { wnd.Tile(co.MDITILE_VERTICAL) }),
+//		),
+//	)
+//	hWindowMenu, _ := menu.HMenu().GetSubMenu(1)
+//
+//	wnd = ui.NewMdiFrame(
+//		ui.OptsMdiFrame().
+//			Title("Documents").
+//			Menu(menu.HMenu()).
+//			AccelTable(menu.HAccel()).
+//			WindowMenu(hWindowMenu),
+//	)
+//	menu.Bind(wnd)
+//	wnd.RunAsMain()
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+// [DefFrameProc]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-defframeprocw
+func NewMdiFrame(opts *VarOptsMdiFrame) *MdiFrame {
+	me := &MdiFrame{
+		_BaseRaw:   newBaseRaw(),
+		opts:       opts,
+		hMdiClient: win.HWND(0),
+	}
+	me.defProc = func(hWnd win.HWND, uMsg co.WM, wParam win.WPARAM, lParam win.LPARAM) uintptr {
+		return hWnd.DefFrameProc(me.hMdiClient, uMsg, wParam, lParam)
+	}
+	me.alwaysDefProc = []co.WM{co.WM_MENUCHAR, co.WM_NCACTIVATE, co.WM_SETFOCUS}
+	me.defaultMessageHandlers()
+	return me
+}
+
+// Physically creates the window, then runs the main application loop. This
+// method will block until the window is closed.
+//
+// Panics on error.
+func (me *MdiFrame) RunAsMain() int {
+	return runAsMain(me.runAsMain)
+}
+
+func (me *MdiFrame) runAsMain(hInst win.HINSTANCE) int {
+	atom := me.registerClass(hInst, me.opts.className, me.opts.classStyle,
+		me.opts.classIconId, me.opts.classBrush, me.opts.classCursor)
+
+	szScreen := win.SIZE{
+		Cx: win.GetSystemMetrics(co.SM_CXSCREEN),
+		Cy: win.GetSystemMetrics(co.SM_CYSCREEN),
+	}
+
+	ptWnd := win.POINT{
+		X: szScreen.Cx/2 - me.opts.size.Cx/2, // center on screen
+		Y: szScreen.Cy/2 - me.opts.size.Cy/2,
+	}
+
+	rcWnd := win.RECT{ // client area, will be adjusted to size with title bar and borders
+		Left:   ptWnd.X,
+		Top:    ptWnd.Y,
+		Right:  ptWnd.X + me.opts.size.Cx,
+		Bottom: ptWnd.Y + me.opts.size.Cy,
+	}
+	win.AdjustWindowRectEx(&rcWnd, me.opts.style, me.opts.menu != 0, me.opts.exStyle)
+
+	me.createWindow(me.opts.exStyle, atom, me.opts.title, me.opts.style,
+		win.POINT{X: rcWnd.Left, Y: rcWnd.Top},
+		win.SIZE{Cx: rcWnd.Right - rcWnd.Left, Cy: rcWnd.Bottom - rcWnd.Top},
+		win.HWND(0), me.opts.menu, hInst)
+
+	me.hWnd.ShowWindow(me.opts.cmdShow)
+	me.hWnd.UpdateWindow()
+
+	accelTable := me.opts.accelTable
+	processDlgMsgs := me.opts.processDlgMsgs
+	me.opts = nil
+	return me.runMainLoop(accelTable, me.hMdiClient, processDlgMsgs)
+}
+
+func (me *MdiFrame) defaultMessageHandlers() {
+	me._BaseRaw._BaseContainer.defaultMessageHandlers()
+
+	me.beforeUserEvents.WmCreate(func(p WmCreate) int {
+		ccs := win.CLIENTCREATESTRUCT{
+			HWindowMenu:  me.opts.windowMenu,
+			IdFirstChild: uint32(me.opts.firstChildId),
+		}
+		rcClient, _ := me.hWnd.GetClientRect()
+		hInst, _ := me.hWnd.HInstance()
+
+		var err error
+		me.hMdiClient, err = win.CreateWindowEx(co.WS_EX_CLIENTEDGE|co.WS_EX_CONTROLPARENT,
+			win.ClassNameStr("MDICLIENT"), "",
+			co.WS_CHILD|co.WS_VISIBLE|co.WS_CLIPCHILDREN|co.WS_CLIPSIBLINGS|co.WS_VSCROLL|co.WS_HSCROLL,
+			0, 0, uint(rcClient.Right), uint(rcClient.Bottom),
+			me.hWnd, win.HMENU(0), hInst, win.LPARAM(unsafe.Pointer(&ccs)))
+		if err != nil {
+			panic(err)
+		}
+		return 0 // ignored
+	})
+
+	me.afterUserEvents.WmCreate(func(_ WmCreate) int {
+		me.resizeClient() // docked children were just created
+		return 0          // ignored
+	})
+
+	me.afterUserEvents.WmSize(func(p WmSize) {
+		if p.Request() != co.SIZE_REQ_MINIMIZED {
+			me.resizeClient()
+		}
+	})
+
+	me.userEvents.WmNcDestroy(func() {
+		win.PostQuitMessage(0)
+	})
+}
+
+// Resizes the MDICLIENT window to fill the client area of the frame, leaving
+// uncovered the visible children which span its whole width and are docked at
+// the top or at the bottom edges.
+func (me *MdiFrame) resizeClient() {
+	rcFrame, _ := me.hWnd.GetClientRect()
+	rcMdi := rcFrame
+
+	for _, hChild := range me.hWnd.EnumChildWindows() {
+		if style, _ := hChild.Style(); hChild == me.hMdiClient || (style&co.WS_VISIBLE) == 0 {
+			continue
+		}
+		if hParent, _ := hChild.GetAncestor(co.GA_PARENT); hParent != me.hWnd {
+			continue // grandchildren, including the MDI children themselves
+		}
+
+		rc, _ := hChild.GetWindowRect() // relative to screen
+		me.hWnd.ScreenToClientRc(&rc)   // now relative to frame
+		if rc.Left > rcFrame.Left || rc.Right < rcFrame.Right {
+			continue // not docked
+		}
+		if rc.Top <= rcFrame.Top && rc.Bottom > rcMdi.Top {
+			rcMdi.Top = rc.Bottom
+		} else if rc.Bottom >= rcFrame.Bottom && rc.Top < rcMdi.Bottom {
+			rcMdi.Bottom = rc.Top
+		}
+	}
+
+	if rcMdi.Bottom < rcMdi.Top {
+		rcMdi.Bottom = rcMdi.Top
+	}
+	me.hMdiClient.SetWindowPos(win.HWND(0), int(rcMdi.Left), int(rcMdi.Top),
+		uint(rcMdi.Right-rcMdi.Left), uint(rcMdi.Bottom-rcMdi.Top),
+		co.SWP_NOZORDER|co.SWP_NOACTIVATE)
+}
+
+// Returns the underlying HWND handle of this window.
+//
+// Implements [Window].
+//
+// Note that this handle is initially zero, existing only after window creation.
+func (me *MdiFrame) Hwnd() win.HWND {
+	return me.hWnd
+}
+
+// Exposes all the window notifications the can be handled.
+//
+// Implements [Parent].
+//
+// Can also be called after the window has been created, so handlers can be
+// added and removed at runtime; see [EventsWindow] for details.
+func (me *MdiFrame) On() *EventsWindow {
+	return &me.userEvents
+}
+
+// This method is analog to [SendMessage] (synchronous), but intended to be
+// called from another thread, so a callback function can, tunelled by
+// [WNDPROC], run in the original thread of the window, thus allowing GUI
+// updates. With this, the user doesn't have to deal with a custom WM_ message.
+//
+// Implements [Parent].
+//
+// [SendMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-sendmessagew
+// [WNDPROC]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nc-winuser-wndproc
+func (me *MdiFrame) UiThread(fun func()) {
+	me.uiThread(fun)
+}
+
+// Returns the current DPI of the window, which changes when it's moved to a
+// monitor with a different scaling factor. Before the window is created,
+// returns the system DPI.
+func (me *MdiFrame) Dpi() int {
+	return me.dpi
+}
+
+// Implements [Parent].
+func (me *MdiFrame) base() *_BaseContainer {
+	return &me._BaseContainer
+}
+
+// Returns the handle of the MDICLIENT window, which is the parent of the MDI
+// children.
+//
+// Note that this handle is initially zero, existing only after window creation.
+func (me *MdiFrame) HwndClient() win.HWND {
+	return me.hMdiClient
+}
+
+// Returns the active MDI child, retrieved with [WM_MDIGETACTIVE], or nil if
+// there is none.
+//
+// [WM_MDIGETACTIVE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-mdigetactive
+func (me *MdiFrame) ActiveChild() *MdiChild {
+	if me.hMdiClient == 0 {
+		return nil
+	}
+	hActive, _ := me.hMdiClient.SendMessage(co.WM_MDIGETACTIVE, 0, 0)
+	for _, child := range me.children {
+		if child.hWnd == win.HWND(hActive) {
+			return child
+		}
+	}
+	return nil
+}
+
+// Arranges the minimized MDI children by sending [WM_MDIICONARRANGE].
+//
+// [WM_MDIICONARRANGE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-mdiiconarrange
+func (me *MdiFrame) ArrangeIcons() {
+	me.hMdiClient.SendMessage(co.WM_MDIICONARRANGE, 0, 0)
+}
+
+// Arranges the MDI children in a cascade by sending [WM_MDICASCADE].
+//
+// [WM_MDICASCADE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-mdicascade
+func (me *MdiFrame) Cascade() {
+	me.hMdiClient.SendMessage(co.WM_MDICASCADE, win.WPARAM(co.MDITILE_SKIPDISABLED), 0)
+}
+
+// Returns the MDI children currently created, in creation order.
+func (me *MdiFrame) Children() []*MdiChild {
+	children := make([]*MdiChild, len(me.children))
+	copy(children, me.children)
+	return children
+}
+
+// Arranges the MDI children in a tile format by sending [WM_MDITILE].
+//
+// # Example
+//
+//	var wnd *ui.MdiFrame // initialized somewhere
+//
+//	wnd.Tile(co.MDITILE_VERTICAL | co.MDITILE_SKIPDISABLED)
+//
+// [WM_MDITILE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-mditile
+func (me *MdiFrame) Tile(how co.MDITILE) {
+	me.hMdiClient.SendMessage(co.WM_MDITILE, win.WPARAM(how), 0)
+}
+
+func (me *MdiFrame) removeChild(child *MdiChild) {
+	for i, c := range me.children {
+		if c == child {
+			me.children = append(me.children[:i], me.children[i+1:]...)
+			return
+		}
+	}
+}
+
+// Options for [NewMdiFrame]; returned by [OptsMdiFrame].
+type VarOptsMdiFrame struct {
+	className   string
+	classStyle  co.CS
+	classIconId uint16
+	classCursor win.HCURSOR
+	classBrush  win.HBRUSH
+
+	title        string
+	size         win.SIZE
+	style        co.WS
+	exStyle      co.WS_EX
+	menu         win.HMENU
+	accelTable   win.HACCEL
+	windowMenu   win.HMENU
+	firstChildId uint16
+
+	cmdShow        co.SW
+	processDlgMsgs bool
+}
+
+// Options for [NewMdiFrame].
+func OptsMdiFrame() *VarOptsMdiFrame {
+	hCursor, _ := win.HINSTANCE(0).LoadCursor(win.CursorResIdc(co.IDC_ARROW))
+	return &VarOptsMdiFrame{
+		classStyle:     co.CS_DBLCLKS,
+		classCursor:    hCursor,
+		classBrush:     win.HBRUSH(co.COLOR_BTNFACE + 1),
+		style:          co.WS_OVERLAPPEDWINDOW | co.WS_CLIPCHILDREN | co.WS_VISIBLE,
+		size:           win.SIZE{Cx: int32(DpiX(800)), Cy: int32(DpiY(600))},
+		firstChildId:   0xff00,
+		cmdShow:        co.SW_SHOW,
+		processDlgMsgs: true,
+	}
+}
+
+// Class name registered with [RegisterClassEx].
+//
+// Defaults to a computed hash.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+func (o *VarOptsMdiFrame) ClassName(s string) *VarOptsMdiFrame { o.className = s; return o }
+
+// Window class style, passed to [RegisterClassEx].
+//
+// Defaults to co.CS_DBLCLKS.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+func (o *VarOptsMdiFrame) ClassStyle(s co.CS) *VarOptsMdiFrame { o.classStyle = s; return o }
+
+// Icon associated to the window, passed to [RegisterClassEx]. This icon is
+// loaded from the resources with [LoadIcon], using the given resource ID.
+//
+// Defaults to none.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+// [LoadIcon]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-loadiconw
+func (o *VarOptsMdiFrame) ClassIconId(i uint16) *VarOptsMdiFrame { o.classIconId = i; return o }
+
+// Window cursor, passed to [RegisterClassEx].
+//
+// Defaults to stock co.IDC_ARROW.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+func (o *VarOptsMdiFrame) ClassCursor(h win.HCURSOR) *VarOptsMdiFrame { o.classCursor = h; return o }
+
+// Window background brush, passed to [RegisterClassEx].
+//
+// Defaults to co.COLOR_BTNFACE color.
+//
+// [RegisterClassEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+func (o *VarOptsMdiFrame) ClassBrush(h win.HBRUSH) *VarOptsMdiFrame { o.classBrush = h; return o }
+
+// Title of the window, passed to [CreateWindowEx].
+//
+// Defaults to empty string.
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsMdiFrame) Title(t string) *VarOptsMdiFrame { o.title = t; return o }
+
+// Size of client area in pixels, passed to [CreateWindowEx].
+//
+// Defaults to ui.Dpi(800, 600).
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsMdiFrame) Size(cx int, cy int) *VarOptsMdiFrame {
+	o.size.Cx = int32(cx)
+	o.size.Cy = int32(cy)
+	return o
+}
+
+// Window style, passed to [CreateWindowEx].
+//
+// Defaults to co.WS_OVERLAPPEDWINDOW | co.WS_CLIPCHILDREN | co.WS_VISIBLE.
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsMdiFrame) Style(s co.WS) *VarOptsMdiFrame { o.style = s; return o }
+
+// Extended window style, passed to [CreateWindowEx].
+//
+// Defaults to co.WS_EX_LEFT.
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsMdiFrame) ExStyle(s co.WS_EX) *VarOptsMdiFrame { o.exStyle = s; return o }
+
+// Frame window menu, passed to [CreateWindowEx].
+//
+// Defaults to none.
+//
+// [CreateWindowEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+func (o *VarOptsMdiFrame) Menu(m win.HMENU) *VarOptsMdiFrame { o.menu = m; return o }
+
+// Main accelerator table to the window. The MDI keystrokes, like Ctrl+F4 and
+// Ctrl+F6, are always processed with [TranslateMDISysAccel].
+//
+// Defaults to none.
+//
+// [TranslateMDISysAccel]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-translatemdisysaccel
+func (o *VarOptsMdiFrame) AccelTable(a win.HACCEL) *VarOptsMdiFrame { o.accelTable = a; return o }
+
+// Submenu of the frame menu where the system appends the list of MDI
+// children, usually titled "Window". Passed to the MDICLIENT window in
+// [CLIENTCREATESTRUCT].
+//
+// Defaults to none.
+//
+// [CLIENTCREATESTRUCT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-clientcreatestruct
+func (o *VarOptsMdiFrame) WindowMenu(m win.HMENU) *VarOptsMdiFrame { o.windowMenu = m; return o }
+
+// Command ID of the first MDI child in the window menu; the next ones are
+// incremented by one. Must not collide with the IDs of the other menu items.
+// Passed to the MDICLIENT window in [CLIENTCREATESTRUCT].
+//
+// Defaults to 0xff00.
+//
+// [CLIENTCREATESTRUCT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-clientcreatestruct
+func (o *VarOptsMdiFrame) FirstChildId(id uint16) *VarOptsMdiFrame { o.firstChildId = id; return o }
+
+// Initial window exhibition state, passed to [ShowWindow].
+//
+// Defaults to co.SW_SHOW.
+//
+// [ShowWindow]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-showwindow
+func (o *VarOptsMdiFrame) CmdShow(c co.SW) *VarOptsMdiFrame { o.cmdShow = c; return o }
+
+// Calls [IsDialogMessage] in the window loop, so the child controls of the MDI
+// children will properly work. The MDICLIENT window and the MDI children are
+// created with co.WS_EX_CONTROLPARENT, so the keyboard navigation reaches the
+// controls of the active child. See [VarOptsMain.ProcessDlgMsgs] for details.
+//
+// Defaults to true.
+//
+// [IsDialogMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-isdialogmessagew
+func (o *VarOptsMdiFrame) ProcessDlgMsgs(p bool) *VarOptsMdiFrame { o.processDlgMsgs = p; return o }
@@ -52,7 +52,50 @@ func NewModal(parent Parent, opts *VarOptsModal) *Modal {
 func NewModalDlg(parent Parent, dlgId uint16) *Modal {
 	return &Modal{
 		raw: nil,
-		dlg: newModalDlg(parent, dlgId),
+		dlg: newModalDlg(parent, dlgId, nil),
+	}
+}
+
+// Creates a new dialog-based Modal with [DialogBoxIndirectParam], from an
+// in-memory dialog template, so no dialog resource is needed.
+//
+// The template should have the WS_POPUP, WS_CAPTION and WS_SYSMENU styles, and
+// the co.DS_MODALFRAME and co.DS_SHELLFONT dialog styles, with an 8-point
+// "MS Shell Dlg" font.
+//
+// Panics if the template cannot be serialized.
+//
+// # Example
+//
+//	var wndParent ui.Parent // initialized somewhere
+//
+//	wndModal := ui.NewModalDlgTemplate(wndParent,
+//		&ui.DlgTemplate{
+//			Style: co.WS_POPUP | co.WS_CAPTION | co.WS_SYSMENU |
+//				co.WS(co.DS_MODALFRAME|co.DS_SHELLFONT),
+//			Cx: 180, Cy: 60,
+//			Title:    "Hello modal",
+//			FontSize: 8,
+//			FontFace: "MS Shell Dlg",
+//			Controls: []ui.DlgTemplateCtrl{
+//				{
+//					Style: co.WS_CHILD | co.WS_VISIBLE | co.WS_TABSTOP |
+//						co.WS(co.BS_DEFPUSHBUTTON),
+//					X: 120, Y: 40, Cx: 50, Cy: 14,
+//					Id:        uint16(co.ID_OK),
+//					ClassName: "Button",
+//					Title:     "OK",
+//				},
+//			},
+//		},
+//	)
+//	wndModal.ShowModal()
+//
+// [DialogBoxIndirectParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-dialogboxindirectparamw
+func NewModalDlgTemplate(parent Parent, template *DlgTemplate) *Modal {
+	return &Modal{
+		raw: nil,
+		dlg: newModalDlg(parent, 0, template),
 	}
 }
 
@@ -83,12 +126,9 @@ func (me *Modal) Hwnd() win.HWND {
 //
 // Implements [Parent].
 //
-// Panics if called after the window has been created.
+// Can also be called after the window has been created, so handlers can be
+// added and removed at runtime; see [EventsWindow] for details.
 func (me *Modal) On() *EventsWindow {
-	if me.Hwnd() != 0 {
-		panic("Cannot add event handling after the window has been created.")
-	}
-
 	if me.raw != nil {
 		return &me.raw.userEvents
 	} else {
@@ -128,6 +168,16 @@ func (me *Modal) UiThread(fun func()) {
 	}
 }
 
+// Returns the current DPI of the window, which changes when it's moved to a
+// monitor with a different scaling factor. Before the window is created,
+// returns the system DPI.
+//
+// Values computed with [DpiX] and [DpiY] can be converted to this DPI with
+// [DpiScale].
+func (me *Modal) Dpi() int {
+	return me.base().dpi
+}
+
 // Implements [Parent].
 func (me *Modal) base() *_BaseContainer {
 	if me.raw != nil {
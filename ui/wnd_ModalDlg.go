@@ -12,9 +12,9 @@ type _ModalDlg struct {
 	parent Parent
 }
 
-func newModalDlg(parent Parent, dlgId uint16) *_ModalDlg {
+func newModalDlg(parent Parent, dlgId uint16, template *DlgTemplate) *_ModalDlg {
 	me := &_ModalDlg{
-		_BaseDlg: newBaseDlg(dlgId),
+		_BaseDlg: newBaseDlg(dlgId, template),
 		parent:   parent,
 	}
 	me.defaultMessageHandlers()
@@ -0,0 +1,281 @@
+//go:build windows
+
+package ui
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// Modal [property sheet], a window with tabbed pages and OK, Cancel and Apply
+// buttons. When created with [NewWizard], the pages are shown in sequence,
+// with Back, Next and Finish buttons.
+//
+// Each page is a [PropertySheetPage], which is a [Control] – thus a [Parent]
+// to its own child controls.
+//
+// Implements:
+//   - [Window]
+//
+// [property sheet]: https://learn.microsoft.com/en-us/windows/win32/controls/property-sheets
+type PropertySheet struct {
+	hWnd      win.HWND
+	parent    Parent
+	opts      *VarOptsPropertySheet
+	wizard    bool
+	pages     []*PropertySheetPage
+	goingBack bool // wizard Back was clicked, so the page must not be validated
+	finished  bool // wizard Finish was accepted
+}
+
+// Creates a new modal property sheet. Pages must be added with
+// [NewPropertySheetPage], then the sheet is shown with
+// [PropertySheet.ShowModal].
+//
+// # Example
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	sheet := ui.NewPropertySheet(wndOwner,
+//		ui.OptsPropertySheet().
+//			Title("Options"),
+//	)
+//	pgGeneral := ui.NewPropertySheetPage(sheet,
+//		ui.OptsPropertySheetPage().
+//			Title("General"),
+//	)
+//	txtName := ui.NewEdit(pgGeneral, ui.OptsEdit())
+//
+//	pgGeneral.OnPage().PsnApply(func(_ bool) bool {
+//		return saveName(txtName.Text())
+//	})
+//
+//	sheet.ShowModal()
+func NewPropertySheet(parent Parent, opts *VarOptsPropertySheet) *PropertySheet {
+	return &PropertySheet{
+		parent: parent,
+		opts:   opts,
+		pages:  make([]*PropertySheetPage, 0),
+	}
+}
+
+// Creates a new modal wizard, a property sheet whose pages are shown in
+// sequence. The Back, Next and Finish buttons are automatically enabled
+// according to the position of the current page, and can be changed with
+// [PropertySheet.SetWizardButtons].
+//
+// Pages must be added with [NewPropertySheetPage], then the wizard is shown
+// with [PropertySheet.ShowModal].
+func NewWizard(parent Parent, opts *VarOptsPropertySheet) *PropertySheet {
+	me := NewPropertySheet(parent, opts)
+	me.wizard = true
+	return me
+}
+
+// Physically creates the property sheet and its pages, then runs the modal
+// loop with [PropertySheet]. This method will block until the window is
+// closed.
+//
+// Returns true if the changes were saved with OK or Apply, or if the wizard
+// was finished.
+//
+// Panics if no pages were added.
+//
+// [PropertySheet]: https://learn.microsoft.com/en-us/windows/win32/api/prsht/nf-prsht-propertysheetw
+func (me *PropertySheet) ShowModal() bool {
+	if len(me.pages) == 0 {
+		panic("A property sheet must have at least one page.")
+	}
+
+	hInst, _ := me.parent.Hwnd().HInstance()
+	wbuf := wstr.NewBufEncoder() // to keep all strings used in the call
+	defer wbuf.Free()
+
+	psps := make([]win.PROPSHEETPAGE, len(me.pages))
+	for i, page := range me.pages {
+		page.serialize(hInst, &wbuf, &psps[i])
+	}
+
+	var psh win.PROPSHEETHEADER
+	psh.SetDwSize()
+	psh.DwFlags = co.PSH_PROPSHEETPAGE | me.opts.flags
+	if me.wizard {
+		psh.DwFlags |= co.PSH_WIZARD
+	}
+	psh.HwndParent = me.parent.Hwnd()
+	psh.HInstance = hInst
+	psh.PszCaption = (*uint16)(wbuf.PtrAllowEmpty(me.opts.title))
+	psh.NPages = uint32(len(psps))
+	psh.NStartPage = uintptr(me.opts.startPage)
+	psh.Ppsp = &psps[0]
+
+	me.goingBack, me.finished = false, false
+	ret, err := win.PropertySheet(&psh)
+	me.hWnd = win.HWND(0)
+	if err != nil {
+		panic(err)
+	}
+	return ret > 0 || me.finished
+}
+
+// Returns the underlying HWND handle of this window.
+//
+// Implements [Window].
+//
+// Note that this handle exists only while the property sheet is shown.
+func (me *PropertySheet) Hwnd() win.HWND {
+	return me.hWnd
+}
+
+// Returns the pages added with [NewPropertySheetPage], in order.
+func (me *PropertySheet) Pages() []*PropertySheetPage {
+	return me.pages
+}
+
+// Returns true if the property sheet was created with [NewWizard].
+func (me *PropertySheet) IsWizard() bool {
+	return me.wizard
+}
+
+// Returns the index of the current page with [PSM_GETCURRENTPAGEHWND], or -1
+// if the property sheet is not shown.
+//
+// [PSM_GETCURRENTPAGEHWND]: https://learn.microsoft.com/en-us/windows/win32/controls/psm-getcurrentpagehwnd
+func (me *PropertySheet) CurrentPage() int {
+	if me.hWnd == 0 {
+		return -1
+	}
+	hPage, _ := me.hWnd.SendMessage(co.PSM_GETCURRENTPAGEHWND, 0, 0)
+	idx, _ := me.hWnd.SendMessage(co.PSM_HWNDTOINDEX, win.WPARAM(hPage), 0)
+	return int(int32(idx))
+}
+
+// Disables the Cancel button and changes the OK button text to "Close", with
+// [PSM_CANCELTOCLOSE]. Should be called after an irreversible change.
+//
+// [PSM_CANCELTOCLOSE]: https://learn.microsoft.com/en-us/windows/win32/controls/psm-canceltoclose
+func (me *PropertySheet) CancelToClose() {
+	me.hWnd.PostMessage(co.PSM_CANCELTOCLOSE, 0, 0)
+}
+
+// Simulates the click of a button with [PSM_PRESSBUTTON].
+//
+// [PSM_PRESSBUTTON]: https://learn.microsoft.com/en-us/windows/win32/controls/psm-pressbutton
+func (me *PropertySheet) PressButton(btn co.PSBTN) {
+	me.hWnd.PostMessage(co.PSM_PRESSBUTTON, win.WPARAM(btn), 0)
+}
+
+// Activates the page at the given index with [PSM_SETCURSEL]. The current page
+// is validated first, so the change may be refused.
+//
+// [PSM_SETCURSEL]: https://learn.microsoft.com/en-us/windows/win32/controls/psm-setcursel
+func (me *PropertySheet) SetCurrentPage(index int) {
+	me.hWnd.SendMessage(co.PSM_SETCURSEL, win.WPARAM(index), 0)
+}
+
+// Replaces the Finish button text of a wizard, with [PSM_SETFINISHTEXT]. The
+// Back and Next buttons are hidden.
+//
+// [PSM_SETFINISHTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/psm-setfinishtext
+func (me *PropertySheet) SetFinishText(text string) {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	me.hWnd.SendMessage(co.PSM_SETFINISHTEXT,
+		0, win.LPARAM(wbuf.PtrAllowEmpty(text)))
+}
+
+// Sets the title of the property sheet with [PSM_SETTITLE].
+//
+// [PSM_SETTITLE]: https://learn.microsoft.com/en-us/windows/win32/controls/psm-settitle
+func (me *PropertySheet) SetTitle(title string) {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	me.hWnd.SendMessage(co.PSM_SETTITLE,
+		0, win.LPARAM(wbuf.PtrAllowEmpty(title)))
+}
+
+// Enables the Back, Next and Finish buttons of a wizard with
+// [PSM_SETWIZBUTTONS].
+//
+// The buttons are automatically set when each page is activated, so this
+// method is usually called within [EventsPropertySheetPage.PsnSetActive], or
+// when the user changes the contents of the page.
+//
+// [PSM_SETWIZBUTTONS]: https://learn.microsoft.com/en-us/windows/win32/controls/psm-setwizbuttons
+func (me *PropertySheet) SetWizardButtons(btns co.PSWIZB) {
+	me.hWnd.PostMessage(co.PSM_SETWIZBUTTONS, 0, win.LPARAM(btns))
+}
+
+// Sets the wizard buttons according to the position of the page.
+func (me *PropertySheet) setDefaultWizardButtons(index int) {
+	var btns co.PSWIZB
+	if index > 0 {
+		btns |= co.PSWIZB_BACK
+	}
+	if index < len(me.pages)-1 {
+		btns |= co.PSWIZB_NEXT
+	} else {
+		btns |= co.PSWIZB_FINISH
+	}
+	me.SetWizardButtons(btns)
+}
+
+var _propSheetPageProcCallback uintptr
+
+// Dialog procedure of the property sheet pages, which receive a PROPSHEETPAGE
+// in WM_INITDIALOG, instead of the object pointer itself.
+func propSheetPageProcCallback() uintptr {
+	if _propSheetPageProcCallback != 0 {
+		return _propSheetPageProcCallback
+	}
+
+	_propSheetPageProcCallback = syscall.NewCallback(
+		func(hDlg win.HWND, uMsg co.WM, wParam win.WPARAM, lParam win.LPARAM) uintptr {
+			if uMsg == co.WM_INITDIALOG {
+				lParam = (*win.PROPSHEETPAGE)(unsafe.Pointer(lParam)).LParam
+			}
+			return dlgProc(hDlg, uMsg, wParam, lParam)
+		},
+	)
+	return _propSheetPageProcCallback
+}
+
+// Options for [NewPropertySheet] and [NewWizard]; returned by
+// [OptsPropertySheet].
+type VarOptsPropertySheet struct {
+	title     string
+	flags     co.PSH
+	startPage int
+}
+
+// Options for [NewPropertySheet] and [NewWizard].
+func OptsPropertySheet() *VarOptsPropertySheet {
+	return &VarOptsPropertySheet{
+		flags: co.PSH_NOCONTEXTHELP,
+	}
+}
+
+// Title of the property sheet.
+//
+// Defaults to empty string.
+func (o *VarOptsPropertySheet) Title(t string) *VarOptsPropertySheet { o.title = t; return o }
+
+// Flags passed to [PropertySheet]. For example, co.PSH_NOAPPLYNOW hides the
+// Apply button, and co.PSH_WIZARD97 or co.PSH_AEROWIZARD change the wizard
+// style.
+//
+// Defaults to co.PSH_NOCONTEXTHELP.
+//
+// [PropertySheet]: https://learn.microsoft.com/en-us/windows/win32/api/prsht/nf-prsht-propertysheetw
+func (o *VarOptsPropertySheet) Flags(f co.PSH) *VarOptsPropertySheet { o.flags = f; return o }
+
+// Index of the page initially shown.
+//
+// Defaults to 0.
+func (o *VarOptsPropertySheet) StartPage(i int) *VarOptsPropertySheet { o.startPage = i; return o }
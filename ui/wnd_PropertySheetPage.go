@@ -0,0 +1,407 @@
+//go:build windows
+
+package ui
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// A page of a [PropertySheet].
+//
+// The page is a [Control], so child controls can be created within it, and its
+// window messages can be handled with [Control.On]. The property sheet
+// notifications are handled with [PropertySheetPage.OnPage].
+//
+// The page is created only when it's first shown, so its child controls may
+// not exist when the property sheet is closed.
+//
+// Implements:
+//   - [Window]
+//   - [ChildControl]
+//   - [Parent]
+type PropertySheetPage struct {
+	*Control
+	sheet  *PropertySheet
+	index  int
+	opts   *VarOptsPropertySheetPage
+	events EventsPropertySheetPage
+}
+
+// Creates a new page, appended to the given property sheet. If a dialog
+// resource ID or a template is informed in the options, the page will be
+// loaded from it; otherwise, a blank page is created.
+//
+// Panics if the property sheet is already shown.
+//
+// # Example
+//
+//	var sheet *ui.PropertySheet // initialized somewhere
+//
+//	pgNetwork := ui.NewPropertySheetPage(sheet,
+//		ui.OptsPropertySheetPage().
+//			Title("Network").
+//			Size(ui.DpiX(320), ui.DpiY(240)),
+//	)
+//	chkProxy := ui.NewCheckBox(pgNetwork,
+//		ui.OptsCheckBox().
+//			Text("Use proxy"),
+//	)
+//
+//	pgNetwork.OnPage().PsnKillActive(func() bool {
+//		return !chkProxy.IsChecked() || proxyIsValid()
+//	})
+func NewPropertySheetPage(sheet *PropertySheet, opts *VarOptsPropertySheetPage) *PropertySheetPage {
+	if sheet.hWnd != 0 {
+		panic("Cannot add a page after the property sheet is shown.")
+	}
+
+	dlg := &_ControlDlg{
+		_BaseDlg: _BaseDlg{
+			_BaseContainer: newBaseContainer(_WNDTY_DLG),
+			dlgId:          opts.dlgId,
+		},
+	}
+	dlg.defaultMessageHandlers()
+
+	me := &PropertySheetPage{
+		Control: &Control{dlg: dlg, parent: sheet.parent},
+		sheet:   sheet,
+		index:   len(sheet.pages),
+		opts:    opts,
+	}
+	if opts.template != nil {
+		dlg.template = serializeDlgTemplate(opts.template)
+	} else if opts.dlgId == 0 {
+		dlg.template = serializeDlgTemplate(blankPageTemplate(opts.size))
+	}
+	sheet.pages = append(sheet.pages, me)
+
+	me.defaultMessageHandlers()
+	return me
+}
+
+// Builds an in-memory dialog template, with no controls, menu, class or title.
+func blankPageTemplate(size win.SIZE) *DlgTemplate {
+	baseX, baseY := win.GetDialogBaseUnits() // no font, so the system font is used
+	style := co.WS_CHILD | co.WS_DISABLED | co.WS_CAPTION | co.WS(co.DS_3DLOOK|co.DS_CONTROL)
+
+	return &DlgTemplate{
+		Style: style,
+		Cx:    int16(int(size.Cx) * 4 / int(baseX)), // in dialog units
+		Cy:    int16(int(size.Cy) * 8 / int(baseY)),
+	}
+}
+
+// Fills the PROPSHEETPAGE, which will be passed to PropertySheet.
+func (me *PropertySheetPage) serialize(
+	hInst win.HINSTANCE, pStrsBuf *wstr.BufEncoder, psp *win.PROPSHEETPAGE) {
+
+	psp.SetDwSize()
+	psp.HInstance = hInst
+	if me.dlg.template != nil {
+		psp.DwFlags |= co.PSP_DLGINDIRECT
+		psp.PResource = uintptr(unsafe.Pointer(me.dlg.pTemplate()))
+	} else {
+		psp.PResource = uintptr(me.opts.dlgId) // MAKEINTRESOURCE
+	}
+	if me.opts.title != "" {
+		psp.DwFlags |= co.PSP_USETITLE
+		psp.PszTitle = (*uint16)(pStrsBuf.PtrAllowEmpty(me.opts.title))
+	}
+	if me.opts.headerTitle != "" {
+		psp.DwFlags |= co.PSP_USEHEADERTITLE
+		psp.PszHeaderTitle = (*uint16)(pStrsBuf.PtrAllowEmpty(me.opts.headerTitle))
+	}
+	if me.opts.headerSubTitle != "" {
+		psp.DwFlags |= co.PSP_USEHEADERSUBTITLE
+		psp.PszHeaderSubTitle = (*uint16)(pStrsBuf.PtrAllowEmpty(me.opts.headerSubTitle))
+	}
+	psp.PfnDlgProc = propSheetPageProcCallback()
+	psp.LParam = win.LPARAM(unsafe.Pointer(&me.dlg._BaseDlg)) // pass pointer to object itself
+}
+
+func (me *PropertySheetPage) defaultMessageHandlers() {
+	before := &me.dlg.beforeUserEvents
+
+	before.WmInitDialog(func(_ WmInitDialog) bool {
+		me.sheet.hWnd, _ = me.Hwnd().GetAncestor(co.GA_PARENT)
+		return true // ignored
+	})
+
+	before.WmNotify(0, co.PSN_SETACTIVE, func(_ unsafe.Pointer) uintptr {
+		me.sheet.goingBack = false
+		if me.sheet.wizard {
+			me.sheet.setDefaultWizardButtons(me.index)
+		}
+		if me.events.setActive != nil {
+			me.events.setActive()
+		}
+		return me.setResult(0) // accept activation
+	})
+
+	before.WmNotify(0, co.PSN_KILLACTIVE, func(_ unsafe.Pointer) uintptr {
+		goingBack := me.sheet.goingBack
+		me.sheet.goingBack = false
+		return me.setResult(utl.BoolToUintptr(!goingBack && !me.validate())) // TRUE prevents the change
+	})
+
+	before.WmNotify(0, co.PSN_APPLY, func(p unsafe.Pointer) uintptr {
+		ret := co.PSNRET_NOERROR
+		if me.events.apply != nil {
+			closing := (*win.PSHNOTIFY)(p).LParam != 0
+			if !me.events.apply(closing) {
+				ret = co.PSNRET_INVALID // keeps the sheet open, showing this page
+			}
+		}
+		return me.setResult(uintptr(ret))
+	})
+
+	before.WmNotify(0, co.PSN_RESET, func(_ unsafe.Pointer) uintptr {
+		if me.events.reset != nil {
+			me.events.reset()
+		}
+		return 0 // ignored
+	})
+
+	before.WmNotify(0, co.PSN_QUERYCANCEL, func(_ unsafe.Pointer) uintptr {
+		allow := me.events.queryCancel == nil || me.events.queryCancel()
+		return me.setResult(utl.BoolToUintptr(!allow)) // TRUE prevents the cancel
+	})
+
+	before.WmNotify(0, co.PSN_WIZBACK, func(_ unsafe.Pointer) uintptr {
+		if me.events.wizBack != nil && !me.events.wizBack() {
+			return me.setResult(^uintptr(0)) // -1 prevents the change
+		}
+		me.sheet.goingBack = true
+		return me.setResult(0)
+	})
+
+	before.WmNotify(0, co.PSN_WIZNEXT, func(_ unsafe.Pointer) uintptr {
+		if me.events.wizNext != nil && !me.events.wizNext() {
+			return me.setResult(^uintptr(0)) // -1 prevents the change
+		}
+		return me.setResult(0)
+	})
+
+	before.WmNotify(0, co.PSN_WIZFINISH, func(_ unsafe.Pointer) uintptr {
+		if !me.validate() || (me.events.wizFinish != nil && !me.events.wizFinish()) {
+			return me.setResult(1) // TRUE prevents the wizard from closing
+		}
+		me.sheet.finished = true
+		return me.setResult(0)
+	})
+}
+
+// Runs the user validation, if any.
+func (me *PropertySheetPage) validate() bool {
+	return me.events.killActive == nil || me.events.killActive()
+}
+
+// Stores the result of a property sheet notification, which must be returned
+// to the dialog through DWLP_MSGRESULT.
+func (me *PropertySheetPage) setResult(ret uintptr) uintptr {
+	me.Hwnd().SetWindowLongPtr(co.GWLP_DWLP_MSGRESULT, ret)
+	return ret
+}
+
+// Exposes the property sheet notifications sent to this page.
+//
+// Since the page is also a [Parent], its window messages are exposed by
+// [Control.On].
+func (me *PropertySheetPage) OnPage() *EventsPropertySheetPage {
+	return &me.events
+}
+
+// Returns the index of this page within its property sheet.
+func (me *PropertySheetPage) Index() int {
+	return me.index
+}
+
+// Returns the property sheet which owns this page.
+func (me *PropertySheetPage) Sheet() *PropertySheet {
+	return me.sheet
+}
+
+// Informs the property sheet whether the contents of this page have changed,
+// with [PSM_CHANGED] or [PSM_UNCHANGED]. The Apply button is enabled while any
+// page is changed.
+//
+// [PSM_CHANGED]: https://learn.microsoft.com/en-us/windows/win32/controls/psm-changed
+// [PSM_UNCHANGED]: https://learn.microsoft.com/en-us/windows/win32/controls/psm-unchanged
+func (me *PropertySheetPage) SetChanged(changed bool) {
+	msg := co.PSM_UNCHANGED
+	if changed {
+		msg = co.PSM_CHANGED
+	}
+	me.sheet.hWnd.SendMessage(msg, win.WPARAM(me.Hwnd()), 0)
+}
+
+// Options for [NewPropertySheetPage]; returned by [OptsPropertySheetPage].
+type VarOptsPropertySheetPage struct {
+	dlgId          uint16
+	template       *DlgTemplate
+	title          string
+	headerTitle    string
+	headerSubTitle string
+	size           win.SIZE
+}
+
+// Options for [NewPropertySheetPage].
+func OptsPropertySheetPage() *VarOptsPropertySheetPage {
+	return &VarOptsPropertySheetPage{
+		size: win.SIZE{Cx: int32(DpiX(300)), Cy: int32(DpiY(200))},
+	}
+}
+
+// Dialog resource ID of the page. The dialog must have the WS_CHILD style.
+//
+// Defaults to none, which creates a blank page with the given
+// [VarOptsPropertySheetPage.Size].
+func (o *VarOptsPropertySheetPage) DlgId(id uint16) *VarOptsPropertySheetPage {
+	o.dlgId = id
+	return o
+}
+
+// In-memory dialog template of the page, so no dialog resource is needed.
+// Overrides [VarOptsPropertySheetPage.DlgId]. The template must have the
+// WS_CHILD style.
+//
+// Defaults to none.
+func (o *VarOptsPropertySheetPage) Template(t *DlgTemplate) *VarOptsPropertySheetPage {
+	o.template = t
+	return o
+}
+
+// Text of the tab of the page. For dialog resources, overrides the dialog
+// caption.
+//
+// Defaults to none.
+func (o *VarOptsPropertySheetPage) Title(t string) *VarOptsPropertySheetPage { o.title = t; return o }
+
+// Title shown in the header area of a co.PSH_WIZARD97 or co.PSH_AEROWIZARD
+// wizard.
+//
+// Defaults to none.
+func (o *VarOptsPropertySheetPage) HeaderTitle(t string) *VarOptsPropertySheetPage {
+	o.headerTitle = t
+	return o
+}
+
+// Subtitle shown in the header area of a co.PSH_WIZARD97 wizard.
+//
+// Defaults to none.
+func (o *VarOptsPropertySheetPage) HeaderSubTitle(t string) *VarOptsPropertySheetPage {
+	o.headerSubTitle = t
+	return o
+}
+
+// Size of a blank page, in pixels. Ignored if a dialog resource ID or a
+// template is given.
+// The property sheet is sized to fit its largest page.
+//
+// Defaults to ui.Dpi(300, 200).
+func (o *VarOptsPropertySheetPage) Size(cx, cy int) *VarOptsPropertySheetPage {
+	o.size.Cx = int32(cx)
+	o.size.Cy = int32(cy)
+	return o
+}
+
+// Exposes the [notifications] sent by the [PropertySheet] to a
+// [PropertySheetPage].
+//
+// You cannot create this object directly, it will be created automatically
+// by the owning page.
+//
+// [notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-property-sheets-reference-notifications
+type EventsPropertySheetPage struct {
+	setActive   func()
+	killActive  func() bool
+	apply       func(closing bool) bool
+	reset       func()
+	queryCancel func() bool
+	wizBack     func() bool
+	wizNext     func() bool
+	wizFinish   func() bool
+}
+
+// [PSN_APPLY] message handler, sent to every page already created when OK or
+// Apply is clicked, after the current page was validated. The closing
+// argument tells whether OK was clicked.
+//
+// Return false to keep the property sheet open, showing this page.
+//
+// [PSN_APPLY]: https://learn.microsoft.com/en-us/windows/win32/controls/psn-apply
+func (me *EventsPropertySheetPage) PsnApply(fun func(closing bool) bool) {
+	me.apply = fun
+}
+
+// [PSN_KILLACTIVE] message handler, which validates the page before another
+// page is activated, before OK or Apply, and before a wizard is finished. It's
+// not called when going back in a wizard.
+//
+// Return false to keep the page active.
+//
+// [PSN_KILLACTIVE]: https://learn.microsoft.com/en-us/windows/win32/controls/psn-killactive
+func (me *EventsPropertySheetPage) PsnKillActive(fun func() bool) {
+	me.killActive = fun
+}
+
+// [PSN_QUERYCANCEL] message handler, sent when Cancel is clicked.
+//
+// Return false to prevent the property sheet from closing.
+//
+// [PSN_QUERYCANCEL]: https://learn.microsoft.com/en-us/windows/win32/controls/psn-querycancel
+func (me *EventsPropertySheetPage) PsnQueryCancel(fun func() bool) {
+	me.queryCancel = fun
+}
+
+// [PSN_RESET] message handler, sent to every page already created when the
+// property sheet is cancelled.
+//
+// [PSN_RESET]: https://learn.microsoft.com/en-us/windows/win32/controls/psn-reset
+func (me *EventsPropertySheetPage) PsnReset(fun func()) {
+	me.reset = fun
+}
+
+// [PSN_SETACTIVE] message handler, sent when the page is activated. In a
+// wizard, the buttons were already set, and can be changed with
+// [PropertySheet.SetWizardButtons].
+//
+// [PSN_SETACTIVE]: https://learn.microsoft.com/en-us/windows/win32/controls/psn-setactive
+func (me *EventsPropertySheetPage) PsnSetActive(fun func()) {
+	me.setActive = fun
+}
+
+// [PSN_WIZBACK] message handler, sent when Back is clicked in a wizard.
+//
+// Return false to stay in the page.
+//
+// [PSN_WIZBACK]: https://learn.microsoft.com/en-us/windows/win32/controls/psn-wizback
+func (me *EventsPropertySheetPage) PsnWizBack(fun func() bool) {
+	me.wizBack = fun
+}
+
+// [PSN_WIZFINISH] message handler, sent when Finish is clicked in a wizard,
+// after the page was validated.
+//
+// Return false to keep the wizard open.
+//
+// [PSN_WIZFINISH]: https://learn.microsoft.com/en-us/windows/win32/controls/psn-wizfinish
+func (me *EventsPropertySheetPage) PsnWizFinish(fun func() bool) {
+	me.wizFinish = fun
+}
+
+// [PSN_WIZNEXT] message handler, sent when Next is clicked in a wizard, before
+// the page is validated.
+//
+// Return false to stay in the page.
+//
+// [PSN_WIZNEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/psn-wiznext
+func (me *EventsPropertySheetPage) PsnWizNext(fun func() bool) {
+	me.wizNext = fun
+}
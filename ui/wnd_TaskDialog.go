@@ -0,0 +1,594 @@
+//go:build windows
+
+package ui
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// Modal [task dialog], an enhanced message box with custom buttons, radio
+// buttons, command links, expandable text, footer, verification checkbox,
+// hyperlinks and a progress bar.
+//
+// While the task dialog is shown, it can be updated within the handlers of
+// [TaskDialog.On].
+//
+// For simple message boxes, see [MsgError], [MsgWarn], [MsgOk] and
+// [MsgOkCancel].
+//
+// Implements:
+//   - [Window]
+//
+// [task dialog]: https://learn.microsoft.com/en-us/windows/win32/controls/task-dialogs-overview
+type TaskDialog struct {
+	hWnd   win.HWND
+	parent Parent
+	opts   *VarOptsTaskDialog
+	events EventsTaskDialog
+	result TaskDialogResult
+}
+
+// Result of a [TaskDialog], returned by [TaskDialog.ShowModal].
+type TaskDialogResult struct {
+	Button       co.ID // ID of the button which closed the task dialog.
+	Radio        co.ID // ID of the selected radio button, or zero if none.
+	Verification bool  // State of the verification checkbox.
+}
+
+// Creates a new modal task dialog, which is shown with
+// [TaskDialog.ShowModal]. The parent can be nil.
+//
+// # Example
+//
+//	const (
+//		ID_UPDATE_NOW co.ID = 1001
+//		ID_LATER      co.ID = 1002
+//	)
+//
+//	var wndOwner ui.Parent // initialized somewhere
+//
+//	td := ui.NewTaskDialog(wndOwner,
+//		ui.OptsTaskDialog().
+//			Title("Updates").
+//			Caption("A new version is available").
+//			Body("Version 2.0 brings <a href=\"notes\">many improvements</a>.").
+//			Hyperlinks(true).
+//			CommandLinks(true).
+//			Button(ID_UPDATE_NOW, "Update now\nThe program will restart.").
+//			Button(ID_LATER, "Remind me later").
+//			Verification("Don't ask again", false),
+//	)
+//
+//	td.On().TdnHyperlinkClicked(func(href string) {
+//		showReleaseNotes()
+//	})
+//
+//	res := td.ShowModal()
+//	if res.Button == ID_UPDATE_NOW {
+//		// ...
+//	}
+func NewTaskDialog(parent Parent, opts *VarOptsTaskDialog) *TaskDialog {
+	return &TaskDialog{
+		parent: parent,
+		opts:   opts,
+	}
+}
+
+// Physically creates the task dialog with [TaskDialogIndirect], then runs the
+// modal loop. This method will block until the task dialog is closed.
+//
+// Panics on error.
+//
+// [TaskDialogIndirect]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/nf-commctrl-taskdialogindirect
+func (me *TaskDialog) ShowModal() TaskDialogResult {
+	if me.hWnd != 0 {
+		panic("Cannot show a task dialog twice at the same time.")
+	}
+
+	var hParent win.HWND
+	if me.parent != nil {
+		hParent = me.parent.Hwnd()
+	}
+
+	flags := me.opts.flags
+	if me.events.timer != nil {
+		flags |= co.TDF_CALLBACK_TIMER
+	}
+
+	me.result = TaskDialogResult{
+		Radio:        me.opts.defaultRadio,
+		Verification: (flags & co.TDF_VERIFICATION_FLAG_CHECKED) != 0,
+	}
+	if me.result.Radio == 0 && len(me.opts.radios) > 0 &&
+		(flags&co.TDF_NO_DEFAULT_RADIO_BUTTON) == 0 {
+		me.result.Radio = me.opts.radios[0].Id // first radio is selected by default
+	}
+
+	var hMainIcon, hFooterIcon win.TdcIcon
+	if me.opts.icon != 0 {
+		hMainIcon = win.TdcIconTdi(me.opts.icon)
+	}
+	if me.opts.footerIcon != 0 {
+		hFooterIcon = win.TdcIconTdi(me.opts.footerIcon)
+	}
+
+	ret, err := win.TaskDialogIndirect(win.TASKDIALOGCONFIG{
+		HwndParent:           hParent,
+		Flags:                flags,
+		CommonButtons:        me.opts.commonButtons,
+		WindowTitle:          me.opts.title,
+		HMainIcon:            hMainIcon,
+		MainInstruction:      me.opts.caption,
+		Content:              me.opts.body,
+		Buttons:              me.opts.buttons,
+		DefaultButtonId:      uint16(me.opts.defaultButton),
+		RadioButtons:         me.opts.radios,
+		DefaultRadioButton:   uint16(me.opts.defaultRadio),
+		VerificationText:     me.opts.verificationText,
+		ExpandedInformation:  me.opts.expandedText,
+		ExpandedControlText:  me.opts.expandedLabel,
+		CollapsedControlText: me.opts.collapsedLabel,
+		HFooterIcon:          hFooterIcon,
+		Footer:               me.opts.footer,
+		PfCallback:           taskDialogCallback(),
+		LpCallbackData:       uintptr(unsafe.Pointer(me)),
+		Width:                me.opts.width,
+	})
+	me.hWnd = win.HWND(0)
+	if err != nil {
+		panic(err)
+	}
+
+	me.result.Button = ret
+	return me.result
+}
+
+// Returns the underlying HWND handle of this window.
+//
+// Implements [Window].
+//
+// Note that this handle exists only while the task dialog is shown.
+func (me *TaskDialog) Hwnd() win.HWND {
+	return me.hWnd
+}
+
+// Exposes the task dialog [notifications].
+//
+// [notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-task-dialogs-reference-notifications
+func (me *TaskDialog) On() *EventsTaskDialog {
+	return &me.events
+}
+
+// Simulates the click of a button with [TDM_CLICK_BUTTON], which may close
+// the task dialog.
+//
+// [TDM_CLICK_BUTTON]: https://learn.microsoft.com/en-us/windows/win32/controls/tdm-click-button
+func (me *TaskDialog) ClickButton(id co.ID) {
+	me.hWnd.SendMessage(co.TDM_CLICK_BUTTON, win.WPARAM(id), 0)
+}
+
+// Selects a radio button with [TDM_CLICK_RADIO_BUTTON].
+//
+// [TDM_CLICK_RADIO_BUTTON]: https://learn.microsoft.com/en-us/windows/win32/controls/tdm-click-radio-button
+func (me *TaskDialog) ClickRadioButton(id co.ID) {
+	me.hWnd.SendMessage(co.TDM_CLICK_RADIO_BUTTON, win.WPARAM(id), 0)
+}
+
+// Sets the state of the verification checkbox with [TDM_CLICK_VERIFICATION].
+//
+// [TDM_CLICK_VERIFICATION]: https://learn.microsoft.com/en-us/windows/win32/controls/tdm-click-verification
+func (me *TaskDialog) ClickVerification(checked, setFocus bool) {
+	me.hWnd.SendMessage(co.TDM_CLICK_VERIFICATION,
+		win.WPARAM(utl.BoolToUintptr(checked)), win.LPARAM(utl.BoolToUintptr(setFocus)))
+}
+
+// Enables or disables a button with [TDM_ENABLE_BUTTON].
+//
+// [TDM_ENABLE_BUTTON]: https://learn.microsoft.com/en-us/windows/win32/controls/tdm-enable-button
+func (me *TaskDialog) EnableButton(id co.ID, enable bool) {
+	me.hWnd.SendMessage(co.TDM_ENABLE_BUTTON,
+		win.WPARAM(id), win.LPARAM(utl.BoolToUintptr(enable)))
+}
+
+// Enables or disables a radio button with [TDM_ENABLE_RADIO_BUTTON].
+//
+// [TDM_ENABLE_RADIO_BUTTON]: https://learn.microsoft.com/en-us/windows/win32/controls/tdm-enable-radio-button
+func (me *TaskDialog) EnableRadioButton(id co.ID, enable bool) {
+	me.hWnd.SendMessage(co.TDM_ENABLE_RADIO_BUTTON,
+		win.WPARAM(id), win.LPARAM(utl.BoolToUintptr(enable)))
+}
+
+// Shows or hides the UAC shield icon of a button with
+// [TDM_SET_BUTTON_ELEVATION_REQUIRED_STATE].
+//
+// [TDM_SET_BUTTON_ELEVATION_REQUIRED_STATE]: https://learn.microsoft.com/en-us/windows/win32/controls/tdm-set-button-elevation-required-state
+func (me *TaskDialog) SetButtonElevationRequired(id co.ID, required bool) {
+	me.hWnd.SendMessage(co.TDM_SET_BUTTON_ELEVATION_REQUIRED_STATE,
+		win.WPARAM(id), win.LPARAM(utl.BoolToUintptr(required)))
+}
+
+// Replaces the text of an element with [TDM_SET_ELEMENT_TEXT]. The task
+// dialog may be resized to fit the new text.
+//
+// [TDM_SET_ELEMENT_TEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/tdm-set-element-text
+func (me *TaskDialog) SetElementText(element co.TDE, text string) {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+
+	me.hWnd.SendMessage(co.TDM_SET_ELEMENT_TEXT,
+		win.WPARAM(element), win.LPARAM(wbuf.PtrAllowEmpty(text)))
+}
+
+// Starts or stops the marquee animation of the progress bar, with
+// [TDM_SET_PROGRESS_BAR_MARQUEE]. The task dialog must have been created with
+// a marquee progress bar.
+//
+// [TDM_SET_PROGRESS_BAR_MARQUEE]: https://learn.microsoft.com/en-us/windows/win32/controls/tdm-set-progress-bar-marquee
+func (me *TaskDialog) SetProgressMarquee(animate bool, milliseconds int) {
+	me.hWnd.SendMessage(co.TDM_SET_PROGRESS_BAR_MARQUEE,
+		win.WPARAM(utl.BoolToUintptr(animate)), win.LPARAM(milliseconds))
+}
+
+// Sets the position of the progress bar with [TDM_SET_PROGRESS_BAR_POS].
+//
+// [TDM_SET_PROGRESS_BAR_POS]: https://learn.microsoft.com/en-us/windows/win32/controls/tdm-set-progress-bar-pos
+func (me *TaskDialog) SetProgressPos(pos int) {
+	me.hWnd.SendMessage(co.TDM_SET_PROGRESS_BAR_POS, win.WPARAM(pos), 0)
+}
+
+// Sets the range of the progress bar with [TDM_SET_PROGRESS_BAR_RANGE]. The
+// default range is 0 to 100.
+//
+// [TDM_SET_PROGRESS_BAR_RANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/tdm-set-progress-bar-range
+func (me *TaskDialog) SetProgressRange(min, max int) {
+	me.hWnd.SendMessage(co.TDM_SET_PROGRESS_BAR_RANGE,
+		0, win.MAKELPARAM(uint16(min), uint16(max)))
+}
+
+// Sets the state of the progress bar with [TDM_SET_PROGRESS_BAR_STATE].
+//
+// [TDM_SET_PROGRESS_BAR_STATE]: https://learn.microsoft.com/en-us/windows/win32/controls/tdm-set-progress-bar-state
+func (me *TaskDialog) SetProgressState(state co.PBST) {
+	me.hWnd.SendMessage(co.TDM_SET_PROGRESS_BAR_STATE, win.WPARAM(state), 0)
+}
+
+// Switches the progress bar between marquee and normal modes, with
+// [TDM_SET_MARQUEE_PROGRESS_BAR].
+//
+// [TDM_SET_MARQUEE_PROGRESS_BAR]: https://learn.microsoft.com/en-us/windows/win32/controls/tdm-set-marquee-progress-bar
+func (me *TaskDialog) SetProgressMarqueeMode(marquee bool) {
+	me.hWnd.SendMessage(co.TDM_SET_MARQUEE_PROGRESS_BAR,
+		win.WPARAM(utl.BoolToUintptr(marquee)), 0)
+}
+
+// Replaces one of the standard icons with [TDM_UPDATE_ICON].
+//
+// [TDM_UPDATE_ICON]: https://learn.microsoft.com/en-us/windows/win32/controls/tdm-update-icon
+func (me *TaskDialog) SetIcon(element co.TDIE, icon co.TDICON) {
+	me.hWnd.SendMessage(co.TDM_UPDATE_ICON, win.WPARAM(element), win.LPARAM(icon))
+}
+
+// Processes the notifications; returns S_FALSE to prevent the default action.
+func (me *TaskDialog) processNotify(
+	hWnd win.HWND, tdn co.TDN, wParam win.WPARAM, lParam win.LPARAM) co.HRESULT {
+
+	me.hWnd = hWnd
+	allow := true
+
+	switch tdn {
+	case co.TDN_CREATED:
+		if me.events.created != nil {
+			me.events.created()
+		}
+	case co.TDN_DESTROYED:
+		if me.events.destroyed != nil {
+			me.events.destroyed()
+		}
+	case co.TDN_BUTTON_CLICKED:
+		if me.events.buttonClicked != nil {
+			allow = me.events.buttonClicked(co.ID(wParam))
+		}
+	case co.TDN_RADIO_BUTTON_CLICKED:
+		me.result.Radio = co.ID(wParam)
+		if me.events.radioButtonClicked != nil {
+			me.events.radioButtonClicked(co.ID(wParam))
+		}
+	case co.TDN_VERIFICATION_CLICKED:
+		me.result.Verification = wParam != 0
+		if me.events.verificationClicked != nil {
+			me.events.verificationClicked(wParam != 0)
+		}
+	case co.TDN_EXPANDO_BUTTON_CLICKED:
+		if me.events.expandoButtonClicked != nil {
+			me.events.expandoButtonClicked(wParam != 0)
+		}
+	case co.TDN_HYPERLINK_CLICKED:
+		if me.events.hyperlinkClicked != nil {
+			me.events.hyperlinkClicked(wstr.DecodePtr((*uint16)(unsafe.Pointer(lParam))))
+		}
+	case co.TDN_TIMER:
+		if me.events.timer != nil {
+			allow = !me.events.timer(int(wParam)) // S_FALSE resets the timer
+		}
+	}
+
+	if allow {
+		return co.HRESULT_S_OK
+	}
+	return co.HRESULT_S_FALSE
+}
+
+var _taskDialogCallback uintptr
+
+func taskDialogCallback() uintptr {
+	if _taskDialogCallback != 0 {
+		return _taskDialogCallback
+	}
+
+	_taskDialogCallback = syscall.NewCallback(
+		func(hWnd win.HWND, msg co.TDN, wParam win.WPARAM, lParam win.LPARAM, refData uintptr) uintptr {
+			pMe := (*TaskDialog)(unsafe.Pointer(refData))
+			return uintptr(pMe.processNotify(hWnd, msg, wParam, lParam))
+		},
+	)
+	return _taskDialogCallback
+}
+
+// Options for [NewTaskDialog]; returned by [OptsTaskDialog].
+type VarOptsTaskDialog struct {
+	title            string
+	caption          string
+	body             string
+	icon             co.TDICON
+	flags            co.TDF
+	commonButtons    co.TDCBF
+	buttons          []win.TASKDIALOG_BUTTON
+	defaultButton    co.ID
+	radios           []win.TASKDIALOG_BUTTON
+	defaultRadio     co.ID
+	verificationText string
+	expandedText     string
+	expandedLabel    string
+	collapsedLabel   string
+	footer           string
+	footerIcon       co.TDICON
+	width            int
+}
+
+// Options for [NewTaskDialog].
+func OptsTaskDialog() *VarOptsTaskDialog {
+	return &VarOptsTaskDialog{
+		flags: co.TDF_ALLOW_DIALOG_CANCELLATION | co.TDF_POSITION_RELATIVE_TO_WINDOW,
+	}
+}
+
+// Title of the task dialog window.
+//
+// Defaults to the executable name.
+func (o *VarOptsTaskDialog) Title(t string) *VarOptsTaskDialog { o.title = t; return o }
+
+// Big caption above the body text; main instruction.
+//
+// Defaults to none.
+func (o *VarOptsTaskDialog) Caption(c string) *VarOptsTaskDialog { o.caption = c; return o }
+
+// Body text; content. If [VarOptsTaskDialog.Hyperlinks] is set, may contain
+// <a href="..."> links.
+//
+// Defaults to none.
+func (o *VarOptsTaskDialog) Body(b string) *VarOptsTaskDialog { o.body = b; return o }
+
+// Main icon.
+//
+// Defaults to none.
+func (o *VarOptsTaskDialog) Icon(i co.TDICON) *VarOptsTaskDialog { o.icon = i; return o }
+
+// Task dialog flags. Some flags are set automatically by other options.
+//
+// Defaults to co.TDF_ALLOW_DIALOG_CANCELLATION |
+// co.TDF_POSITION_RELATIVE_TO_WINDOW.
+func (o *VarOptsTaskDialog) Flags(f co.TDF) *VarOptsTaskDialog { o.flags = f; return o }
+
+// Standard buttons, like OK and Cancel, whose IDs are co.ID_OK, co.ID_CANCEL
+// and so on.
+//
+// Defaults to co.TDCBF_OK, if no custom buttons are added.
+func (o *VarOptsTaskDialog) CommonButtons(b co.TDCBF) *VarOptsTaskDialog {
+	o.commonButtons = b
+	return o
+}
+
+// Adds a custom button. If [VarOptsTaskDialog.CommandLinks] is set, the text
+// after the first line break is shown as a note below the main text.
+func (o *VarOptsTaskDialog) Button(id co.ID, text string) *VarOptsTaskDialog {
+	o.buttons = append(o.buttons, win.TASKDIALOG_BUTTON{Id: id, Text: text})
+	return o
+}
+
+// ID of the button initially focused.
+//
+// Defaults to the first button.
+func (o *VarOptsTaskDialog) DefaultButton(id co.ID) *VarOptsTaskDialog {
+	o.defaultButton = id
+	return o
+}
+
+// Shows the custom buttons as command links, instead of push buttons.
+//
+// Defaults to false.
+func (o *VarOptsTaskDialog) CommandLinks(c bool) *VarOptsTaskDialog {
+	o.setFlag(co.TDF_USE_COMMAND_LINKS, c)
+	return o
+}
+
+// Adds a radio button.
+func (o *VarOptsTaskDialog) RadioButton(id co.ID, text string) *VarOptsTaskDialog {
+	o.radios = append(o.radios, win.TASKDIALOG_BUTTON{Id: id, Text: text})
+	return o
+}
+
+// ID of the radio button initially selected.
+//
+// Defaults to the first radio button, unless co.TDF_NO_DEFAULT_RADIO_BUTTON
+// is set.
+func (o *VarOptsTaskDialog) DefaultRadio(id co.ID) *VarOptsTaskDialog {
+	o.defaultRadio = id
+	return o
+}
+
+// Adds a verification checkbox with the given text and initial state.
+//
+// Defaults to none.
+func (o *VarOptsTaskDialog) Verification(text string, checked bool) *VarOptsTaskDialog {
+	o.verificationText = text
+	o.setFlag(co.TDF_VERIFICATION_FLAG_CHECKED, checked)
+	return o
+}
+
+// Additional text, shown when the user clicks the expando button.
+//
+// Defaults to none.
+func (o *VarOptsTaskDialog) ExpandedText(t string) *VarOptsTaskDialog {
+	o.expandedText = t
+	return o
+}
+
+// Labels of the expando button, when the additional text is expanded and
+// collapsed.
+//
+// Defaults to the system labels.
+func (o *VarOptsTaskDialog) ExpandoLabels(expanded, collapsed string) *VarOptsTaskDialog {
+	o.expandedLabel = expanded
+	o.collapsedLabel = collapsed
+	return o
+}
+
+// Text of the footer, at the bottom of the task dialog, with an optional icon.
+// If [VarOptsTaskDialog.Hyperlinks] is set, may contain <a href="..."> links.
+//
+// Defaults to none.
+func (o *VarOptsTaskDialog) Footer(text string, icon co.TDICON) *VarOptsTaskDialog {
+	o.footer = text
+	o.footerIcon = icon
+	return o
+}
+
+// Renders the <a href="..."> links in the body, footer and expanded text,
+// which are handled by [EventsTaskDialog.TdnHyperlinkClicked].
+//
+// Defaults to false.
+func (o *VarOptsTaskDialog) Hyperlinks(h bool) *VarOptsTaskDialog {
+	o.setFlag(co.TDF_ENABLE_HYPERLINKS, h)
+	return o
+}
+
+// Shows a progress bar, which can be updated with [TaskDialog.SetProgressPos]
+// or, if marquee, animated with [TaskDialog.SetProgressMarquee].
+//
+// Defaults to none.
+func (o *VarOptsTaskDialog) ProgressBar(marquee bool) *VarOptsTaskDialog {
+	o.setFlag(co.TDF_SHOW_PROGRESS_BAR, !marquee)
+	o.setFlag(co.TDF_SHOW_MARQUEE_PROGRESS_BAR, marquee)
+	return o
+}
+
+// Width of the task dialog client area, in dialog units.
+//
+// Defaults to 0, which computes the ideal width.
+func (o *VarOptsTaskDialog) Width(w int) *VarOptsTaskDialog { o.width = w; return o }
+
+func (o *VarOptsTaskDialog) setFlag(flag co.TDF, set bool) {
+	if set {
+		o.flags |= flag
+	} else {
+		o.flags &^= flag
+	}
+}
+
+// Exposes the task dialog [notifications].
+//
+// You cannot create this object directly, it will be created automatically
+// by the owning task dialog.
+//
+// [notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-task-dialogs-reference-notifications
+type EventsTaskDialog struct {
+	created              func()
+	destroyed            func()
+	buttonClicked        func(id co.ID) bool
+	radioButtonClicked   func(id co.ID)
+	verificationClicked  func(checked bool)
+	expandoButtonClicked func(expanded bool)
+	hyperlinkClicked     func(href string)
+	timer                func(elapsedMs int) bool
+}
+
+// [TDN_BUTTON_CLICKED] message handler.
+//
+// Return false to keep the task dialog open.
+//
+// [TDN_BUTTON_CLICKED]: https://learn.microsoft.com/en-us/windows/win32/controls/tdn-button-clicked
+func (me *EventsTaskDialog) TdnButtonClicked(fun func(id co.ID) bool) {
+	me.buttonClicked = fun
+}
+
+// [TDN_CREATED] message handler, ideal to initialize the progress bar and the
+// state of the buttons.
+//
+// [TDN_CREATED]: https://learn.microsoft.com/en-us/windows/win32/controls/tdn-created
+func (me *EventsTaskDialog) TdnCreated(fun func()) {
+	me.created = fun
+}
+
+// [TDN_DESTROYED] message handler.
+//
+// [TDN_DESTROYED]: https://learn.microsoft.com/en-us/windows/win32/controls/tdn-destroyed
+func (me *EventsTaskDialog) TdnDestroyed(fun func()) {
+	me.destroyed = fun
+}
+
+// [TDN_EXPANDO_BUTTON_CLICKED] message handler.
+//
+// [TDN_EXPANDO_BUTTON_CLICKED]: https://learn.microsoft.com/en-us/windows/win32/controls/tdn-expando-button-clicked
+func (me *EventsTaskDialog) TdnExpandoButtonClicked(fun func(expanded bool)) {
+	me.expandoButtonClicked = fun
+}
+
+// [TDN_HYPERLINK_CLICKED] message handler. Requires
+// [VarOptsTaskDialog.Hyperlinks].
+//
+// [TDN_HYPERLINK_CLICKED]: https://learn.microsoft.com/en-us/windows/win32/controls/tdn-hyperlink-clicked
+func (me *EventsTaskDialog) TdnHyperlinkClicked(fun func(href string)) {
+	me.hyperlinkClicked = fun
+}
+
+// [TDN_RADIO_BUTTON_CLICKED] message handler.
+//
+// [TDN_RADIO_BUTTON_CLICKED]: https://learn.microsoft.com/en-us/windows/win32/controls/tdn-radio-button-clicked
+func (me *EventsTaskDialog) TdnRadioButtonClicked(fun func(id co.ID)) {
+	me.radioButtonClicked = fun
+}
+
+// [TDN_TIMER] message handler, called approximately every 200 milliseconds,
+// with the time elapsed since the task dialog was created or the timer was
+// reset. Setting this handler before [TaskDialog.ShowModal] automatically
+// adds the co.TDF_CALLBACK_TIMER flag.
+//
+// Return true to reset the timer.
+//
+// [TDN_TIMER]: https://learn.microsoft.com/en-us/windows/win32/controls/tdn-timer
+func (me *EventsTaskDialog) TdnTimer(fun func(elapsedMs int) bool) {
+	me.timer = fun
+}
+
+// [TDN_VERIFICATION_CLICKED] message handler.
+//
+// [TDN_VERIFICATION_CLICKED]: https://learn.microsoft.com/en-us/windows/win32/controls/tdn-verification-clicked
+func (me *EventsTaskDialog) TdnVerificationClicked(fun func(checked bool)) {
+	me.verificationClicked = fun
+}
@@ -366,7 +366,7 @@ func (hKey HKEY) RegEnumValue() ([]HkeyNameVal, error) {
 	valueNameBuf := wstr.NewBufDecoder(nfo.MaxValueNameLen + 1)
 	defer valueNameBuf.Free()
 
-	dataBuf := make([]byte, nfo.MaxValueDataLen)
+	dataBuf := make([]byte, nfo.MaxValueDataLen+1) // +1 so it's never empty
 
 	for i := uint(0); i < nfo.NumValues; i++ {
 		szValueNameBuf := uint32(valueNameBuf.Len())
@@ -506,6 +506,39 @@ func (hKey HKEY) RegLoadKey(subKey, file string) error {
 
 var _RegLoadKeyW *syscall.Proc
 
+// [RegNotifyChangeKeyValue] function.
+//
+// If async is true, hEvent is signaled when a change happens, and the function
+// returns immediately. Note that, unless [co.REG_NOTIFY_THREAD_AGNOSTIC] is
+// given, the notification is discarded when the calling thread exits, so the
+// calling goroutine should be locked with [runtime.LockOSThread].
+//
+// For a higher-level abstraction which delivers the changes on a channel, see
+// [RegWatch].
+//
+// [RegNotifyChangeKeyValue]: https://learn.microsoft.com/en-us/windows/win32/api/winreg/nf-winreg-regnotifychangekeyvalue
+func (hKey HKEY) RegNotifyChangeKeyValue(
+	watchSubtree bool,
+	filter co.REG_NOTIFY,
+	hEvent HEVENT,
+	async bool,
+) error {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.ADVAPI32, &_RegNotifyChangeKeyValue, "RegNotifyChangeKeyValue"),
+		uintptr(hKey),
+		utl.BoolToUintptr(watchSubtree),
+		uintptr(filter),
+		uintptr(hEvent),
+		utl.BoolToUintptr(async))
+
+	if wErr := co.ERROR(ret); wErr != co.ERROR_SUCCESS {
+		return wErr
+	}
+	return nil
+}
+
+var _RegNotifyChangeKeyValue *syscall.Proc
+
 // [RegOpenKeyEx] function.
 //
 // ⚠️ You must defer [HKEY.RegCloseKey].
@@ -741,7 +774,7 @@ func (hKey HKEY) RegQueryValueEx(valueName string) (RegVal, error) {
 			return RegVal{}, wErr
 		}
 
-		dataBuf := make([]byte, szDataBytes) // will be passed straight into RegVal
+		dataBuf := make([]byte, szDataBytes+1) // will be passed straight into RegVal; +1 so it's never empty
 		var dataType uint32
 
 		ret, _, _ = syscall.SyscallN( // 2nd call to retrieve the data
@@ -901,13 +934,18 @@ func (hKey HKEY) RegSetValueEx(valueName string, data RegVal) error {
 	defer wbuf.Free()
 	pValueName := wbuf.PtrEmptyIsNil(valueName)
 
+	var pData *byte
+	if len(data.data) > 0 { // REG_NONE and empty REG_BINARY have no data
+		pData = &data.data[0]
+	}
+
 	ret, _, _ := syscall.SyscallN(
 		dll.Load(dll.ADVAPI32, &_RegSetValueExW, "RegSetValueExW"),
 		uintptr(hKey),
 		uintptr(pValueName),
 		0,
 		uintptr(data.Type()),
-		uintptr(unsafe.Pointer(&data.data[0])),
+		uintptr(unsafe.Pointer(pData)),
 		uintptr(uint32(len(data.data))))
 	return utl.ZeroAsSysError(ret)
 }
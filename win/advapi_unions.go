@@ -1,14 +1,14 @@
-//go:build windows
-
 package win
 
+// This file has no knowledge of windows, so the registry values can be tested
+// on any OS.
+
 import (
 	"encoding/binary"
-	"syscall"
-	"unsafe"
+	"strings"
+	"unicode/utf16"
 
 	"github.com/rodrigocfd/windigo/win/co"
-	"github.com/rodrigocfd/windigo/win/wstr"
 )
 
 // Tagged union for a Registry value.
@@ -64,12 +64,9 @@ func (me *RegVal) Binary() ([]byte, bool) {
 //
 // The environment variables can be expanded with [ExpandEnvironmentStrings].
 func RegValExpandSz(s string) RegVal {
-	str16, _ := syscall.UTF16FromString(s)
-	data := unsafe.Slice((*byte)(unsafe.Pointer(&str16[0])), len(str16)*2)
-
 	return RegVal{
 		tag:  co.REG_EXPAND_SZ,
-		data: data,
+		data: regValEncodeUtf16(s),
 	}
 }
 
@@ -78,8 +75,7 @@ func RegValExpandSz(s string) RegVal {
 // The environment variables can be expanded with [ExpandEnvironmentStrings].
 func (me *RegVal) ExpandSz() (string, bool) {
 	if me.tag == co.REG_EXPAND_SZ {
-		str16 := unsafe.Slice((*uint16)(unsafe.Pointer(&me.data[0])), len(me.data)/2)
-		return wstr.DecodeSlice(str16), true
+		return regValDecodeSz(me.data), true
 	}
 	return "", false
 }
@@ -127,8 +123,8 @@ func (me *RegVal) DwordBigEndian() (uint32, bool) {
 
 // Creates a new [RegVal] with a [co.REG_MULTI_SZ] value.
 func RegValMultiSz(strs ...string) RegVal {
-	buf := wstr.EncodeArrToSlice(strs...)
-	data := unsafe.Slice((*byte)(unsafe.Pointer(&buf[0])), len(buf)*2)
+	data := regValEncodeUtf16(strs...)
+	data = binary.LittleEndian.AppendUint16(data, 0) // additional terminating null
 
 	return RegVal{
 		tag:  co.REG_MULTI_SZ,
@@ -139,8 +135,7 @@ func RegValMultiSz(strs ...string) RegVal {
 // If the value is [co.REG_MULTI_SZ], returns it and true.
 func (me *RegVal) MultiSz() ([]string, bool) {
 	if me.tag == co.REG_MULTI_SZ {
-		pStr16 := (*uint16)(unsafe.Pointer(&me.data[0]))
-		return wstr.DecodeArrPtr(pStr16), true
+		return regValDecodeMultiSz(me.data), true
 	}
 	return nil, false
 }
@@ -149,7 +144,7 @@ func (me *RegVal) MultiSz() ([]string, bool) {
 //
 // Same as [co.REG_QWORD_LITTLE_ENDIAN].
 func RegValQword(n uint64) RegVal {
-	var data [4]byte
+	var data [8]byte
 	binary.LittleEndian.PutUint64(data[:], n)
 
 	return RegVal{
@@ -169,12 +164,9 @@ func (me *RegVal) Qword() (uint64, bool) {
 
 // Creates a new [RegVal] with a [co.REG_SZ] value.
 func RegValSz(s string) RegVal {
-	str16, _ := syscall.UTF16FromString(s)
-	data := unsafe.Slice((*byte)(unsafe.Pointer(&str16[0])), len(str16)*2)
-
 	return RegVal{
 		tag:  co.REG_SZ,
-		data: data,
+		data: regValEncodeUtf16(s),
 	}
 }
 
@@ -189,8 +181,7 @@ func RegValSz(s string) RegVal {
 //	}
 func (me *RegVal) Sz() (string, bool) {
 	if me.tag == co.REG_SZ {
-		str16 := unsafe.Slice((*uint16)(unsafe.Pointer(&me.data[0])), len(me.data)/2)
-		return wstr.DecodeSlice(str16), true
+		return regValDecodeSz(me.data), true
 	}
 	return "", false
 }
@@ -200,12 +191,51 @@ func (me *RegVal) Sz() (string, bool) {
 func regValParse(data []byte, regType co.REG) (RegVal, error) {
 	isDword := regType == co.REG_DWORD || regType == co.REG_DWORD_BIG_ENDIAN
 	isQword := regType == co.REG_QWORD
-	sz := uintptr(len(data))
 
-	if (isDword && sz != unsafe.Sizeof(uint32(0))) ||
-		(isQword && sz != unsafe.Sizeof(uint64(0))) { // validate integer sizes
+	if (isDword && len(data) != 4) || (isQword && len(data) != 8) { // validate integer sizes
 		return RegVal{}, co.ERROR_INVALID_DATA
 	}
 
 	return RegVal{regType, data}, nil
 }
+
+// Encodes each string as null-terminated UTF-16 LE, the way the registry stores
+// them.
+func regValEncodeUtf16(strs ...string) []byte {
+	data := make([]byte, 0, 64) // arbitrary
+	for _, str := range strs {
+		for _, w := range utf16.Encode([]rune(str)) {
+			data = binary.LittleEndian.AppendUint16(data, w)
+		}
+		data = binary.LittleEndian.AppendUint16(data, 0) // terminating null
+	}
+	return data
+}
+
+// Decodes a string, stopping at the terminating null, if any.
+func regValDecodeSz(data []byte) string {
+	str, _, _ := strings.Cut(regValDecodeUtf16(data), "\x00")
+	return str
+}
+
+// Decodes the strings of a multi-string, stopping at the first empty one, which
+// is the double null terminator.
+func regValDecodeMultiSz(data []byte) []string {
+	strs := make([]string, 0)
+	for _, str := range strings.Split(regValDecodeUtf16(data), "\x00") {
+		if str == "" {
+			break // double null terminator
+		}
+		strs = append(strs, str)
+	}
+	return strs
+}
+
+// Decodes UTF-16 LE, ignoring a trailing odd byte.
+func regValDecodeUtf16(data []byte) string {
+	words := make([]uint16, 0, len(data)/2)
+	for i := 0; i+1 < len(data); i += 2 {
+		words = append(words, binary.LittleEndian.Uint16(data[i:]))
+	}
+	return string(utf16.Decode(words))
+}
@@ -1,5 +1,3 @@
-//go:build windows
-
 package co
 
 // [Registry key] security and access rights.
@@ -54,6 +52,28 @@ const (
 	REG_QWORD_LITTLE_ENDIAN        REG = 11
 )
 
+// Kind of a win.RegValueChange, delivered by win.RegWatch.
+type REG_CHANGE uint8
+
+const (
+	REG_CHANGE_ADDED    REG_CHANGE = iota + 1 // The value was created.
+	REG_CHANGE_REMOVED                        // The value was deleted.
+	REG_CHANGE_MODIFIED                       // The value type or data changed.
+)
+
+// [RegNotifyChangeKeyValue] filter. Originally has REG_NOTIFY_CHANGE prefix.
+//
+// [RegNotifyChangeKeyValue]: https://learn.microsoft.com/en-us/windows/win32/api/winreg/nf-winreg-regnotifychangekeyvalue
+type REG_NOTIFY uint32
+
+const (
+	REG_NOTIFY_NAME            REG_NOTIFY = 0x0000_0001
+	REG_NOTIFY_ATTRIBUTES      REG_NOTIFY = 0x0000_0002
+	REG_NOTIFY_LAST_SET        REG_NOTIFY = 0x0000_0004
+	REG_NOTIFY_SECURITY        REG_NOTIFY = 0x0000_0008
+	REG_NOTIFY_THREAD_AGNOSTIC REG_NOTIFY = 0x1000_0000
+)
+
 // [RegCreateKeyEx] and [RegOpenKeyEx] options.
 //
 // [RegCreateKeyEx]: https://learn.microsoft.com/en-us/windows/win32/api/winreg/nf-winreg-regcreatekeyexw
@@ -1,5 +1,3 @@
-//go:build windows
-
 package co
 
 // [NMTVASYNCDRAW] dwRetFlags, don't seem to be defined anywhere, values are unconfirmed.
@@ -654,6 +652,17 @@ const (
 	LVS_EX_UNDERLINEHOT          LVS_EX = 0x0000_0800
 )
 
+// [LVM_SETITEMCOUNT] flags.
+//
+// [LVM_SETITEMCOUNT]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-setitemcount
+type LVSICF uint32
+
+const (
+	LVSICF_NONE            LVSICF = 0
+	LVSICF_NOINVALIDATEALL LVSICF = 0x0000_0001
+	LVSICF_NOSCROLL        LVSICF = 0x0000_0002
+)
+
 // [LVM_GETIMAGELIST] and [LVM_SETIMAGELIST] type.
 //
 // [LVM_GETIMAGELIST]: https://learn.microsoft.com/en-us/windows/win32/controls/lvm-getimagelist
@@ -733,6 +742,105 @@ const (
 	PBST_PAUSED PBST = 0x0003
 )
 
+// [PSM_PRESSBUTTON] button.
+//
+// [PSM_PRESSBUTTON]: https://learn.microsoft.com/en-us/windows/win32/controls/psm-pressbutton
+type PSBTN int32
+
+const (
+	PSBTN_BACK     PSBTN = 0
+	PSBTN_NEXT     PSBTN = 1
+	PSBTN_FINISH   PSBTN = 2
+	PSBTN_OK       PSBTN = 3
+	PSBTN_APPLYNOW PSBTN = 4
+	PSBTN_CANCEL   PSBTN = 5
+	PSBTN_HELP     PSBTN = 6
+)
+
+// [PROPSHEETHEADER] dwFlags.
+//
+// [PROPSHEETHEADER]: https://learn.microsoft.com/en-us/windows/win32/api/prsht/ns-prsht-propsheetheaderw_v2
+type PSH uint32
+
+const (
+	PSH_DEFAULT           PSH = 0x0000_0000
+	PSH_PROPTITLE         PSH = 0x0000_0001
+	PSH_USEHICON          PSH = 0x0000_0002
+	PSH_USEICONID         PSH = 0x0000_0004
+	PSH_PROPSHEETPAGE     PSH = 0x0000_0008
+	PSH_WIZARDHASFINISH   PSH = 0x0000_0010
+	PSH_WIZARD            PSH = 0x0000_0020
+	PSH_USEPSTARTPAGE     PSH = 0x0000_0040
+	PSH_NOAPPLYNOW        PSH = 0x0000_0080
+	PSH_USECALLBACK       PSH = 0x0000_0100
+	PSH_HASHELP           PSH = 0x0000_0200
+	PSH_MODELESS          PSH = 0x0000_0400
+	PSH_RTLREADING        PSH = 0x0000_0800
+	PSH_WIZARDCONTEXTHELP PSH = 0x0000_1000
+	PSH_AEROWIZARD        PSH = 0x0000_4000
+	PSH_WATERMARK         PSH = 0x0000_8000
+	PSH_USEHBMWATERMARK   PSH = 0x0001_0000
+	PSH_USEHPLWATERMARK   PSH = 0x0002_0000
+	PSH_STRETCHWATERMARK  PSH = 0x0004_0000
+	PSH_HEADER            PSH = 0x0008_0000
+	PSH_USEHBMHEADER      PSH = 0x0010_0000
+	PSH_USEPAGELANG       PSH = 0x0020_0000
+	PSH_WIZARD_LITE       PSH = 0x0040_0000
+	PSH_WIZARD97          PSH = 0x0100_0000
+	PSH_NOCONTEXTHELP     PSH = 0x0200_0000
+	PSH_RESIZABLE         PSH = 0x0400_0000
+	PSH_HEADERBITMAP      PSH = 0x0800_0000
+	PSH_NOMARGIN          PSH = 0x1000_0000
+)
+
+// [PSN_APPLY] and [PSN_KILLACTIVE] return values.
+//
+// [PSN_APPLY]: https://learn.microsoft.com/en-us/windows/win32/controls/psn-apply
+// [PSN_KILLACTIVE]: https://learn.microsoft.com/en-us/windows/win32/controls/psn-killactive
+type PSNRET int32
+
+const (
+	PSNRET_NOERROR              PSNRET = 0
+	PSNRET_INVALID              PSNRET = 1
+	PSNRET_INVALID_NOCHANGEPAGE PSNRET = 2
+	PSNRET_MESSAGEHANDLED       PSNRET = 3
+)
+
+// [PROPSHEETPAGE] dwFlags.
+//
+// [PROPSHEETPAGE]: https://learn.microsoft.com/en-us/windows/win32/api/prsht/ns-prsht-propsheetpagew
+type PSP uint32
+
+const (
+	PSP_DEFAULT           PSP = 0x0000_0000
+	PSP_DLGINDIRECT       PSP = 0x0000_0001
+	PSP_USEHICON          PSP = 0x0000_0002
+	PSP_USEICONID         PSP = 0x0000_0004
+	PSP_USETITLE          PSP = 0x0000_0008
+	PSP_RTLREADING        PSP = 0x0000_0010
+	PSP_HASHELP           PSP = 0x0000_0020
+	PSP_USEREFPARENT      PSP = 0x0000_0040
+	PSP_USECALLBACK       PSP = 0x0000_0080
+	PSP_PREMATURE         PSP = 0x0000_0400
+	PSP_HIDEHEADER        PSP = 0x0000_0800
+	PSP_USEHEADERTITLE    PSP = 0x0000_1000
+	PSP_USEHEADERSUBTITLE PSP = 0x0000_2000
+	PSP_USEFUSIONCONTEXT  PSP = 0x0000_4000
+)
+
+// [PSM_SETWIZBUTTONS] flags.
+//
+// [PSM_SETWIZBUTTONS]: https://learn.microsoft.com/en-us/windows/win32/controls/psm-setwizbuttons
+type PSWIZB uint32
+
+const (
+	PSWIZB_BACK           PSWIZB = 0x0000_0001
+	PSWIZB_NEXT           PSWIZB = 0x0000_0002
+	PSWIZB_FINISH         PSWIZB = 0x0000_0004
+	PSWIZB_DISABLEDFINISH PSWIZB = 0x0000_0008
+	PSWIZB_CANCEL         PSWIZB = 0x0000_0010
+)
+
 // StatusBar [styles].
 //
 // [styles]: https://learn.microsoft.com/en-us/windows/win32/controls/status-bar-styles
@@ -973,6 +1081,77 @@ const (
 	TDF_SIZE_TO_CONTENT             TDF = 0x0100_0000
 )
 
+// [TDM_SET_ELEMENT_TEXT] and [TDM_UPDATE_ELEMENT_TEXT] element.
+//
+// [TDM_SET_ELEMENT_TEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/tdm-set-element-text
+// [TDM_UPDATE_ELEMENT_TEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/tdm-update-element-text
+type TDE uint32
+
+const (
+	TDE_CONTENT              TDE = 0
+	TDE_EXPANDED_INFORMATION TDE = 1
+	TDE_FOOTER               TDE = 2
+	TDE_MAIN_INSTRUCTION     TDE = 3
+)
+
+// [TDM_UPDATE_ICON] element.
+//
+// [TDM_UPDATE_ICON]: https://learn.microsoft.com/en-us/windows/win32/controls/tdm-update-icon
+type TDIE uint32
+
+const (
+	TDIE_ICON_MAIN   TDIE = 0
+	TDIE_ICON_FOOTER TDIE = 1
+)
+
+// [TaskDialogCallbackProc] notifications.
+//
+// [TaskDialogCallbackProc]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/nc-commctrl-pftaskdialogcallback
+type TDN uint32
+
+const (
+	TDN_CREATED                TDN = 0
+	TDN_NAVIGATED              TDN = 1
+	TDN_BUTTON_CLICKED         TDN = 2
+	TDN_HYPERLINK_CLICKED      TDN = 3
+	TDN_TIMER                  TDN = 4
+	TDN_DESTROYED              TDN = 5
+	TDN_RADIO_BUTTON_CLICKED   TDN = 6
+	TDN_DIALOG_CONSTRUCTED     TDN = 7
+	TDN_VERIFICATION_CLICKED   TDN = 8
+	TDN_HELP                   TDN = 9
+	TDN_EXPANDO_BUTTON_CLICKED TDN = 10
+)
+
+// [TTM_SETDELAYTIME] delay.
+//
+// [TTM_SETDELAYTIME]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-setdelaytime
+type TTDT uint32
+
+const (
+	TTDT_AUTOMATIC TTDT = 0
+	TTDT_RESHOW    TTDT = 1
+	TTDT_AUTOPOP   TTDT = 2
+	TTDT_INITIAL   TTDT = 3
+)
+
+// [TTTOOLINFO] uFlags.
+//
+// [TTTOOLINFO]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-tttoolinfow
+type TTF uint32
+
+const (
+	TTF_IDISHWND    TTF = 0x0001
+	TTF_CENTERTIP   TTF = 0x0002
+	TTF_RTLREADING  TTF = 0x0004
+	TTF_SUBCLASS    TTF = 0x0010
+	TTF_TRACK       TTF = 0x0020
+	TTF_ABSOLUTE    TTF = 0x0080
+	TTF_TRANSPARENT TTF = 0x0100
+	TTF_PARSELINKS  TTF = 0x1000
+	TTF_DI_SETITEM  TTF = 0x8000
+)
+
 // [EDITBALLOONTIP] ttiIcon.
 //
 // [EDITBALLOONTIP]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-editballoontip
@@ -988,6 +1167,21 @@ const (
 	TTI_ERROR_LARGE   TTI = 6
 )
 
+// Tooltip control [styles].
+//
+// [styles]: https://learn.microsoft.com/en-us/windows/win32/controls/tooltip-styles
+type TTS WS
+
+const (
+	TTS_ALWAYSTIP      TTS = 0x0001
+	TTS_NOPREFIX       TTS = 0x0002
+	TTS_NOANIMATE      TTS = 0x0010
+	TTS_NOFADE         TTS = 0x0020
+	TTS_BALLOON        TTS = 0x0040
+	TTS_CLOSE          TTS = 0x0080
+	TTS_USEVISUALSTYLE TTS = 0x0100
+)
+
 // [TVM_EXPAND] action flag.
 //
 // [TVM_EXPAND]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-expand
@@ -1,5 +1,3 @@
-//go:build windows
-
 package co
 
 // Visual style [parts and states].
@@ -1,5 +1,3 @@
-//go:build windows
-
 package co
 
 // [DWMWA_GET_CLOAKED] return values.
@@ -1,5 +1,3 @@
-//go:build windows
-
 package co
 
 // [ACTCTX] dwFlags. Originally has ACTCTX_FLAG prefix.
@@ -430,6 +428,16 @@ const (
 	HEAP_REALLOC_ZERO_MEMORY           HEAP_REALLOC = 0x0000_0008
 )
 
+// Text encoding of an .ini file, as stored in win.Ini.Encoding.
+type INI_ENC uint8
+
+const (
+	INI_ENC_UTF8     INI_ENC = iota // UTF-8 without byte order mark.
+	INI_ENC_UTF8_BOM                // UTF-8 with byte order mark.
+	INI_ENC_UTF16LE                 // UTF-16 little-endian with byte order mark.
+	INI_ENC_UTF16BE                 // UTF-16 big-endian with byte order mark.
+)
+
 // [Language] identifier.
 //
 // [Language]: https://learn.microsoft.com/en-us/windows/win32/intl/language-identifier-constants-and-strings
@@ -1,5 +1,3 @@
-//go:build windows
-
 package co
 
 // A COM [interface ID], represented as a string.
@@ -0,0 +1,235 @@
+package co
+
+// [CHARFORMAT2] dwMask.
+//
+// [CHARFORMAT2]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-charformat2w
+type CFM uint32
+
+const (
+	CFM_BOLD          CFM = 0x0000_0001
+	CFM_ITALIC        CFM = 0x0000_0002
+	CFM_UNDERLINE     CFM = 0x0000_0004
+	CFM_STRIKEOUT     CFM = 0x0000_0008
+	CFM_PROTECTED     CFM = 0x0000_0010
+	CFM_LINK          CFM = 0x0000_0020
+	CFM_SMALLCAPS     CFM = 0x0000_0040
+	CFM_ALLCAPS       CFM = 0x0000_0080
+	CFM_HIDDEN        CFM = 0x0000_0100
+	CFM_OUTLINE       CFM = 0x0000_0200
+	CFM_SHADOW        CFM = 0x0000_0400
+	CFM_EMBOSS        CFM = 0x0000_0800
+	CFM_IMPRINT       CFM = 0x0000_1000
+	CFM_DISABLED      CFM = 0x0000_2000
+	CFM_REVISED       CFM = 0x0000_4000
+	CFM_REVAUTHOR     CFM = 0x0000_8000
+	CFM_SUBSCRIPT     CFM = 0x0003_0000
+	CFM_SUPERSCRIPT   CFM = 0x0003_0000
+	CFM_ANIMATION     CFM = 0x0004_0000
+	CFM_STYLE         CFM = 0x0008_0000
+	CFM_KERNING       CFM = 0x0010_0000
+	CFM_SPACING       CFM = 0x0020_0000
+	CFM_WEIGHT        CFM = 0x0040_0000
+	CFM_UNDERLINETYPE CFM = 0x0080_0000
+	CFM_COOKIE        CFM = 0x0100_0000
+	CFM_LCID          CFM = 0x0200_0000
+	CFM_BACKCOLOR     CFM = 0x0400_0000
+	CFM_CHARSET       CFM = 0x0800_0000
+	CFM_OFFSET        CFM = 0x1000_0000
+	CFM_FACE          CFM = 0x2000_0000
+	CFM_COLOR         CFM = 0x4000_0000
+	CFM_SIZE          CFM = 0x8000_0000
+	CFM_EFFECTS       CFM = CFM_BOLD | CFM_ITALIC | CFM_UNDERLINE | CFM_COLOR | CFM_STRIKEOUT | CFM_PROTECTED | CFM_LINK
+	CFM_ALL           CFM = CFM_EFFECTS | CFM_SIZE | CFM_FACE | CFM_OFFSET | CFM_CHARSET
+)
+
+// [CHARFORMAT2] dwEffects.
+//
+// [CHARFORMAT2]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-charformat2w
+type CFE uint32
+
+const (
+	CFE_BOLD          CFE = 0x0000_0001
+	CFE_ITALIC        CFE = 0x0000_0002
+	CFE_UNDERLINE     CFE = 0x0000_0004
+	CFE_STRIKEOUT     CFE = 0x0000_0008
+	CFE_PROTECTED     CFE = 0x0000_0010
+	CFE_LINK          CFE = 0x0000_0020
+	CFE_SMALLCAPS     CFE = 0x0000_0040
+	CFE_ALLCAPS       CFE = 0x0000_0080
+	CFE_HIDDEN        CFE = 0x0000_0100
+	CFE_OUTLINE       CFE = 0x0000_0200
+	CFE_SHADOW        CFE = 0x0000_0400
+	CFE_EMBOSS        CFE = 0x0000_0800
+	CFE_IMPRINT       CFE = 0x0000_1000
+	CFE_DISABLED      CFE = 0x0000_2000
+	CFE_REVISED       CFE = 0x0000_4000
+	CFE_SUBSCRIPT     CFE = 0x0001_0000
+	CFE_SUPERSCRIPT   CFE = 0x0002_0000
+	CFE_AUTOBACKCOLOR CFE = 0x0400_0000
+	CFE_AUTOCOLOR     CFE = 0x4000_0000
+)
+
+// [EM_SETEVENTMASK] mask.
+//
+// [EM_SETEVENTMASK]: https://learn.microsoft.com/en-us/windows/win32/controls/em-seteventmask
+type ENM uint32
+
+const (
+	ENM_NONE              ENM = 0x0000_0000
+	ENM_CHANGE            ENM = 0x0000_0001
+	ENM_UPDATE            ENM = 0x0000_0002
+	ENM_SCROLL            ENM = 0x0000_0004
+	ENM_SCROLLEVENTS      ENM = 0x0000_0008
+	ENM_DRAGDROPDONE      ENM = 0x0000_0010
+	ENM_PARAGRAPHEXPANDED ENM = 0x0000_0020
+	ENM_PAGECHANGE        ENM = 0x0000_0040
+	ENM_CLIPFORMAT        ENM = 0x0000_0080
+	ENM_KEYEVENTS         ENM = 0x0001_0000
+	ENM_MOUSEEVENTS       ENM = 0x0002_0000
+	ENM_REQUESTRESIZE     ENM = 0x0004_0000
+	ENM_SELCHANGE         ENM = 0x0008_0000
+	ENM_DROPFILES         ENM = 0x0010_0000
+	ENM_PROTECTED         ENM = 0x0020_0000
+	ENM_CORRECTTEXT       ENM = 0x0040_0000
+	ENM_IMECHANGE         ENM = 0x0080_0000
+	ENM_LANGCHANGE        ENM = 0x0100_0000
+	ENM_OBJECTPOSITIONS   ENM = 0x0200_0000
+	ENM_LINK              ENM = 0x0400_0000
+	ENM_LOWFIRTF          ENM = 0x0800_0000
+	ENM_STARTCOMPOSITION  ENM = 0x1000_0000
+	ENM_ENDCOMPOSITION    ENM = 0x2000_0000
+	ENM_GROUPTYPINGCHANGE ENM = 0x4000_0000
+	ENM_HIDELINKTOOLTIP   ENM = 0x8000_0000
+)
+
+// [EM_FINDTEXTEX] flags. Shares the type with the [AddFontResourceEx] flags,
+// since both use the FR prefix.
+//
+// [EM_FINDTEXTEX]: https://learn.microsoft.com/en-us/windows/win32/controls/em-findtextex
+// [AddFontResourceEx]: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/nf-wingdi-addfontresourceexw
+const (
+	FR_DOWN      FR = 0x0000_0001
+	FR_WHOLEWORD FR = 0x0000_0002
+	FR_MATCHCASE FR = 0x0000_0004
+)
+
+// [GETTEXTLENGTHEX] flags.
+//
+// [GETTEXTLENGTHEX]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-gettextlengthex
+type GTL uint32
+
+const (
+	GTL_DEFAULT  GTL = 0
+	GTL_USECRLF  GTL = 1
+	GTL_PRECISE  GTL = 2
+	GTL_CLOSE    GTL = 4
+	GTL_NUMCHARS GTL = 8
+	GTL_NUMBYTES GTL = 16
+)
+
+// [PARAFORMAT2] wAlignment.
+//
+// [PARAFORMAT2]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-paraformat2
+type PFA uint16
+
+const (
+	PFA_LEFT    PFA = 1
+	PFA_RIGHT   PFA = 2
+	PFA_CENTER  PFA = 3
+	PFA_JUSTIFY PFA = 4
+)
+
+// [PARAFORMAT2] dwMask.
+//
+// [PARAFORMAT2]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-paraformat2
+type PFM uint32
+
+const (
+	PFM_STARTINDENT     PFM = 0x0000_0001
+	PFM_RIGHTINDENT     PFM = 0x0000_0002
+	PFM_OFFSET          PFM = 0x0000_0004
+	PFM_ALIGNMENT       PFM = 0x0000_0008
+	PFM_TABSTOPS        PFM = 0x0000_0010
+	PFM_NUMBERING       PFM = 0x0000_0020
+	PFM_SPACEBEFORE     PFM = 0x0000_0040
+	PFM_SPACEAFTER      PFM = 0x0000_0080
+	PFM_LINESPACING     PFM = 0x0000_0100
+	PFM_STYLE           PFM = 0x0000_0400
+	PFM_BORDER          PFM = 0x0000_0800
+	PFM_SHADING         PFM = 0x0000_1000
+	PFM_NUMBERINGSTYLE  PFM = 0x0000_2000
+	PFM_NUMBERINGTAB    PFM = 0x0000_4000
+	PFM_NUMBERINGSTART  PFM = 0x0000_8000
+	PFM_RTLPARA         PFM = 0x0001_0000
+	PFM_KEEP            PFM = 0x0002_0000
+	PFM_KEEPNEXT        PFM = 0x0004_0000
+	PFM_PAGEBREAKBEFORE PFM = 0x0008_0000
+	PFM_NOLINENUMBER    PFM = 0x0010_0000
+	PFM_NOWIDOWCONTROL  PFM = 0x0020_0000
+	PFM_DONOTHYPHEN     PFM = 0x0040_0000
+	PFM_SIDEBYSIDE      PFM = 0x0080_0000
+	PFM_TABLE           PFM = 0x4000_0000
+	PFM_OFFSETINDENT    PFM = 0x8000_0000
+)
+
+// [PARAFORMAT2] wNumbering.
+//
+// [PARAFORMAT2]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-paraformat2
+type PFN uint16
+
+const (
+	PFN_NONE     PFN = 0
+	PFN_BULLET   PFN = 1
+	PFN_ARABIC   PFN = 2
+	PFN_LCLETTER PFN = 3
+	PFN_UCLETTER PFN = 4
+	PFN_LCROMAN  PFN = 5
+	PFN_UCROMAN  PFN = 6
+)
+
+// [EM_SETCHARFORMAT] wParam.
+//
+// [EM_SETCHARFORMAT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-setcharformat
+type SCF uint32
+
+const (
+	SCF_DEFAULT       SCF = 0x0000
+	SCF_SELECTION     SCF = 0x0001
+	SCF_WORD          SCF = 0x0002
+	SCF_ALL           SCF = 0x0004
+	SCF_USEUIRULES    SCF = 0x0008
+	SCF_ASSOCIATEFONT SCF = 0x0010
+	SCF_NOKBUPDATE    SCF = 0x0020
+)
+
+// [SELCHANGE] seltyp.
+//
+// [SELCHANGE]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-selchange
+type SEL uint16
+
+const (
+	SEL_EMPTY       SEL = 0x0000
+	SEL_TEXT        SEL = 0x0001
+	SEL_OBJECT      SEL = 0x0002
+	SEL_MULTICHAR   SEL = 0x0004
+	SEL_MULTIOBJECT SEL = 0x0008
+)
+
+// [EM_STREAMIN] and [EM_STREAMOUT] format.
+//
+// [EM_STREAMIN]: https://learn.microsoft.com/en-us/windows/win32/controls/em-streamin
+// [EM_STREAMOUT]: https://learn.microsoft.com/en-us/windows/win32/controls/em-streamout
+type SF uint32
+
+const (
+	SF_TEXT           SF = 0x0001
+	SF_RTF            SF = 0x0002
+	SF_RTFNOOBJS      SF = 0x0003
+	SF_TEXTIZED       SF = 0x0004
+	SF_UNICODE        SF = 0x0010
+	SF_USECODEPAGE    SF = 0x0020
+	SF_NCRFORNONASCII SF = 0x0040
+	SFF_WRITEXTRAPAR  SF = 0x0080
+	SFF_PLAINRTF      SF = 0x4000
+	SFF_SELECTION     SF = 0x8000
+)
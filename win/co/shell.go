@@ -1,5 +1,3 @@
-//go:build windows
-
 package co
 
 // [FDAP] enumeration.
@@ -345,6 +343,21 @@ const (
 	NIM_SETVERSION NIM = 0x0000_0004
 )
 
+// [Shell_NotifyIcon] notifications, received in the low-order word of the
+// LPARAM of the callback message.
+//
+// [Shell_NotifyIcon]: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shell_notifyiconw
+const (
+	NIN_SELECT           = WM_USER + 0
+	NIN_KEYSELECT        = NIN_SELECT | 1
+	NIN_BALLOONSHOW      = WM_USER + 2
+	NIN_BALLOONHIDE      = WM_USER + 3
+	NIN_BALLOONTIMEOUT   = WM_USER + 4
+	NIN_BALLOONUSERCLICK = WM_USER + 5
+	NIN_POPUPOPEN        = WM_USER + 6
+	NIN_POPUPCLOSE       = WM_USER + 7
+)
+
 // [NOTIFYICONDATA] dwState.
 //
 // [NOTIFYICONDATA]: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-notifyicondataw
@@ -1,5 +1,3 @@
-//go:build windows
-
 package co
 
 // [PROPERTYKEY] struct predefined values, represented as a string.
@@ -1,5 +1,3 @@
-//go:build windows
-
 package co
 
 // [ACCELL] fVirt.
@@ -328,6 +326,29 @@ const (
 	DI_NORMAL      DI = 0x0003
 )
 
+// Dialog box [styles].
+//
+// [styles]: https://learn.microsoft.com/en-us/windows/win32/dlgbox/dialog-box-styles
+type DS WS
+
+const (
+	DS_ABSALIGN      DS = 0x01
+	DS_SYSMODAL      DS = 0x02
+	DS_3DLOOK        DS = 0x04
+	DS_FIXEDSYS      DS = 0x08
+	DS_NOFAILCREATE  DS = 0x10
+	DS_LOCALEDIT     DS = 0x20
+	DS_SETFONT       DS = 0x40
+	DS_MODALFRAME    DS = 0x80
+	DS_NOIDLEMSG     DS = 0x100
+	DS_SETFOREGROUND DS = 0x200
+	DS_CONTROL       DS = 0x0400
+	DS_CENTER        DS = 0x0800
+	DS_CENTERMOUSE   DS = 0x1000
+	DS_CONTEXTHELP   DS = 0x2000
+	DS_SHELLFONT     DS = DS_SETFONT | DS_FIXEDSYS
+)
+
 // [EnumDisplayDevices] flags.
 //
 // [EnumDisplayDevices]: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/ns-wingdi-display_devicew
@@ -372,6 +393,19 @@ const (
 	DLGC_BUTTON          DLGC = 0x2000
 )
 
+// [DPI_AWARENESS_CONTEXT] handle.
+//
+// [DPI_AWARENESS_CONTEXT]: https://learn.microsoft.com/en-us/windows/win32/hidpi/dpi-awareness-context
+type DPI_AWARENESS_CONTEXT int32
+
+const (
+	DPI_AWARENESS_CONTEXT_UNAWARE              DPI_AWARENESS_CONTEXT = -1
+	DPI_AWARENESS_CONTEXT_SYSTEM_AWARE         DPI_AWARENESS_CONTEXT = -2
+	DPI_AWARENESS_CONTEXT_PER_MONITOR_AWARE    DPI_AWARENESS_CONTEXT = -3
+	DPI_AWARENESS_CONTEXT_PER_MONITOR_AWARE_V2 DPI_AWARENESS_CONTEXT = -4
+	DPI_AWARENESS_CONTEXT_UNAWARE_GDISCALED    DPI_AWARENESS_CONTEXT = -5
+)
+
 // [EnumDisplayDevices] flags.
 //
 // [EnumDisplayDevices]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-enumdisplaydevicesw
@@ -402,6 +436,12 @@ const (
 	ES_READONLY    ES = 0x0800
 	ES_WANTRETURN  ES = 0x1000
 	ES_NUMBER      ES = 0x2000
+
+	ES_SAVESEL         ES = 0x8000      // RichEdit only.
+	ES_SUNKEN          ES = 0x4000      // RichEdit only.
+	ES_DISABLENOSCROLL ES = 0x2000      // RichEdit only.
+	ES_NOOLEDRAGDROP   ES = 0x0008      // RichEdit only.
+	ES_SELECTIONBAR    ES = 0x0100_0000 // RichEdit only.
 )
 
 // [ExitWindowsEx] flags.
@@ -704,6 +744,31 @@ const (
 	LAYOUT_RTL    LAYOUT = 0x0000_0001
 )
 
+// ListBox [styles].
+//
+// [styles]: https://learn.microsoft.com/en-us/windows/win32/controls/list-box-styles
+type LBS WS
+
+const (
+	LBS_NOTIFY            LBS = 0x0001
+	LBS_SORT              LBS = 0x0002
+	LBS_NOREDRAW          LBS = 0x0004
+	LBS_MULTIPLESEL       LBS = 0x0008
+	LBS_OWNERDRAWFIXED    LBS = 0x0010
+	LBS_OWNERDRAWVARIABLE LBS = 0x0020
+	LBS_HASSTRINGS        LBS = 0x0040
+	LBS_USETABSTOPS       LBS = 0x0080
+	LBS_NOINTEGRALHEIGHT  LBS = 0x0100
+	LBS_MULTICOLUMN       LBS = 0x0200
+	LBS_WANTKEYBOARDINPUT LBS = 0x0400
+	LBS_EXTENDEDSEL       LBS = 0x0800
+	LBS_DISABLENOSCROLL   LBS = 0x1000
+	LBS_NODATA            LBS = 0x2000
+	LBS_NOSEL             LBS = 0x4000
+	LBS_COMBOBOX          LBS = 0x8000
+	LBS_STANDARD          LBS = LBS_NOTIFY | LBS_SORT | LBS(WS_VSCROLL) | LBS(WS_BORDER)
+)
+
 // [LoadImage] fuLoad.
 //
 // [LoadImage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-loadimagew
@@ -789,6 +854,28 @@ const (
 	MB_SERVICE_NOTIFICATION MB = 0x0020_0000
 )
 
+// MDI client window [styles].
+//
+// [styles]: https://learn.microsoft.com/en-us/windows/win32/winmsg/about-the-multiple-document-interface#creating-the-client-window
+type MDIS WS
+
+const (
+	MDIS_ALLCHILDSTYLES MDIS = 0x0001 // Child windows with the WS_MINIMIZE, WS_MAXIMIZE, WS_HSCROLL or WS_VSCROLL styles can be created.
+)
+
+// [WM_MDITILE] and [WM_MDICASCADE] wParam.
+//
+// [WM_MDITILE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-mditile
+// [WM_MDICASCADE]: https://learn.microsoft.com/en-us/windows/win32/winmsg/wm-mdicascade
+type MDITILE uint32
+
+const (
+	MDITILE_VERTICAL     MDITILE = 0x0000
+	MDITILE_HORIZONTAL   MDITILE = 0x0001
+	MDITILE_SKIPDISABLED MDITILE = 0x0002
+	MDITILE_ZORDER       MDITILE = 0x0004
+)
+
 // [CheckMenuItem] uCheck, among others.
 //
 // [CheckMenuItem]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-checkmenuitem
@@ -1,5 +1,3 @@
-//go:build windows
-
 package co
 
 // [WM_COMMAND] notification codes.
@@ -131,6 +129,33 @@ const (
 	EN_AFTER_PASTE  CMD = 0x0801
 )
 
+// RichEdit control [notifications] (EN), sent via WM_NOTIFY.
+//
+// [notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-rich-edit-control-reference-notifications
+const (
+	EN_MSGFILTER         NM = 0x0700
+	EN_REQUESTRESIZE     NM = 0x0701
+	EN_SELCHANGE         NM = 0x0702
+	EN_DROPFILES         NM = 0x0703
+	EN_PROTECTED         NM = 0x0704
+	EN_CORRECTTEXT       NM = 0x0705
+	EN_STOPNOUNDO        NM = 0x0706
+	EN_IMECHANGE         NM = 0x0707
+	EN_SAVECLIPBOARD     NM = 0x0708
+	EN_OLEOPFAILED       NM = 0x0709
+	EN_OBJECTPOSITIONS   NM = 0x070a
+	EN_LINK              NM = 0x070b
+	EN_DRAGDROPDONE      NM = 0x070c
+	EN_PARAGRAPHEXPANDED NM = 0x070d
+	EN_PAGECHANGE        NM = 0x070e
+	EN_LOWFIRTF          NM = 0x070f
+	EN_ALIGNLTR          NM = 0x0710
+	EN_ALIGNRTL          NM = 0x0711
+	EN_CLIPFORMAT        NM = 0x0712
+	EN_STARTCOMPOSITION  NM = 0x0713
+	EN_ENDCOMPOSITION    NM = 0x0714
+)
+
 // Header control [notifications] (HDN).
 //
 // [notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-header-control-reference-notifications
@@ -167,6 +192,18 @@ const (
 	IPN_FIELDCHANGED = _IPN_FIRST - 0
 )
 
+// ListBox control [notifications] (LBN).
+//
+// [notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-list-box-control-reference-notifications
+const (
+	LBN_ERRSPACE  CMD = 0xfffe
+	LBN_SELCHANGE CMD = 1
+	LBN_DBLCLK    CMD = 2
+	LBN_SELCANCEL CMD = 3
+	LBN_SETFOCUS  CMD = 4
+	LBN_KILLFOCUS CMD = 5
+)
+
 // ListView control [notifications] (LVN).
 //
 // [notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-list-view-control-reference-notifications
@@ -214,6 +251,26 @@ const (
 	MCN_VIEWCHANGE  = _MCN_FIRST - 4
 )
 
+// PropertySheet [notifications] (PSN).
+//
+// [notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-property-sheets-reference-notifications
+const (
+	_PSN_FIRST NM = -200
+
+	PSN_SETACTIVE            = _PSN_FIRST - 0
+	PSN_KILLACTIVE           = _PSN_FIRST - 1
+	PSN_APPLY                = _PSN_FIRST - 2
+	PSN_RESET                = _PSN_FIRST - 3
+	PSN_HELP                 = _PSN_FIRST - 5
+	PSN_WIZBACK              = _PSN_FIRST - 6
+	PSN_WIZNEXT              = _PSN_FIRST - 7
+	PSN_WIZFINISH            = _PSN_FIRST - 8
+	PSN_QUERYCANCEL          = _PSN_FIRST - 9
+	PSN_GETOBJECT            = _PSN_FIRST - 10
+	PSN_TRANSLATEACCELERATOR = _PSN_FIRST - 12
+	PSN_QUERYINITIALFOCUS    = _PSN_FIRST - 13
+)
+
 // Rebar control [notifications] (RBN).
 //
 // [notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-rebar-control-reference-notifications
@@ -1,5 +1,3 @@
-//go:build windows
-
 package co
 
 // Window [messages].
@@ -458,6 +456,85 @@ const (
 	EM_GETFILELINECOUNT = _ECM_FIRST + 23
 )
 
+// RichEdit control [messages] (EM).
+//
+// [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-rich-edit-control-reference-messages
+const (
+	EM_CANPASTE             = WM_USER + 50
+	EM_DISPLAYBAND          = WM_USER + 51
+	EM_EXGETSEL             = WM_USER + 52
+	EM_EXLIMITTEXT          = WM_USER + 53
+	EM_EXLINEFROMCHAR       = WM_USER + 54
+	EM_EXSETSEL             = WM_USER + 55
+	EM_FINDTEXT             = WM_USER + 56
+	EM_FORMATRANGE          = WM_USER + 57
+	EM_GETCHARFORMAT        = WM_USER + 58
+	EM_GETEVENTMASK         = WM_USER + 59
+	EM_GETOLEINTERFACE      = WM_USER + 60
+	EM_GETPARAFORMAT        = WM_USER + 61
+	EM_GETSELTEXT           = WM_USER + 62
+	EM_HIDESELECTION        = WM_USER + 63
+	EM_PASTESPECIAL         = WM_USER + 64
+	EM_REQUESTRESIZE        = WM_USER + 65
+	EM_SELECTIONTYPE        = WM_USER + 66
+	EM_SETBKGNDCOLOR        = WM_USER + 67
+	EM_SETCHARFORMAT        = WM_USER + 68
+	EM_SETEVENTMASK         = WM_USER + 69
+	EM_SETOLECALLBACK       = WM_USER + 70
+	EM_SETPARAFORMAT        = WM_USER + 71
+	EM_SETTARGETDEVICE      = WM_USER + 72
+	EM_STREAMIN             = WM_USER + 73
+	EM_STREAMOUT            = WM_USER + 74
+	EM_GETTEXTRANGE         = WM_USER + 75
+	EM_FINDWORDBREAK        = WM_USER + 76
+	EM_SETOPTIONS           = WM_USER + 77
+	EM_GETOPTIONS           = WM_USER + 78
+	EM_FINDTEXTEX           = WM_USER + 79
+	EM_GETWORDBREAKPROCEX   = WM_USER + 80
+	EM_SETWORDBREAKPROCEX   = WM_USER + 81
+	EM_SETUNDOLIMIT         = WM_USER + 82
+	EM_REDO                 = WM_USER + 84
+	EM_CANREDO              = WM_USER + 85
+	EM_GETUNDONAME          = WM_USER + 86
+	EM_GETREDONAME          = WM_USER + 87
+	EM_STOPGROUPTYPING      = WM_USER + 88
+	EM_SETTEXTMODE          = WM_USER + 89
+	EM_GETTEXTMODE          = WM_USER + 90
+	EM_AUTOURLDETECT        = WM_USER + 91
+	EM_GETAUTOURLDETECT     = WM_USER + 92
+	EM_SETPALETTE           = WM_USER + 93
+	EM_GETTEXTEX            = WM_USER + 94
+	EM_GETTEXTLENGTHEX      = WM_USER + 95
+	EM_SHOWSCROLLBAR        = WM_USER + 96
+	EM_SETTEXTEX            = WM_USER + 97
+	EM_SETPUNCTUATION       = WM_USER + 100
+	EM_GETPUNCTUATION       = WM_USER + 101
+	EM_SETWORDWRAPMODE      = WM_USER + 102
+	EM_GETWORDWRAPMODE      = WM_USER + 103
+	EM_SETIMECOLOR          = WM_USER + 104
+	EM_GETIMECOLOR          = WM_USER + 105
+	EM_SETIMEOPTIONS        = WM_USER + 106
+	EM_GETIMEOPTIONS        = WM_USER + 107
+	EM_CONVPOSITION         = WM_USER + 108
+	EM_SETLANGOPTIONS       = WM_USER + 120
+	EM_GETLANGOPTIONS       = WM_USER + 121
+	EM_GETIMECOMPMODE       = WM_USER + 122
+	EM_FINDTEXTW            = WM_USER + 123
+	EM_FINDTEXTEXW          = WM_USER + 124
+	EM_RECONVERSION         = WM_USER + 125
+	EM_SETIMEMODEBIAS       = WM_USER + 126
+	EM_GETIMEMODEBIAS       = WM_USER + 127
+	EM_SETBIDIOPTIONS       = WM_USER + 200
+	EM_GETBIDIOPTIONS       = WM_USER + 201
+	EM_SETTYPOGRAPHYOPTIONS = WM_USER + 202
+	EM_GETTYPOGRAPHYOPTIONS = WM_USER + 203
+	EM_SETEDITSTYLE         = WM_USER + 204
+	EM_GETEDITSTYLE         = WM_USER + 205
+	EM_GETSCROLLPOS         = WM_USER + 221
+	EM_SETSCROLLPOS         = WM_USER + 222
+	EM_SETFONTSIZE          = WM_USER + 223
+)
+
 // Header control [messages] (HDM).
 //
 // [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-header-control-reference-messages
@@ -491,6 +568,53 @@ const (
 	HDM_SETFOCUSEDITEM         = _HDM_FIRST + 28
 )
 
+// ListBox control [messages] (LB).
+//
+// [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-list-box-control-reference-messages
+const (
+	LB_ADDSTRING           WM = 0x0180
+	LB_INSERTSTRING        WM = 0x0181
+	LB_DELETESTRING        WM = 0x0182
+	LB_SELITEMRANGEEX      WM = 0x0183
+	LB_RESETCONTENT        WM = 0x0184
+	LB_SETSEL              WM = 0x0185
+	LB_SETCURSEL           WM = 0x0186
+	LB_GETSEL              WM = 0x0187
+	LB_GETCURSEL           WM = 0x0188
+	LB_GETTEXT             WM = 0x0189
+	LB_GETTEXTLEN          WM = 0x018a
+	LB_GETCOUNT            WM = 0x018b
+	LB_SELECTSTRING        WM = 0x018c
+	LB_DIR                 WM = 0x018d
+	LB_GETTOPINDEX         WM = 0x018e
+	LB_FINDSTRING          WM = 0x018f
+	LB_GETSELCOUNT         WM = 0x0190
+	LB_GETSELITEMS         WM = 0x0191
+	LB_SETTABSTOPS         WM = 0x0192
+	LB_GETHORIZONTALEXTENT WM = 0x0193
+	LB_SETHORIZONTALEXTENT WM = 0x0194
+	LB_SETCOLUMNWIDTH      WM = 0x0195
+	LB_ADDFILE             WM = 0x0196
+	LB_SETTOPINDEX         WM = 0x0197
+	LB_GETITEMRECT         WM = 0x0198
+	LB_GETITEMDATA         WM = 0x0199
+	LB_SETITEMDATA         WM = 0x019a
+	LB_SELITEMRANGE        WM = 0x019b
+	LB_SETANCHORINDEX      WM = 0x019c
+	LB_GETANCHORINDEX      WM = 0x019d
+	LB_SETCARETINDEX       WM = 0x019e
+	LB_GETCARETINDEX       WM = 0x019f
+	LB_SETITEMHEIGHT       WM = 0x01a0
+	LB_GETITEMHEIGHT       WM = 0x01a1
+	LB_FINDSTRINGEXACT     WM = 0x01a2
+	LB_SETLOCALE           WM = 0x01a5
+	LB_GETLOCALE           WM = 0x01a6
+	LB_SETCOUNT            WM = 0x01a7
+	LB_INITSTORAGE         WM = 0x01a8
+	LB_ITEMFROMPOINT       WM = 0x01a9
+	LB_GETLISTBOXINFO      WM = 0x01b2
+)
+
 // ListView control [messages] (LVM).
 //
 // [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-list-view-control-reference-messages
@@ -685,6 +809,45 @@ const (
 	PBM_GETSTATE    = WM_USER + 17
 )
 
+// PropertySheet [messages] (PSM).
+//
+// [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-property-sheets-reference-messages
+const (
+	PSM_SETCURSEL          = WM_USER + 101
+	PSM_REMOVEPAGE         = WM_USER + 102
+	PSM_ADDPAGE            = WM_USER + 103
+	PSM_CHANGED            = WM_USER + 104
+	PSM_RESTARTWINDOWS     = WM_USER + 105
+	PSM_REBOOTSYSTEM       = WM_USER + 106
+	PSM_CANCELTOCLOSE      = WM_USER + 107
+	PSM_QUERYSIBLINGS      = WM_USER + 108
+	PSM_UNCHANGED          = WM_USER + 109
+	PSM_APPLY              = WM_USER + 110
+	PSM_SETWIZBUTTONS      = WM_USER + 112
+	PSM_PRESSBUTTON        = WM_USER + 113
+	PSM_SETCURSELID        = WM_USER + 114
+	PSM_GETTABCONTROL      = WM_USER + 116
+	PSM_ISDIALOGMESSAGE    = WM_USER + 117
+	PSM_GETCURRENTPAGEHWND = WM_USER + 118
+	PSM_INSERTPAGE         = WM_USER + 119
+	PSM_SETTITLE           = WM_USER + 120
+	PSM_SETFINISHTEXT      = WM_USER + 121
+	PSM_SETHEADERTITLE     = WM_USER + 126
+	PSM_SETHEADERSUBTITLE  = WM_USER + 127
+	PSM_HWNDTOINDEX        = WM_USER + 130
+	PSM_INDEXTOHWND        = WM_USER + 131
+	PSM_PAGETOINDEX        = WM_USER + 132
+	PSM_INDEXTOPAGE        = WM_USER + 133
+	PSM_IDTOINDEX          = WM_USER + 134
+	PSM_INDEXTOID          = WM_USER + 135
+	PSM_GETRESULT          = WM_USER + 135
+	PSM_RECALCPAGESIZES    = WM_USER + 136
+	PSM_SETNEXTTEXT        = WM_USER + 137
+	PSM_SHOWWIZBUTTONS     = WM_USER + 138
+	PSM_ENABLEWIZBUTTONS   = WM_USER + 139
+	PSM_SETBUTTONTEXT      = WM_USER + 140
+)
+
 // Status bar control [messages] (SB).
 //
 // [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-status-bars-reference-messages
@@ -884,6 +1047,67 @@ const (
 	TCM_GETUNICODEFORMAT = CCM_GETUNICODEFORMAT
 )
 
+// TaskDialog [messages] (TDM).
+//
+// [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-task-dialogs-reference-messages
+const (
+	TDM_NAVIGATE_PAGE                       = WM_USER + 101
+	TDM_CLICK_BUTTON                        = WM_USER + 102
+	TDM_SET_MARQUEE_PROGRESS_BAR            = WM_USER + 103
+	TDM_SET_PROGRESS_BAR_STATE              = WM_USER + 104
+	TDM_SET_PROGRESS_BAR_RANGE              = WM_USER + 105
+	TDM_SET_PROGRESS_BAR_POS                = WM_USER + 106
+	TDM_SET_PROGRESS_BAR_MARQUEE            = WM_USER + 107
+	TDM_SET_ELEMENT_TEXT                    = WM_USER + 108
+	TDM_CLICK_RADIO_BUTTON                  = WM_USER + 110
+	TDM_ENABLE_BUTTON                       = WM_USER + 111
+	TDM_ENABLE_RADIO_BUTTON                 = WM_USER + 112
+	TDM_CLICK_VERIFICATION                  = WM_USER + 113
+	TDM_UPDATE_ELEMENT_TEXT                 = WM_USER + 114
+	TDM_SET_BUTTON_ELEVATION_REQUIRED_STATE = WM_USER + 115
+	TDM_UPDATE_ICON                         = WM_USER + 116
+)
+
+// Tooltip control [messages] (TTM).
+//
+// [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-tooltip-control-reference-messages
+const (
+	TTM_ACTIVATE        = WM_USER + 1
+	TTM_SETDELAYTIME    = WM_USER + 3
+	TTM_RELAYEVENT      = WM_USER + 7
+	TTM_GETTOOLCOUNT    = WM_USER + 13
+	TTM_WINDOWFROMPOINT = WM_USER + 16
+	TTM_TRACKACTIVATE   = WM_USER + 17
+	TTM_TRACKPOSITION   = WM_USER + 18
+	TTM_SETTIPBKCOLOR   = WM_USER + 19
+	TTM_SETTIPTEXTCOLOR = WM_USER + 20
+	TTM_GETDELAYTIME    = WM_USER + 21
+	TTM_GETTIPBKCOLOR   = WM_USER + 22
+	TTM_GETTIPTEXTCOLOR = WM_USER + 23
+	TTM_SETMAXTIPWIDTH  = WM_USER + 24
+	TTM_GETMAXTIPWIDTH  = WM_USER + 25
+	TTM_SETMARGIN       = WM_USER + 26
+	TTM_GETMARGIN       = WM_USER + 27
+	TTM_POP             = WM_USER + 28
+	TTM_UPDATE          = WM_USER + 29
+	TTM_GETBUBBLESIZE   = WM_USER + 30
+	TTM_ADJUSTRECT      = WM_USER + 31
+	TTM_SETTITLE        = WM_USER + 33
+	TTM_POPUP           = WM_USER + 34
+	TTM_GETTITLE        = WM_USER + 35
+	TTM_ADDTOOL         = WM_USER + 50
+	TTM_DELTOOL         = WM_USER + 51
+	TTM_NEWTOOLRECT     = WM_USER + 52
+	TTM_GETTOOLINFO     = WM_USER + 53
+	TTM_SETTOOLINFO     = WM_USER + 54
+	TTM_HITTEST         = WM_USER + 55
+	TTM_GETTEXT         = WM_USER + 56
+	TTM_UPDATETIPTEXT   = WM_USER + 57
+	TTM_ENUMTOOLS       = WM_USER + 58
+	TTM_GETCURRENTTOOL  = WM_USER + 59
+	TTM_SETWINDOWTHEME  = CCM_SETWINDOWTHEME
+)
+
 // TreeView control [messages] (TVM).
 //
 // [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-tree-view-control-reference-messages
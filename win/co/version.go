@@ -1,5 +1,3 @@
-//go:build windows
-
 package co
 
 // [VS_FIXEDFILEINFO] DwFileType.
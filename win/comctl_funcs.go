@@ -96,6 +96,24 @@ func InitMUILanguage(lang LANGID) {
 
 var _InitMUILanguage *syscall.Proc
 
+// [PropertySheet] function.
+//
+// For modal property sheets, returns a positive value if the changes were
+// saved, or zero if not.
+//
+// [PropertySheet]: https://learn.microsoft.com/en-us/windows/win32/api/prsht/nf-prsht-propertysheetw
+func PropertySheet(psh *PROPSHEETHEADER) (int, error) {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.COMCTL32, &_PropertySheetW, "PropertySheetW"),
+		uintptr(unsafe.Pointer(psh)))
+	if int(ret) == -1 {
+		return 0, co.ERROR(err)
+	}
+	return int(ret), nil
+}
+
+var _PropertySheetW *syscall.Proc
+
 // [TaskDialogIndirect] function.
 //
 // # Example
@@ -248,6 +248,25 @@ func (hImg HIMAGELIST) GetBkColor() COLORREF {
 
 var _ImageList_GetBkColor *syscall.Proc
 
+// [ImageList_GetIcon] function.
+//
+// ⚠️ You must defer [HICON.DestroyIcon].
+//
+// [ImageList_GetIcon]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/nf-commctrl-imagelist_geticon
+func (hImg HIMAGELIST) GetIcon(index int, flags co.ILD) (HICON, error) {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.COMCTL32, &_ImageList_GetIcon, "ImageList_GetIcon"),
+		uintptr(hImg),
+		uintptr(int32(index)),
+		uintptr(flags))
+	if ret == 0 {
+		return HICON(0), co.ERROR_INVALID_PARAMETER
+	}
+	return HICON(ret), nil
+}
+
+var _ImageList_GetIcon *syscall.Proc
+
 // [ImageList_GetIconSize] function.
 //
 // [ImageList_GetIconSize]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/nf-commctrl-imagelist_geticonsize
@@ -857,6 +857,25 @@ type NMTREEVIEW struct {
 	PtDrag  POINT
 }
 
+// [NMTTDISPINFO] struct.
+//
+// [NMTTDISPINFO]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmttdispinfow
+type NMTTDISPINFO struct {
+	Hdr      NMHDR
+	LpszText *uint16
+	szText   [80]uint16
+	Hinst    HINSTANCE
+	UFlags   co.TTF
+	LParam   LPARAM
+}
+
+func (di *NMTTDISPINFO) SzText() string {
+	return wstr.DecodeSlice(di.szText[:])
+}
+func (di *NMTTDISPINFO) SetSzText(val string) {
+	wstr.EncodeToBuf(val, di.szText[:])
+}
+
 // [NMTVASYNCDRAW] struct.
 //
 // [NMTVASYNCDRAW]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmtvasyncdraw
@@ -954,6 +973,77 @@ type PBRANGE struct {
 	IHigh int32
 }
 
+// [PROPSHEETHEADER] struct.
+//
+// ⚠️ You must call [PROPSHEETHEADER.SetDwSize] to initialize the struct.
+//
+// # Example
+//
+//	var psh win.PROPSHEETHEADER
+//	psh.SetDwSize()
+//
+// [PROPSHEETHEADER]: https://learn.microsoft.com/en-us/windows/win32/api/prsht/ns-prsht-propsheetheaderw_v2
+type PROPSHEETHEADER struct {
+	dwSize       uint32
+	DwFlags      co.PSH
+	HwndParent   HWND
+	HInstance    HINSTANCE
+	HIcon        HICON // Union HICON + PCWSTR.
+	PszCaption   *uint16
+	NPages       uint32
+	NStartPage   uintptr        // Union UINT + PCWSTR.
+	Ppsp         *PROPSHEETPAGE // Union PROPSHEETPAGE + HPROPSHEETPAGE array.
+	PfnCallback  uintptr
+	HbmWatermark HBITMAP // Union HBITMAP + PCWSTR.
+	HplWatermark HPALETTE
+	HbmHeader    HBITMAP // Union HBITMAP + PCWSTR.
+}
+
+// Sets the dwSize field to the size of the struct, correctly initializing it.
+func (psh *PROPSHEETHEADER) SetDwSize() {
+	psh.dwSize = uint32(unsafe.Sizeof(*psh))
+}
+
+// [PROPSHEETPAGE] struct.
+//
+// ⚠️ You must call [PROPSHEETPAGE.SetDwSize] to initialize the struct.
+//
+// # Example
+//
+//	var psp win.PROPSHEETPAGE
+//	psp.SetDwSize()
+//
+// [PROPSHEETPAGE]: https://learn.microsoft.com/en-us/windows/win32/api/prsht/ns-prsht-propsheetpagew
+type PROPSHEETPAGE struct {
+	dwSize            uint32
+	DwFlags           co.PSP
+	HInstance         HINSTANCE
+	PResource         uintptr // Union PCWSTR + DLGTEMPLATE pointer.
+	HIcon             HICON   // Union HICON + PCWSTR.
+	PszTitle          *uint16
+	PfnDlgProc        uintptr
+	LParam            LPARAM
+	PfnCallback       uintptr
+	PcRefParent       *uint32
+	PszHeaderTitle    *uint16
+	PszHeaderSubTitle *uint16
+	HActCtx           HACTCTX
+	HbmHeader         HBITMAP // Union HBITMAP + PCWSTR.
+}
+
+// Sets the dwSize field to the size of the struct, correctly initializing it.
+func (psp *PROPSHEETPAGE) SetDwSize() {
+	psp.dwSize = uint32(unsafe.Sizeof(*psp))
+}
+
+// [PSHNOTIFY] struct.
+//
+// [PSHNOTIFY]: https://learn.microsoft.com/en-us/windows/win32/api/prsht/ns-prsht-pshnotify
+type PSHNOTIFY struct {
+	Hdr    NMHDR
+	LParam LPARAM
+}
+
 // [TASKDIALOG_BUTTON] struct syntactic sugar.
 //
 // This struct originally has a packed alignment, so we serialized it before the
@@ -1107,6 +1197,33 @@ type TBBUTTON struct {
 	IString   *uint16 // Can also be the index in the string list.
 }
 
+// [TTTOOLINFO] struct.
+//
+// ⚠️ You must call [TTTOOLINFO.SetCbSize] to initialize the struct.
+//
+// # Example
+//
+//	var ti win.TTTOOLINFO
+//	ti.SetCbSize()
+//
+// [TTTOOLINFO]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-tttoolinfow
+type TTTOOLINFO struct {
+	cbSize     uint32
+	UFlags     co.TTF
+	Hwnd       HWND
+	UId        uintptr
+	Rect       RECT
+	Hinst      HINSTANCE
+	LpszText   *uint16 // Can be LPSTR_TEXTCALLBACK, which is ^uintptr(0).
+	LParam     LPARAM
+	lpReserved uintptr
+}
+
+// Sets the cbSize field to the size of the struct, correctly initializing it.
+func (ti *TTTOOLINFO) SetCbSize() {
+	ti.cbSize = uint32(unsafe.Sizeof(*ti))
+}
+
 // [TVINSERTSTRUCT] struct.
 //
 // [TVINSERTSTRUCT]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-tvinsertstructw
@@ -690,3 +690,29 @@ func SystemTimeToTzSpecificLocalTime(
 }
 
 var _SystemTimeToTzSpecificLocalTime *syscall.Proc
+
+// [WaitForMultipleObjects] function.
+//
+// The returned value is [co.WAIT_OBJECT_0] plus the index of the signaled
+// handle, or [co.WAIT_TIMEOUT]. For INFINITE, use milliseconds 0xffff_ffff.
+//
+// Panics if handles is empty.
+//
+// [WaitForMultipleObjects]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-waitformultipleobjects
+func WaitForMultipleObjects(handles []HANDLE, waitAll bool, milliseconds uint) (co.WAIT, error) {
+	if len(handles) == 0 {
+		panic("WaitForMultipleObjects: no handles given.")
+	}
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.KERNEL32, &_WaitForMultipleObjects, "WaitForMultipleObjects"),
+		uintptr(uint32(len(handles))),
+		uintptr(unsafe.Pointer(&handles[0])),
+		utl.BoolToUintptr(waitAll),
+		uintptr(uint32(milliseconds)))
+	if co.WAIT(ret) == co.WAIT_FAILED {
+		return co.WAIT_FAILED, co.ERROR(err)
+	}
+	return co.WAIT(ret), nil
+}
+
+var _WaitForMultipleObjects *syscall.Proc
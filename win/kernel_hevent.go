@@ -0,0 +1,96 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/dll"
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// Handle to an [event] object.
+//
+// [event]: https://learn.microsoft.com/en-us/windows/win32/sync/event-objects
+type HEVENT HANDLE
+
+// [CreateEvent] function.
+//
+// ⚠️ You must defer [HEVENT.CloseHandle].
+//
+// # Example
+//
+//	hEvent, _ := win.CreateEvent(nil, false, false, "")
+//	defer hEvent.CloseHandle()
+//
+// [CreateEvent]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-createeventw
+func CreateEvent(
+	securityAttributes *SECURITY_ATTRIBUTES,
+	manualReset, initialState bool,
+	name string,
+) (HEVENT, error) {
+	wbuf := wstr.NewBufEncoder()
+	defer wbuf.Free()
+	pName := wbuf.PtrEmptyIsNil(name)
+
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.KERNEL32, &_CreateEventW, "CreateEventW"),
+		uintptr(unsafe.Pointer(securityAttributes)),
+		utl.BoolToUintptr(manualReset),
+		utl.BoolToUintptr(initialState),
+		uintptr(pName))
+	if ret == 0 {
+		return HEVENT(0), co.ERROR(err)
+	}
+	return HEVENT(ret), nil
+}
+
+var _CreateEventW *syscall.Proc
+
+// [CloseHandle] function.
+//
+// [CloseHandle]: https://learn.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-closehandle
+func (hEvent HEVENT) CloseHandle() error {
+	return HANDLE(hEvent).CloseHandle()
+}
+
+// [ResetEvent] function.
+//
+// [ResetEvent]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-resetevent
+func (hEvent HEVENT) ResetEvent() error {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.KERNEL32, &_ResetEvent, "ResetEvent"),
+		uintptr(hEvent))
+	return utl.ZeroAsGetLastError(ret, err)
+}
+
+var _ResetEvent *syscall.Proc
+
+// [SetEvent] function.
+//
+// [SetEvent]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-setevent
+func (hEvent HEVENT) SetEvent() error {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.KERNEL32, &_SetEvent, "SetEvent"),
+		uintptr(hEvent))
+	return utl.ZeroAsGetLastError(ret, err)
+}
+
+var _SetEvent *syscall.Proc
+
+// [WaitForSingleObject] function.
+//
+// For INFINITE, use [HEVENT.WaitForSingleObjectInfinite].
+//
+// [WaitForSingleObject]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-waitforsingleobject
+func (hEvent HEVENT) WaitForSingleObject(milliseconds uint) (co.WAIT, error) {
+	return HTHREAD(hEvent).WaitForSingleObject(milliseconds) // same underlying call
+}
+
+// [HEVENT.WaitForSingleObject] function with INFINITE value.
+func (hEvent HEVENT) WaitForSingleObjectInfinite() (co.WAIT, error) {
+	return hEvent.WaitForSingleObject(utl.INFINITE)
+}
@@ -0,0 +1,236 @@
+//go:build windows
+
+package win
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/wstr"
+)
+
+// The RichEdit structs are declared within #pragma pack(4), so structs with
+// pointer-sized fields after a 4-byte field can't be represented with Go
+// fields on 64-bit; these are stored as raw bytes, with accessor methods.
+
+// Offset of a field, within a pack(4) struct, placed after nPtrs pointer-sized
+// fields and n32 4-byte fields.
+func richEditOff(nPtrs, n32 uintptr) uintptr {
+	return nPtrs*unsafe.Sizeof(uintptr(0)) + n32*4
+}
+
+// [CHARFORMAT2] struct.
+//
+// ⚠️ You must call [CHARFORMAT2.SetCbSize] to initialize the struct.
+//
+// # Example
+//
+//	var cf win.CHARFORMAT2
+//	cf.SetCbSize()
+//
+// [CHARFORMAT2]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-charformat2w
+type CHARFORMAT2 struct {
+	cbSize          uint32
+	DwMask          co.CFM
+	DwEffects       co.CFE
+	YHeight         int32 // Character height, in twips (1/1440 of an inch).
+	YOffset         int32
+	CrTextColor     COLORREF
+	BCharSet        co.CHARSET
+	BPitchAndFamily uint8
+	szFaceName      [utl.LF_FACESIZE]uint16
+	WWeight         uint16
+	SSpacing        int16
+	CrBackColor     COLORREF
+	Lcid            LCID
+	DwCookie        uint32
+	SStyle          int16
+	WKerning        uint16
+	BUnderlineType  uint8
+	BAnimation      uint8
+	BRevAuthor      uint8
+	BUnderlineColor uint8
+}
+
+// Sets the cbSize field to the size of the struct, correctly initializing it.
+func (cf *CHARFORMAT2) SetCbSize() {
+	cf.cbSize = uint32(unsafe.Sizeof(*cf))
+}
+
+func (cf *CHARFORMAT2) SzFaceName() string {
+	return wstr.DecodeSlice(cf.szFaceName[:])
+}
+func (cf *CHARFORMAT2) SetSzFaceName(val string) {
+	wstr.EncodeToBuf(val, cf.szFaceName[:])
+}
+
+// [CHARRANGE] struct.
+//
+// [CHARRANGE]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-charrange
+type CHARRANGE struct {
+	CpMin int32
+	CpMax int32
+}
+
+// [EDITSTREAM] struct.
+//
+// [EDITSTREAM]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-editstream
+type EDITSTREAM struct {
+	raw [20]byte // dwCookie, dwError, pfnCallback
+}
+
+func (es *EDITSTREAM) DwCookie() uintptr {
+	return *(*uintptr)(unsafe.Pointer(&es.raw[0]))
+}
+func (es *EDITSTREAM) SetDwCookie(val uintptr) {
+	*(*uintptr)(unsafe.Pointer(&es.raw[0])) = val
+}
+
+func (es *EDITSTREAM) DwError() uint32 {
+	return *(*uint32)(unsafe.Pointer(&es.raw[richEditOff(1, 0)]))
+}
+
+func (es *EDITSTREAM) PfnCallback() uintptr {
+	return *(*uintptr)(unsafe.Pointer(&es.raw[richEditOff(1, 1)]))
+}
+func (es *EDITSTREAM) SetPfnCallback(val uintptr) {
+	*(*uintptr)(unsafe.Pointer(&es.raw[richEditOff(1, 1)])) = val
+}
+
+// [ENDROPFILES] struct.
+//
+// [ENDROPFILES]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-endropfiles
+type ENDROPFILES struct {
+	Hdr        NMHDR
+	HDrop      HDROP
+	Cp         int32
+	fProtected int32 // BOOL
+}
+
+func (edf *ENDROPFILES) FProtected() bool {
+	return edf.fProtected != 0
+}
+
+// [ENLINK] struct.
+//
+// [ENLINK]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-enlink
+type ENLINK struct {
+	Hdr NMHDR
+	raw [28]byte // msg, wParam, lParam, chrg
+}
+
+func (el *ENLINK) Msg() co.WM {
+	return *(*co.WM)(unsafe.Pointer(&el.raw[0]))
+}
+func (el *ENLINK) WParam() WPARAM {
+	return *(*WPARAM)(unsafe.Pointer(&el.raw[richEditOff(0, 1)]))
+}
+func (el *ENLINK) LParam() LPARAM {
+	return *(*LPARAM)(unsafe.Pointer(&el.raw[richEditOff(1, 1)]))
+}
+func (el *ENLINK) Chrg() CHARRANGE {
+	return *(*CHARRANGE)(unsafe.Pointer(&el.raw[richEditOff(2, 1)]))
+}
+
+// [FINDTEXTEX] struct.
+//
+// [FINDTEXTEX]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-findtextexw
+type FINDTEXTEX struct {
+	Chrg      CHARRANGE
+	LpstrText *uint16
+	ChrgText  CHARRANGE
+}
+
+// [GETTEXTLENGTHEX] struct.
+//
+// [GETTEXTLENGTHEX]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-gettextlengthex
+type GETTEXTLENGTHEX struct {
+	Flags    co.GTL
+	Codepage uint32
+}
+
+// [MSGFILTER] struct.
+//
+// [MSGFILTER]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-msgfilter
+type MSGFILTER struct {
+	Hdr NMHDR
+	raw [20]byte // msg, wParam, lParam
+}
+
+func (mf *MSGFILTER) Msg() co.WM {
+	return *(*co.WM)(unsafe.Pointer(&mf.raw[0]))
+}
+func (mf *MSGFILTER) WParam() WPARAM {
+	return *(*WPARAM)(unsafe.Pointer(&mf.raw[richEditOff(0, 1)]))
+}
+func (mf *MSGFILTER) LParam() LPARAM {
+	return *(*LPARAM)(unsafe.Pointer(&mf.raw[richEditOff(1, 1)]))
+}
+
+// [PARAFORMAT2] struct.
+//
+// ⚠️ You must call [PARAFORMAT2.SetCbSize] to initialize the struct.
+//
+// # Example
+//
+//	var pf win.PARAFORMAT2
+//	pf.SetCbSize()
+//
+// [PARAFORMAT2]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-paraformat2
+type PARAFORMAT2 struct {
+	cbSize           uint32
+	DwMask           co.PFM
+	WNumbering       co.PFN
+	WEffects         uint16
+	DxStartIndent    int32
+	DxRightIndent    int32
+	DxOffset         int32
+	WAlignment       co.PFA
+	CTabCount        int16
+	RgxTabs          [32]int32
+	DySpaceBefore    int32
+	DySpaceAfter     int32
+	DyLineSpacing    int32
+	SStyle           int16
+	BLineSpacingRule uint8
+	BOutlineLevel    uint8
+	WShadingWeight   uint16
+	WShadingStyle    uint16
+	WNumberingStart  uint16
+	WNumberingStyle  uint16
+	WNumberingTab    uint16
+	WBorderSpace     uint16
+	WBorderWidth     uint16
+	WBorders         uint16
+}
+
+// Sets the cbSize field to the size of the struct, correctly initializing it.
+func (pf *PARAFORMAT2) SetCbSize() {
+	pf.cbSize = uint32(unsafe.Sizeof(*pf))
+}
+
+// [REQRESIZE] struct.
+//
+// [REQRESIZE]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-reqresize
+type REQRESIZE struct {
+	Hdr NMHDR
+	Rc  RECT
+}
+
+// [SELCHANGE] struct.
+//
+// [SELCHANGE]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-selchange
+type SELCHANGE struct {
+	Hdr    NMHDR
+	Chrg   CHARRANGE
+	Seltyp co.SEL
+}
+
+// [TEXTRANGE] struct.
+//
+// [TEXTRANGE]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-textrangew
+type TEXTRANGE struct {
+	Chrg      CHARRANGE
+	LpstrText *uint16
+}
@@ -408,6 +408,21 @@ func GetInputState() bool {
 
 var _GetInputState *syscall.Proc
 
+// [GetKeyState] function.
+//
+// Unlike [GetAsyncKeyState], returns the state of the key when the message
+// being processed was generated.
+//
+// [GetKeyState]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getkeystate
+func GetKeyState(virtKeyCode co.VK) uint16 {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.USER32, &_GetKeyState, "GetKeyState"),
+		uintptr(virtKeyCode))
+	return uint16(ret)
+}
+
+var _GetKeyState *syscall.Proc
+
 // [GetMessage] function.
 //
 // [GetMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getmessagew
@@ -744,6 +759,17 @@ func RegisterWindowMessage(message string) (co.WM, error) {
 
 var _RegisterWindowMessageW *syscall.Proc
 
+// [ReleaseCapture] function.
+//
+// [ReleaseCapture]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-releasecapture
+func ReleaseCapture() error {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_ReleaseCapture, "ReleaseCapture"))
+	return utl.ZeroAsGetLastError(ret, err)
+}
+
+var _ReleaseCapture *syscall.Proc
+
 // [ReplyMessage] function.
 //
 // [ReplyMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-replymessage
@@ -824,6 +850,20 @@ func SetProcessDefaultLayout(defaultLayout co.LAYOUT) error {
 
 var _SetProcessDefaultLayout *syscall.Proc
 
+// [SetProcessDpiAwarenessContext] function.
+//
+// Available on Windows 10 version 1703 or later.
+//
+// [SetProcessDpiAwarenessContext]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setprocessdpiawarenesscontext
+func SetProcessDpiAwarenessContext(value co.DPI_AWARENESS_CONTEXT) error {
+	ret, _, err := syscall.SyscallN(
+		dll.Load(dll.USER32, &_SetProcessDpiAwarenessContext, "SetProcessDpiAwarenessContext"),
+		uintptr(value))
+	return utl.ZeroAsGetLastError(ret, err)
+}
+
+var _SetProcessDpiAwarenessContext *syscall.Proc
+
 // [SetProcessDPIAware] function.
 //
 // [SetProcessDPIAware]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setprocessdpiaware
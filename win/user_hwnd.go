@@ -77,6 +77,17 @@ func FindWindow(className ClassName, title string) (HWND, bool) {
 
 var _FindWindowW *syscall.Proc
 
+// [GetCapture] function.
+//
+// [GetCapture]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getcapture
+func GetCapture() HWND {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.USER32, &_GetCapture, "GetCapture"))
+	return HWND(ret)
+}
+
+var _GetCapture *syscall.Proc
+
 // [GetClipboardOwner] function.
 //
 // [GetClipboardOwner]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getclipboardowner
@@ -319,6 +330,37 @@ func (hWnd HWND) DefDlgProc(msg co.WM, wParam WPARAM, lParam LPARAM) uintptr {
 
 var _DefDlgProcW *syscall.Proc
 
+// [DefFrameProc] function.
+//
+// [DefFrameProc]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-defframeprocw
+func (hWnd HWND) DefFrameProc(hMdiClient HWND, msg co.WM, wParam WPARAM, lParam LPARAM) uintptr {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.USER32, &_DefFrameProcW, "DefFrameProcW"),
+		uintptr(hWnd),
+		uintptr(hMdiClient),
+		uintptr(msg),
+		uintptr(wParam),
+		uintptr(lParam))
+	return ret
+}
+
+var _DefFrameProcW *syscall.Proc
+
+// [DefMDIChildProc] function.
+//
+// [DefMDIChildProc]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-defmdichildprocw
+func (hWnd HWND) DefMDIChildProc(msg co.WM, wParam WPARAM, lParam LPARAM) uintptr {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.USER32, &_DefMDIChildProcW, "DefMDIChildProcW"),
+		uintptr(hWnd),
+		uintptr(msg),
+		uintptr(wParam),
+		uintptr(lParam))
+	return ret
+}
+
+var _DefMDIChildProcW *syscall.Proc
+
 // [DefWindowProc] function.
 //
 // [DefWindowProc]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-defwindowprocw
@@ -570,6 +612,20 @@ func (hWnd HWND) GetDlgItem(dlgId uint16) (HWND, error) {
 
 var _GetDlgItem *syscall.Proc
 
+// [GetDpiForWindow] function.
+//
+// Available on Windows 10 version 1607 or later.
+//
+// [GetDpiForWindow]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getdpiforwindow
+func (hWnd HWND) GetDpiForWindow() uint32 {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.USER32, &_GetDpiForWindow, "GetDpiForWindow"),
+		uintptr(hWnd))
+	return uint32(ret)
+}
+
+var _GetDpiForWindow *syscall.Proc
+
 // [GetLastActivePopup] function.
 //
 // [GetLastActivePopup]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getlastactivepopup
@@ -1048,6 +1104,20 @@ func (hWnd HWND) SendMessage(msg co.WM, wParam WPARAM, lParam LPARAM) (uintptr,
 
 var _SendMessageW *syscall.Proc
 
+// [SetCapture] function.
+//
+// Returns a handle to the window which had previously captured the mouse.
+//
+// [SetCapture]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setcapture
+func (hWnd HWND) SetCapture() HWND {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.USER32, &_SetCapture, "SetCapture"),
+		uintptr(hWnd))
+	return HWND(ret)
+}
+
+var _SetCapture *syscall.Proc
+
 // [SetFocus] function.
 //
 // Returns a handle to the previously focused window.
@@ -1315,6 +1385,21 @@ func (hWnd HWND) TranslateAccelerator(hAccel HACCEL, msg *MSG) error {
 
 var _TranslateAcceleratorW *syscall.Proc
 
+// [TranslateMDISysAccel] function.
+//
+// This method must be called on the MDI client window.
+//
+// [TranslateMDISysAccel]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-translatemdisysaccel
+func (hWnd HWND) TranslateMDISysAccel(msg *MSG) bool {
+	ret, _, _ := syscall.SyscallN(
+		dll.Load(dll.USER32, &_TranslateMDISysAccel, "TranslateMDISysAccel"),
+		uintptr(hWnd),
+		uintptr(unsafe.Pointer(msg)))
+	return ret != 0
+}
+
+var _TranslateMDISysAccel *syscall.Proc
+
 // [UpdateWindow] function.
 //
 // [UpdateWindow]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-updatewindow
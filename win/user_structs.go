@@ -19,6 +19,14 @@ type ACCEL struct {
 	Cmd   uint16    // LOWORD(wParam) value.
 }
 
+// [CLIENTCREATESTRUCT] struct.
+//
+// [CLIENTCREATESTRUCT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-clientcreatestruct
+type CLIENTCREATESTRUCT struct {
+	HWindowMenu  HMENU
+	IdFirstChild uint32
+}
+
 // [COMPAREITEMSTRUCT] struct.
 //
 // [COMPAREITEMSTRUCT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-compareitemstruct
@@ -352,6 +360,19 @@ func (lp LPARAM) MakeSize() SIZE {
 	}
 }
 
+// [MDICREATESTRUCT] struct.
+//
+// [MDICREATESTRUCT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-mdicreatestructw
+type MDICREATESTRUCT struct {
+	SzClass *uint16
+	SzTitle *uint16
+	HOwner  HINSTANCE
+	X, Y    int32
+	Cx, Cy  int32
+	Style   co.WS
+	LParam  LPARAM
+}
+
 // [MDINEXTMENU] struct.
 //
 // [MDINEXTMENU]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-mdinextmenu
@@ -361,6 +382,18 @@ type MDINEXTMENU struct {
 	HwndNext  HWND
 }
 
+// [MEASUREITEMSTRUCT] struct.
+//
+// [MEASUREITEMSTRUCT]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-measureitemstruct
+type MEASUREITEMSTRUCT struct {
+	CtlType    co.ODT
+	CtlID      uint32
+	ItemID     uint32
+	ItemWidth  uint32
+	ItemHeight uint32
+	ItemData   uintptr // ULONG_PTR
+}
+
 // [MENUGETOBJECTINFO] struct.
 //
 // [MENUGETOBJECTINFO]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-menugetobjectinfo
@@ -1,16 +1,23 @@
-//go:build windows
-
 package win
 
-import (
-	"strings"
+// This file has no knowledge of windows, so the .ini document can be tested on
+// any OS.
 
+import (
 	"github.com/rodrigocfd/windigo/win/co"
-	"github.com/rodrigocfd/windigo/win/wstr"
 )
 
 type (
-	// High-level abstraction for .ini file contents, loaded with [IniLoad].
+	// High-level abstraction for .ini file contents, loaded with [IniLoad] or
+	// [IniParse].
+	//
+	// The document is lossless: comments, blank lines, the order of the keys,
+	// duplicated keys, quoted values, line endings and text encoding are kept
+	// when the contents are serialized back. Only the sections and entries you
+	// change are rewritten.
+	//
+	// Keys which appear before the first section belong to a section with an
+	// empty name, which is written without a header.
 	Ini struct {
 		// Path to the .ini file that has been loaded. If you want to save the
 		// .ini somewhere else, you may change this value.
@@ -18,6 +25,14 @@ type (
 		// Sections of the .ini file. You may modify or rearrange these before
 		// saving the file.
 		Sections []IniSection
+		// Text encoding used when saving the file. When loaded, it's the
+		// encoding detected from the byte order mark, if any.
+		Encoding co.INI_ENC
+
+		preamble  []string // comments and blank lines before the first section
+		trailer   []string // comments and blank lines after the last entry
+		lineBreak string   // "\r\n" or "\n"; empty means "\r\n"
+		noLastBr  bool     // last line of the file has no line break
 	}
 
 	// High-level abstraction for .ini file sections.
@@ -28,95 +43,42 @@ type (
 		// Entries of the section. You may modify or rearrange these before
 		// saving the file.
 		Entries []IniEntry
+
+		comments []string // comments and blank lines before the header
+		header   string   // original header line, kept if Name is unchanged
+		origName string
+		line     int // 1-based line number, zero if not parsed
 	}
 
 	// High-level abstracion for .ini entries.
 	IniEntry struct {
 		// Name of the key. You may change this value before saving the file.
 		Key string
-		// Actual value. You may change this value before saving the file.
+		// Actual value, without the enclosing quotes, if any. You may change
+		// this value before saving the file.
 		Value string
+
+		comments  []string // comments and blank lines before the entry
+		raw       string   // original line, kept if Key and Value are unchanged
+		indent    string   // whitespace before the key
+		separator string   // equal sign, with its surrounding whitespace
+		quote     string   // quote char which enclosed the value, if any
+		origKey   string
+		origValue string
+		line      int // 1-based line number, zero if not parsed
 	}
 )
 
-// Creates an [Ini] object by reading an .ini file, parsing its contents.
+// Serializes the contents as text, keeping the original line endings.
 //
-// # Example
-//
-//	ini, _ := win.IniLoad("C:\\Temp\\foo.ini")
-func IniLoad(iniPath string) (*Ini, error) {
-	lines, err := iniLoadLines(iniPath)
-	if err != nil {
-		return nil, err
-	}
-
-	me := &Ini{}
-	var curSection IniSection
-
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if len(line) == 0 || line[0] == '#' || line[0] == ';' {
-			continue // skip blank lines
-		}
-
-		if line[0] == '[' && line[len(line)-1] == ']' { // [section] ?
-			if curSection.Name != "" {
-				me.Sections = append(me.Sections, curSection)
-			}
-			curSection = IniSection{ // create a new section with the given name
-				Name:    strings.TrimSpace(line[1 : len(line)-1]),
-				Entries: make([]IniEntry, 0),
-			}
-		} else if curSection.Name != "" {
-			keyVal := strings.SplitN(line, "=", 2)
-			curSection.Entries = append(curSection.Entries, IniEntry{
-				Key:   strings.TrimSpace(keyVal[0]),
-				Value: strings.TrimSpace(keyVal[1]),
-			})
-		}
-	}
-
-	if curSection.Name != "" { // for the last section
-		me.Sections = append(me.Sections, curSection)
-	}
-
-	me.Path = iniPath // keep
-	return me, nil
-}
-
-func iniLoadLines(iniPath string) ([]string, error) {
-	fin, err := FileMapOpen(iniPath, co.FOPEN_READ_EXISTING)
-	if err != nil {
-		return nil, err
-	}
-	defer fin.Close()
-	return wstr.SplitLines(string(fin.HotSlice())), nil
-}
-
-// Serializes the contents.
+// To obtain the contents in the original text encoding, use [Ini.Bytes].
 func (me *Ini) Serialize() string {
-	var serialized strings.Builder
-
-	for idxSection := range me.Sections {
-		section := &me.Sections[idxSection]
-		serialized.WriteString("[" + section.Name + "]\r\n")
-		for idxEntry := range section.Entries {
-			value := &section.Entries[idxEntry]
-			serialized.WriteString(value.Key + "=" + value.Value + "\r\n")
-		}
-
-		isLast := idxSection == len(me.Sections)-1
-		if !isLast {
-			serialized.WriteString("\r\n")
-		}
-	}
-
-	return serialized.String()
+	return iniSerialize(me)
 }
 
-// Serializes and saves the contents into the .ini file.
-func (me *Ini) SaveToFile(filePath string) error {
-	return FileWrite(filePath, []byte(me.Serialize()))
+// Serializes the contents, encoded according to [Ini.Encoding].
+func (me *Ini) Bytes() []byte {
+	return iniEncode(iniSerialize(me), me.Encoding)
 }
 
 // Returns a pointer to the section with the given name, or nil if not existing.
@@ -137,19 +99,27 @@ func (me *Ini) Get(section, key string) (string, bool) {
 	return "", false
 }
 
-// Sets the given value. If the section/key pair doesn't exist, create it.
+// Sets the given value. If the section/key pair doesn't exist, create it. A
+// section with an empty name is created before all others.
 func (me *Ini) Set(section, key, value string) {
 	if pSection := me.GetSection(section); pSection != nil {
 		pSection.Set(key, value) // section exists
+		return
+	}
+
+	newSection := IniSection{ // section doesn't exist
+		Name:    section,
+		Entries: []IniEntry{{Key: key, Value: value}},
+	}
+	if section == "" {
+		me.Sections = append([]IniSection{newSection}, me.Sections...) // keys before any section
 	} else {
-		me.Sections = append(me.Sections, IniSection{ // section doesn't exist
-			Name:    section,
-			Entries: []IniEntry{{key, value}},
-		})
+		me.Sections = append(me.Sections, newSection)
 	}
 }
 
-// Returns the given value, if existing.
+// Returns the given value, if existing. If the key is duplicated, returns the
+// first one.
 func (me *IniSection) Get(key string) (string, bool) {
 	for idx := range me.Entries {
 		if me.Entries[idx].Key == key {
@@ -159,7 +129,20 @@ func (me *IniSection) Get(key string) (string, bool) {
 	return "", false
 }
 
-// Sets the given value. If the key doesn't exist, creates it.
+// Returns all the values of the given key, in the order they appear, for keys
+// which are duplicated.
+func (me *IniSection) GetAll(key string) []string {
+	values := make([]string, 0, 1)
+	for idx := range me.Entries {
+		if me.Entries[idx].Key == key {
+			values = append(values, me.Entries[idx].Value)
+		}
+	}
+	return values
+}
+
+// Sets the given value. If the key doesn't exist, creates it. If the key is
+// duplicated, sets the first one.
 func (me *IniSection) Set(key, value string) {
 	for idx := range me.Entries {
 		if me.Entries[idx].Key == key {
@@ -167,5 +150,5 @@ func (me *IniSection) Set(key, value string) {
 			return
 		}
 	}
-	me.Entries = append(me.Entries, IniEntry{key, value}) // key doesn't exist
+	me.Entries = append(me.Entries, IniEntry{Key: key, Value: value}) // key doesn't exist
 }
@@ -0,0 +1,375 @@
+package win
+
+// This file has no knowledge of windows, so the struct binding can be tested on
+// any OS.
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Error returned by [IniUnmarshal] and [IniMarshal], carrying the position
+// of the offending value.
+type IniError struct {
+	Line    int    // 1-based line number in the .ini file; zero if unknown.
+	Section string // Name of the section.
+	Key     string // Name of the key; empty if the error refers to the section.
+	Err     error  // Underlying error.
+}
+
+// Implements [error].
+func (e *IniError) Error() string {
+	var buf strings.Builder
+	if e.Line > 0 {
+		buf.WriteString(fmt.Sprintf("line %d: ", e.Line))
+	}
+	buf.WriteString("[" + e.Section + "]")
+	if e.Key != "" {
+		buf.WriteString(" " + e.Key)
+	}
+	buf.WriteString(": " + e.Err.Error())
+	return buf.String()
+}
+
+// Allows [errors.Is] and [errors.As] on the underlying error.
+func (e *IniError) Unwrap() error {
+	return e.Err
+}
+
+// Loads the values of the .ini document into the struct pointed to by v.
+//
+// Each field of the struct must be itself a struct, which maps to a section.
+// Each field of a section struct maps to a key. A struct field within a section
+// struct maps to a nested section, whose name is the parent section name, a
+// dot, and its own name.
+//
+// Field names are used as section and key names, unless overridden by an "ini"
+// struct tag. A "-" tag skips the field. The supported field types are:
+//   - string;
+//   - signed and unsigned integers;
+//   - bool, which also accepts yes/no and on/off;
+//   - float32 and float64;
+//   - [time.Duration], in the [time.ParseDuration] format;
+//   - []string, as comma-separated values;
+//   - any type implementing [encoding.TextUnmarshaler].
+//
+// Sections and keys absent from the document leave the fields untouched. On
+// error, an [IniError] is returned.
+//
+// # Example
+//
+//	type Config struct {
+//		Window struct {
+//			Width   int
+//			Height  int
+//			Maxed   bool          `ini:"maximized"`
+//			Refresh time.Duration `ini:"refresh"`
+//		} `ini:"window"`
+//		Recent struct {
+//			Files []string `ini:"files"`
+//		} `ini:"recent"`
+//	}
+//
+//	ini, _ := win.IniLoad("C:\\Temp\\foo.ini")
+//	var cfg Config
+//	if err := win.IniUnmarshal(ini, &cfg); err != nil {
+//		println(err.Error()) // line 12: [window] Width: invalid syntax
+//	}
+func IniUnmarshal(ini *Ini, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("IniUnmarshal needs a non-nil pointer to a struct")
+	}
+	return iniUnmarshalSections(ini, rv.Elem(), "")
+}
+
+func iniUnmarshalSections(ini *Ini, rvDoc reflect.Value, prefix string) error {
+	for i := 0; i < rvDoc.NumField(); i++ {
+		name, _, ok := iniFieldName(rvDoc.Type().Field(i))
+		if !ok {
+			continue
+		}
+		rvField := rvDoc.Field(i)
+		if rvField.Kind() != reflect.Struct || iniIsScalar(rvField) {
+			return &IniError{Section: prefix + name, Err: errors.New("field must be a struct to map to a section")}
+		}
+		if err := iniUnmarshalSection(ini, rvField, prefix+name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func iniUnmarshalSection(ini *Ini, rvSection reflect.Value, sectionName string) error {
+	section := ini.GetSection(sectionName)
+
+	for i := 0; i < rvSection.NumField(); i++ {
+		name, _, ok := iniFieldName(rvSection.Type().Field(i))
+		if !ok {
+			continue
+		}
+		rvField := rvSection.Field(i)
+
+		if rvField.Kind() == reflect.Struct && !iniIsScalar(rvField) { // nested section
+			if err := iniUnmarshalSection(ini, rvField, sectionName+"."+name); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if section == nil {
+			continue
+		}
+		for idx := range section.Entries {
+			entry := &section.Entries[idx]
+			if entry.Key == name { // first one only, like IniSection.Get
+				if err := iniParseValue(rvField, entry.Value); err != nil {
+					return &IniError{Line: entry.line, Section: sectionName, Key: name, Err: err}
+				}
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// Creates a new [Ini] document with the values of the struct v, which can be a
+// struct or a pointer to it. The mapping rules are the same of [IniUnmarshal].
+//
+// The "omitempty" tag option skips keys with zero values.
+//
+// To update an existing document, keeping its comments and formatting, use
+// [IniMarshalInto].
+func IniMarshal(v interface{}) (*Ini, error) {
+	ini := &Ini{}
+	if err := IniMarshalInto(ini, v); err != nil {
+		return nil, err
+	}
+	return ini, nil
+}
+
+// Writes the values of the struct v, which can be a struct or a pointer to it,
+// into an existing [Ini] document. Existing keys are updated in place, and new
+// sections and keys are appended; comments and formatting are kept.
+//
+// The mapping rules are the same of [IniUnmarshal].
+//
+// # Example
+//
+//	var cfg Config // initialized somewhere
+//
+//	ini, _ := win.IniLoad("C:\\Temp\\foo.ini")
+//	_ = win.IniMarshalInto(ini, &cfg)
+//	_ = ini.SaveToFile(ini.Path)
+func IniMarshalInto(ini *Ini, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return errors.New("IniMarshal needs a struct or a pointer to a struct")
+	}
+	return iniMarshalSections(ini, rv, "")
+}
+
+func iniMarshalSections(ini *Ini, rvDoc reflect.Value, prefix string) error {
+	for i := 0; i < rvDoc.NumField(); i++ {
+		name, _, ok := iniFieldName(rvDoc.Type().Field(i))
+		if !ok {
+			continue
+		}
+		rvField := rvDoc.Field(i)
+		if rvField.Kind() != reflect.Struct || iniIsScalar(rvField) {
+			return &IniError{Section: prefix + name, Err: errors.New("field must be a struct to map to a section")}
+		}
+		if err := iniMarshalSection(ini, rvField, prefix+name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func iniMarshalSection(ini *Ini, rvSection reflect.Value, sectionName string) error {
+	for i := 0; i < rvSection.NumField(); i++ {
+		name, omitEmpty, ok := iniFieldName(rvSection.Type().Field(i))
+		if !ok {
+			continue
+		}
+		rvField := rvSection.Field(i)
+
+		if rvField.Kind() == reflect.Struct && !iniIsScalar(rvField) { // nested section
+			if err := iniMarshalSection(ini, rvField, sectionName+"."+name); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if omitEmpty && rvField.IsZero() {
+			continue
+		}
+		if cur, ok := ini.Get(sectionName, name); ok {
+			rvCur := reflect.New(rvField.Type()).Elem()
+			if iniParseValue(rvCur, cur) == nil && reflect.DeepEqual(rvCur.Interface(), rvField.Interface()) {
+				continue // same value, keep the way it's written, like "yes" for true
+			}
+		}
+		value, err := iniFormatValue(rvField)
+		if err != nil {
+			return &IniError{Section: sectionName, Key: name, Err: err}
+		}
+		ini.Set(sectionName, name, value)
+	}
+	return nil
+}
+
+// Returns the section or key name of the struct field, and whether the field
+// is mapped at all.
+func iniFieldName(field reflect.StructField) (name string, omitEmpty, ok bool) {
+	if !field.IsExported() {
+		return "", false, false
+	}
+	tag := field.Tag.Get("ini")
+	if tag == "-" {
+		return "", false, false
+	}
+
+	name = field.Name
+	parts := strings.Split(tag, ",")
+	if parts[0] != "" {
+		name = parts[0]
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty, true
+}
+
+var (
+	iniTypeDuration        = reflect.TypeOf(time.Duration(0))
+	iniTypeTextUnmarshaler = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	iniTypeTextMarshaler   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// Tells whether the value is converted from/to a single string, even if it's a
+// struct, like time.Time.
+func iniIsScalar(rv reflect.Value) bool {
+	return reflect.PointerTo(rv.Type()).Implements(iniTypeTextUnmarshaler) ||
+		rv.Type().Implements(iniTypeTextMarshaler)
+}
+
+func iniParseValue(rv reflect.Value, text string) error {
+	if rv.CanAddr() && rv.Addr().Type().Implements(iniTypeTextUnmarshaler) {
+		return rv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(text))
+	}
+
+	if rv.Type() == iniTypeDuration {
+		d, err := time.ParseDuration(text)
+		if err != nil {
+			return err
+		}
+		rv.SetInt(int64(d))
+		return nil
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(text)
+	case reflect.Bool:
+		switch strings.ToLower(text) {
+		case "yes", "on":
+			rv.SetBool(true)
+		case "no", "off":
+			rv.SetBool(false)
+		default:
+			b, err := strconv.ParseBool(text)
+			if err != nil {
+				return iniUnwrapNum(err)
+			}
+			rv.SetBool(b)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 0, rv.Type().Bits())
+		if err != nil {
+			return iniUnwrapNum(err)
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(text, 0, rv.Type().Bits())
+		if err != nil {
+			return iniUnwrapNum(err)
+		}
+		rv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(text, rv.Type().Bits())
+		if err != nil {
+			return iniUnwrapNum(err)
+		}
+		rv.SetFloat(f)
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %s", rv.Type())
+		}
+		items := reflect.MakeSlice(rv.Type(), 0, 0)
+		if strings.TrimSpace(text) != "" {
+			for _, item := range strings.Split(text, ",") {
+				items = reflect.Append(items, reflect.ValueOf(strings.TrimSpace(item)).Convert(rv.Type().Elem()))
+			}
+		}
+		rv.Set(items)
+	default:
+		return fmt.Errorf("unsupported type %s", rv.Type())
+	}
+	return nil
+}
+
+func iniFormatValue(rv reflect.Value) (string, error) {
+	if rv.Type().Implements(iniTypeTextMarshaler) {
+		text, err := rv.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+
+	if rv.Type() == iniTypeDuration {
+		return time.Duration(rv.Int()).String(), nil
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() != reflect.String {
+			return "", fmt.Errorf("unsupported type %s", rv.Type())
+		}
+		items := make([]string, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			items = append(items, rv.Index(i).String())
+		}
+		return strings.Join(items, ","), nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", rv.Type())
+	}
+}
+
+// Removes the verbose strconv wrapper, since the error already carries the
+// section and key.
+func iniUnwrapNum(err error) error {
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		return numErr.Err
+	}
+	return err
+}
@@ -0,0 +1,290 @@
+package win
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type _IniTestLevel int
+
+func (l _IniTestLevel) MarshalText() ([]byte, error) {
+	return []byte([]string{"low", "high"}[l]), nil
+}
+
+func (l *_IniTestLevel) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "low":
+		*l = 0
+	case "high":
+		*l = 1
+	default:
+		return errors.New("unknown level")
+	}
+	return nil
+}
+
+type _IniTestConfig struct {
+	Window struct {
+		Title   string
+		Width   int           `ini:"width"`
+		Height  uint16        `ini:"height"`
+		Maxed   bool          `ini:"maximized"`
+		Scale   float64       `ini:"scale"`
+		Refresh time.Duration `ini:"refresh"`
+		Level   _IniTestLevel `ini:"level"`
+		Pos     struct {
+			X int8 `ini:"x"`
+			Y int8 `ini:"y"`
+		} `ini:"pos"`
+		Lang    string `ini:"lang"`
+		Theme   string `ini:"theme,omitempty"`
+		Skipped string `ini:"-"`
+	} `ini:"window"`
+	Recent struct {
+		Files []string `ini:"files"`
+	} `ini:"recent"`
+}
+
+const _INI_TEST_SOURCE = "; settings\r\n" +
+	"[window]\r\n" +
+	"Title = My app\r\n" +
+	"width = 800\r\n" +
+	"height = 0x258\r\n" +
+	"maximized = yes\r\n" +
+	"scale = 1.25\r\n" +
+	"refresh = 1m30s\r\n" +
+	"level = high\r\n" +
+	"unknown = ignored\r\n" +
+	"Skipped = nope\r\n" +
+	"\r\n" +
+	"[window.pos]\r\n" +
+	"x = -5\r\n" +
+	"y = 10\r\n" +
+	"\r\n" +
+	"[recent]\r\n" +
+	"# most recent first\r\n" +
+	"files = a.txt, b.txt\r\n"
+
+func iniTestSourceConfig() _IniTestConfig {
+	var cfg _IniTestConfig
+	cfg.Window.Title = "My app"
+	cfg.Window.Width = 800
+	cfg.Window.Height = 600
+	cfg.Window.Maxed = true
+	cfg.Window.Scale = 1.25
+	cfg.Window.Refresh = 90 * time.Second
+	cfg.Window.Level = 1
+	cfg.Window.Pos.X = -5
+	cfg.Window.Pos.Y = 10
+	cfg.Recent.Files = []string{"a.txt", "b.txt"}
+	return cfg
+}
+
+func TestIniUnmarshal(t *testing.T) {
+	ini, err := IniParse([]byte(_INI_TEST_SOURCE))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cfg _IniTestConfig
+	cfg.Window.Lang = "kept" // absent from the document
+	if err := IniUnmarshal(ini, &cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	want := iniTestSourceConfig()
+	want.Window.Lang = "kept"
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("got %+v\nwant %+v", cfg, want)
+	}
+}
+
+func TestIniUnmarshalValues(t *testing.T) {
+	type Section struct {
+		B   bool
+		I8  int8
+		U   uint
+		F32 float32
+		S   []string
+	}
+	var cfg struct{ A Section }
+
+	tests := []struct {
+		entries string
+		want    Section
+	}{
+		{"B=on\r\nI8=-128\r\nU=0b101\r\nF32=0.5\r\nS=", Section{true, -128, 5, 0.5, []string{}}},
+		{"B=Off\r\nI8=0x7f\r\nU=0o17\r\nF32=-1e3\r\nS= x ,y", Section{false, 127, 15, -1000, []string{"x", "y"}}},
+		{"B=1\r\nB=0\r\nI8=1\r\nU=1\r\nF32=1\r\nS=z", Section{true, 1, 1, 1, []string{"z"}}}, // first duplicate wins
+	}
+
+	for _, tc := range tests {
+		ini, err := IniParse([]byte("[A]\r\n" + tc.entries))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := IniUnmarshal(ini, &cfg); err != nil {
+			t.Fatalf("%q: %v", tc.entries, err)
+		}
+		if !reflect.DeepEqual(cfg.A, tc.want) {
+			t.Errorf("%q: got %+v, want %+v", tc.entries, cfg.A, tc.want)
+		}
+	}
+}
+
+func TestIniUnmarshalErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		contents    string
+		wantLine    int
+		wantSection string
+		wantKey     string
+		wantErr     error
+	}{
+		{"invalid int", "[window]\r\nTitle=x\r\nwidth = 8o0\r\n", 3, "window", "width", strconv.ErrSyntax},
+		{"invalid uint", "[window]\r\nheight = -1\r\n", 2, "window", "height", strconv.ErrSyntax},
+		{"nested overflow", "[window]\r\n; c\r\n[window.pos]\r\n\r\nx = 300\r\n", 5, "window.pos", "x", strconv.ErrRange},
+		{"invalid bool", "[window]\r\nmaximized = maybe\r\n", 2, "window", "maximized", strconv.ErrSyntax},
+		{"invalid float", "[window]\r\nscale = big\r\n", 2, "window", "scale", strconv.ErrSyntax},
+		{"invalid duration", "[x]\r\n[window]\r\nrefresh = 5\r\n", 3, "window", "refresh", nil},
+		{"invalid text", "[window]\r\nlevel = mid\r\n", 2, "window", "level", nil},
+		{"later section", "[window]\r\nwidth=1\r\n[recent]\r\n[window]\r\nwidth=x\r\n", 0, "", "", nil}, // only the first [window] is read
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ini, err := IniParse([]byte(tc.contents))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var cfg _IniTestConfig
+			err = IniUnmarshal(ini, &cfg)
+			if tc.wantLine == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var iniErr *IniError
+			if !errors.As(err, &iniErr) {
+				t.Fatalf("error = %v, want an *IniError", err)
+			}
+			if iniErr.Line != tc.wantLine || iniErr.Section != tc.wantSection || iniErr.Key != tc.wantKey {
+				t.Errorf("got line %d [%s] %s, want line %d [%s] %s", iniErr.Line, iniErr.Section,
+					iniErr.Key, tc.wantLine, tc.wantSection, tc.wantKey)
+			}
+			if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+				t.Errorf("error = %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestIniUnmarshalBadTarget(t *testing.T) {
+	ini := &Ini{}
+
+	var notStruct int
+	if err := IniUnmarshal(ini, &notStruct); err == nil {
+		t.Error("expected error for pointer to int")
+	}
+	if err := IniUnmarshal(ini, _IniTestConfig{}); err == nil {
+		t.Error("expected error for non-pointer")
+	}
+
+	var flat struct{ Name string }
+	var iniErr *IniError
+	if err := IniUnmarshal(ini, &flat); !errors.As(err, &iniErr) || iniErr.Section != "Name" {
+		t.Errorf("error = %v, want an *IniError for section Name", err)
+	}
+	if err := IniMarshalInto(ini, flat); !errors.As(err, &iniErr) || iniErr.Section != "Name" {
+		t.Errorf("error = %v, want an *IniError for section Name", err)
+	}
+}
+
+func TestIniMarshal(t *testing.T) {
+	cfg := iniTestSourceConfig()
+	ini, err := IniMarshal(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "[window]\r\n" +
+		"Title=My app\r\n" +
+		"width=800\r\n" +
+		"height=600\r\n" +
+		"maximized=true\r\n" +
+		"scale=1.25\r\n" +
+		"refresh=1m30s\r\n" +
+		"level=high\r\n" +
+		"lang=\r\n" +
+		"\r\n" +
+		"[window.pos]\r\n" +
+		"x=-5\r\n" +
+		"y=10\r\n" +
+		"\r\n" +
+		"[recent]\r\n" +
+		"files=a.txt,b.txt\r\n"
+	if got := ini.Serialize(); got != want {
+		t.Errorf("Serialize() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestIniMarshalRoundTrip(t *testing.T) {
+	ini, err := IniParse([]byte(_INI_TEST_SOURCE))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cfg _IniTestConfig
+	if err := IniUnmarshal(ini, &cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg.Window.Width = 1024
+	cfg.Window.Lang = "en"
+	cfg.Window.Pos.Y = 20
+	cfg.Recent.Files = append(cfg.Recent.Files, "c.txt")
+	if err := IniMarshalInto(ini, &cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "; settings\r\n" +
+		"[window]\r\n" +
+		"Title = My app\r\n" +
+		"width = 1024\r\n" +
+		"height = 0x258\r\n" + // same value, kept as written
+		"maximized = yes\r\n" +
+		"scale = 1.25\r\n" +
+		"refresh = 1m30s\r\n" +
+		"level = high\r\n" +
+		"unknown = ignored\r\n" +
+		"Skipped = nope\r\n" +
+		"lang = en\r\n" +
+		"\r\n" +
+		"[window.pos]\r\n" +
+		"x = -5\r\n" +
+		"y = 20\r\n" +
+		"\r\n" +
+		"[recent]\r\n" +
+		"# most recent first\r\n" +
+		"files = a.txt,b.txt,c.txt\r\n"
+	if got := ini.Serialize(); got != want {
+		t.Errorf("Serialize() =\n%s\nwant\n%s", got, want)
+	}
+
+	reparsed, err := IniParse([]byte(ini.Serialize()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cfg2 _IniTestConfig
+	if err := IniUnmarshal(reparsed, &cfg2); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(cfg2, cfg) {
+		t.Errorf("round-trip got %+v\nwant %+v", cfg2, cfg)
+	}
+}
@@ -0,0 +1,240 @@
+package win
+
+// This file has no knowledge of windows, so the .ini parser and serializer can
+// be tested on any OS.
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Parses the contents of an .ini file. The text encoding is detected from the
+// byte order mark: UTF-16 LE/BE and UTF-8 are supported; without a byte order
+// mark, UTF-8 is assumed.
+//
+// This function doesn't touch the file system, so it can be used with contents
+// from any source.
+//
+// # Example
+//
+//	ini, _ := win.IniParse([]byte("[section]\r\nkey=value\r\n"))
+func IniParse(contents []byte) (*Ini, error) {
+	text, enc, err := iniDecode(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	me := &Ini{Encoding: enc}
+	me.lineBreak = "\r\n"
+	if idx := strings.IndexByte(text, '\n'); idx != -1 && (idx == 0 || text[idx-1] != '\r') {
+		me.lineBreak = "\n"
+	}
+
+	if text == "" {
+		return me, nil
+	}
+	if text[len(text)-1] == '\n' {
+		text = strings.TrimSuffix(strings.TrimSuffix(text, "\n"), "\r")
+	} else {
+		me.noLastBr = true
+	}
+
+	var pending []string // comments and blank lines waiting for the next item
+	var curSection *IniSection
+
+	for idxLine, line := range strings.Split(text, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		trimmed := strings.TrimSpace(line)
+
+		if len(trimmed) > 1 && trimmed[0] == '[' && trimmed[len(trimmed)-1] == ']' { // [section] ?
+			name := strings.TrimSpace(trimmed[1 : len(trimmed)-1])
+			if curSection == nil && len(me.Sections) == 0 {
+				me.preamble, pending = pending, nil
+			}
+			me.Sections = append(me.Sections, IniSection{
+				Name:     name,
+				Entries:  make([]IniEntry, 0),
+				comments: pending,
+				header:   line,
+				origName: name,
+				line:     idxLine + 1,
+			})
+			curSection = &me.Sections[len(me.Sections)-1]
+			pending = nil
+		} else if entry, ok := iniParseEntry(line); ok {
+			if curSection == nil { // key before any section
+				me.Sections = append(me.Sections, IniSection{Entries: make([]IniEntry, 0)})
+				curSection = &me.Sections[0]
+			}
+			entry.comments, pending = pending, nil
+			entry.line = idxLine + 1
+			curSection.Entries = append(curSection.Entries, entry)
+		} else {
+			pending = append(pending, line) // comment, blank or unrecognized line
+		}
+	}
+
+	if len(me.Sections) == 0 {
+		me.preamble = pending
+	} else {
+		me.trailer = pending
+	}
+	return me, nil
+}
+
+// Parses a key=value line, keeping its formatting.
+func iniParseEntry(line string) (IniEntry, bool) {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" || trimmed[0] == '#' || trimmed[0] == ';' {
+		return IniEntry{}, false
+	}
+	idxEq := strings.IndexByte(line, '=')
+	if idxEq == -1 {
+		return IniEntry{}, false
+	}
+
+	keyPart, valPart := line[:idxEq], line[idxEq+1:]
+	key := strings.TrimSpace(keyPart)
+	value := strings.TrimSpace(valPart)
+
+	entry := IniEntry{
+		raw:    line,
+		indent: keyPart[:len(keyPart)-len(strings.TrimLeft(keyPart, " \t"))],
+		separator: keyPart[len(strings.TrimRight(keyPart, " \t")):] +
+			"=" + valPart[:len(valPart)-len(strings.TrimLeft(valPart, " \t"))],
+	}
+
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		entry.quote = value[:1]
+		value = value[1 : len(value)-1]
+	}
+
+	entry.Key, entry.origKey = key, key
+	entry.Value, entry.origValue = value, value
+	return entry, true
+}
+
+// Serializes the document as text, rewriting only the changed lines.
+func iniSerialize(me *Ini) string {
+	lineBreak := me.lineBreak
+	if lineBreak == "" {
+		lineBreak = "\r\n"
+	}
+
+	lines := make([]string, 0, 32) // arbitrary
+	lines = append(lines, me.preamble...)
+
+	for idxSection := range me.Sections {
+		section := &me.Sections[idxSection]
+		isGlobal := idxSection == 0 && section.Name == "" && section.header == ""
+
+		if !isGlobal && section.header == "" && section.comments == nil && len(lines) > 0 &&
+			strings.TrimSpace(lines[len(lines)-1]) != "" {
+			lines = append(lines, "") // new section, separate it from the previous one
+		}
+		lines = append(lines, section.comments...)
+
+		switch {
+		case isGlobal: // keys before any section have no header
+		case section.header != "" && section.Name == section.origName:
+			lines = append(lines, section.header)
+		default:
+			lines = append(lines, "["+section.Name+"]")
+		}
+
+		var prev *IniEntry // new entries follow the formatting of the previous one
+		for idxEntry := range section.Entries {
+			entry := &section.Entries[idxEntry]
+			lines = append(lines, entry.comments...)
+			lines = append(lines, iniSerializeEntry(entry, prev))
+			prev = entry
+		}
+	}
+
+	lines = append(lines, me.trailer...)
+
+	var serialized strings.Builder
+	for idx, line := range lines {
+		serialized.WriteString(line)
+		if idx < len(lines)-1 || !me.noLastBr {
+			serialized.WriteString(lineBreak)
+		}
+	}
+	return serialized.String()
+}
+
+func iniSerializeEntry(entry, prev *IniEntry) string {
+	if entry.raw != "" && entry.Key == entry.origKey && entry.Value == entry.origValue {
+		return entry.raw // unchanged
+	}
+
+	indent, separator := entry.indent, entry.separator
+	if separator == "" { // entry was not parsed
+		separator = "="
+		if prev != nil && prev.separator != "" {
+			indent, separator = prev.indent, prev.separator
+		}
+	}
+	return indent + entry.Key + separator + entry.quote + entry.Value + entry.quote
+}
+
+var errIniOddUtf16 = errors.New("invalid UTF-16 .ini contents: odd number of bytes")
+
+// Converts the raw contents to a Go string, according to the byte order mark.
+func iniDecode(contents []byte) (string, co.INI_ENC, error) {
+	switch {
+	case len(contents) >= 3 && contents[0] == 0xef && contents[1] == 0xbb && contents[2] == 0xbf:
+		return string(contents[3:]), co.INI_ENC_UTF8_BOM, nil
+	case len(contents) >= 2 && contents[0] == 0xff && contents[1] == 0xfe:
+		text, err := iniDecodeUtf16(contents[2:], false)
+		return text, co.INI_ENC_UTF16LE, err
+	case len(contents) >= 2 && contents[0] == 0xfe && contents[1] == 0xff:
+		text, err := iniDecodeUtf16(contents[2:], true)
+		return text, co.INI_ENC_UTF16BE, err
+	default:
+		return string(contents), co.INI_ENC_UTF8, nil
+	}
+}
+
+func iniDecodeUtf16(contents []byte, bigEndian bool) (string, error) {
+	if len(contents)%2 != 0 {
+		return "", errIniOddUtf16
+	}
+	words := make([]uint16, 0, len(contents)/2)
+	for i := 0; i < len(contents); i += 2 {
+		if bigEndian {
+			words = append(words, uint16(contents[i])<<8|uint16(contents[i+1]))
+		} else {
+			words = append(words, uint16(contents[i+1])<<8|uint16(contents[i]))
+		}
+	}
+	return string(utf16.Decode(words)), nil
+}
+
+// Converts the Go string to raw contents, with the byte order mark, if any.
+func iniEncode(text string, enc co.INI_ENC) []byte {
+	switch enc {
+	case co.INI_ENC_UTF8_BOM:
+		return append([]byte{0xef, 0xbb, 0xbf}, text...)
+	case co.INI_ENC_UTF16LE, co.INI_ENC_UTF16BE:
+		words := utf16.Encode([]rune(text))
+		buf := make([]byte, 0, 2+len(words)*2)
+		if enc == co.INI_ENC_UTF16LE {
+			buf = append(buf, 0xff, 0xfe)
+			for _, w := range words {
+				buf = append(buf, byte(w), byte(w>>8))
+			}
+		} else {
+			buf = append(buf, 0xfe, 0xff)
+			for _, w := range words {
+				buf = append(buf, byte(w>>8), byte(w))
+			}
+		}
+		return buf
+	default:
+		return []byte(text)
+	}
+}
@@ -0,0 +1,204 @@
+package win
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Lists all entries as "section|key=value", in order.
+func iniTestFlatten(ini *Ini) []string {
+	flat := make([]string, 0)
+	for _, section := range ini.Sections {
+		for _, entry := range section.Entries {
+			flat = append(flat, section.Name+"|"+entry.Key+"="+entry.Value)
+		}
+	}
+	return flat
+}
+
+func TestIniParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		wantEnc  co.INI_ENC
+		want     []string
+	}{
+		{
+			name:     "empty",
+			contents: "",
+			want:     []string{},
+		},
+		{
+			name:     "comments kept out of entries",
+			contents: "; top\r\n[a]\r\n# note\r\nx=1\r\n\r\n; last\r\n",
+			want:     []string{"a|x=1"},
+		},
+		{
+			name:     "duplicate keys",
+			contents: "[a]\r\nk=1\r\nk=2\r\n[a]\r\nk=3\r\n",
+			want:     []string{"a|k=1", "a|k=2", "a|k=3"},
+		},
+		{
+			name:     "quoted values",
+			contents: "[a]\r\nd=\"x y\"\r\ns='z'\r\nodd=\"w\r\nempty=\"\"\r\n",
+			want:     []string{"a|d=x y", "a|s=z", "a|odd=\"w", "a|empty="},
+		},
+		{
+			name:     "whitespace around key and value",
+			contents: "  [ a ]  \r\n\tk  =  v  \r\n",
+			want:     []string{"a|k=v"},
+		},
+		{
+			name:     "LF line breaks",
+			contents: "[a]\nk=v\n",
+			want:     []string{"a|k=v"},
+		},
+		{
+			name:     "no trailing line break",
+			contents: "[a]\r\nk=v",
+			want:     []string{"a|k=v"},
+		},
+		{
+			name:     "keys before any section",
+			contents: "; global\r\ng=1\r\n[a]\r\nk=v\r\n",
+			want:     []string{"|g=1", "a|k=v"},
+		},
+		{
+			name:     "UTF-8 with BOM",
+			contents: "\xef\xbb\xbf[a]\r\nk=\xc3\xa9\r\n",
+			wantEnc:  co.INI_ENC_UTF8_BOM,
+			want:     []string{"a|k=é"},
+		},
+		{
+			name:     "UTF-16LE with BOM",
+			contents: "\xff\xfe[\x00a\x00]\x00\r\x00\n\x00k\x00=\x00\xe9\x00\r\x00\n\x00",
+			wantEnc:  co.INI_ENC_UTF16LE,
+			want:     []string{"a|k=é"},
+		},
+		{
+			name:     "UTF-16BE with BOM",
+			contents: "\xfe\xff\x00[\x00a\x00]\x00\n\x00k\x00=\x00v\x00\n",
+			wantEnc:  co.INI_ENC_UTF16BE,
+			want:     []string{"a|k=v"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ini, err := IniParse([]byte(tc.contents))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ini.Encoding != tc.wantEnc {
+				t.Errorf("Encoding = %d, want %d", ini.Encoding, tc.wantEnc)
+			}
+			if got := iniTestFlatten(ini); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("entries = %q, want %q", got, tc.want)
+			}
+			if got := ini.Bytes(); !bytes.Equal(got, []byte(tc.contents)) {
+				t.Errorf("round-trip =\n%q\nwant\n%q", got, tc.contents)
+			}
+		})
+	}
+}
+
+func TestIniParseErrors(t *testing.T) {
+	if _, err := IniParse([]byte("\xff\xfe[\x00a")); err == nil {
+		t.Error("expected error for odd number of UTF-16 bytes")
+	}
+}
+
+func TestIniEdit(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		edit     func(ini *Ini)
+		want     string
+	}{
+		{
+			name:     "changed values keep comments, spacing and quotes",
+			contents: "; top\r\n[a]\r\n# c1\r\nx = 1\r\ny=\"q\"\r\n\r\n; end\r\n",
+			edit: func(ini *Ini) {
+				ini.Set("a", "x", "2")
+				ini.Set("a", "y", "r")
+			},
+			want: "; top\r\n[a]\r\n# c1\r\nx = 2\r\ny=\"r\"\r\n\r\n; end\r\n",
+		},
+		{
+			name:     "new key follows previous formatting",
+			contents: "[a]\r\n  x = 1\r\n",
+			edit:     func(ini *Ini) { ini.Set("a", "y", "2") },
+			want:     "[a]\r\n  x = 1\r\n  y = 2\r\n",
+		},
+		{
+			name:     "new section keeps LF and missing trailing line break",
+			contents: "[a]\nk=v",
+			edit:     func(ini *Ini) { ini.Set("b", "k", "w") },
+			want:     "[a]\nk=v\n\n[b]\nk=w",
+		},
+		{
+			name:     "duplicate key sets only the first",
+			contents: "[a]\r\nk=1\r\nk=2\r\n",
+			edit:     func(ini *Ini) { ini.Set("a", "k", "3") },
+			want:     "[a]\r\nk=3\r\nk=2\r\n",
+		},
+		{
+			name:     "renamed section",
+			contents: "; c\r\n[ a ]\r\nk=v\r\n",
+			edit:     func(ini *Ini) { ini.Sections[0].Name = "b" },
+			want:     "; c\r\n[b]\r\nk=v\r\n",
+		},
+		{
+			name:     "key before any section",
+			contents: "[a]\r\nk=v\r\n",
+			edit:     func(ini *Ini) { ini.Set("", "g", "1") },
+			want:     "g=1\r\n[a]\r\nk=v\r\n",
+		},
+		{
+			name:     "existing keys before any section",
+			contents: "g=1\r\n\r\n[a]\r\nk=v\r\n",
+			edit:     func(ini *Ini) { ini.Set("", "g", "2") },
+			want:     "g=2\r\n\r\n[a]\r\nk=v\r\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ini, err := IniParse([]byte(tc.contents))
+			if err != nil {
+				t.Fatal(err)
+			}
+			tc.edit(ini)
+			if got := ini.Serialize(); got != tc.want {
+				t.Errorf("Serialize() =\n%q\nwant\n%q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIniEncodingKept(t *testing.T) {
+	ini, err := IniParse([]byte("\xff\xfe[\x00a\x00]\x00\r\x00\n\x00k\x00=\x00v\x00\r\x00\n\x00"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ini.Set("a", "k", "w")
+
+	want := []byte("\xff\xfe[\x00a\x00]\x00\r\x00\n\x00k\x00=\x00w\x00\r\x00\n\x00")
+	if got := ini.Bytes(); !bytes.Equal(got, want) {
+		t.Errorf("Bytes() = % x, want % x", got, want)
+	}
+}
+
+func TestIniNew(t *testing.T) {
+	ini := &Ini{}
+	ini.Set("a", "k", "v")
+	ini.Set("b", "k", "w")
+
+	want := "[a]\r\nk=v\r\n\r\n[b]\r\nk=w\r\n"
+	if got := ini.Serialize(); got != want {
+		t.Errorf("Serialize() =\n%q\nwant\n%q", got, want)
+	}
+}
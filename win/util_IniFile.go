@@ -0,0 +1,27 @@
+//go:build windows
+
+package win
+
+// Creates an [Ini] object by reading an .ini file, parsing its contents.
+//
+// # Example
+//
+//	ini, _ := win.IniLoad("C:\\Temp\\foo.ini")
+func IniLoad(iniPath string) (*Ini, error) {
+	contents, err := FileRead(iniPath)
+	if err != nil {
+		return nil, err
+	}
+
+	me, err := IniParse(contents)
+	if err != nil {
+		return nil, err
+	}
+	me.Path = iniPath // keep
+	return me, nil
+}
+
+// Serializes and saves the contents into the .ini file.
+func (me *Ini) SaveToFile(filePath string) error {
+	return FileWrite(filePath, me.Bytes())
+}
@@ -0,0 +1,151 @@
+//go:build windows
+
+package win
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Serializes and saves the contents into a .reg file.
+func (me *RegFile) SaveToFile(filePath string) error {
+	return FileWrite(filePath, me.Bytes())
+}
+
+// Writes all keys and values into the registry: keys are created if needed,
+// values are set, and deletions are performed. Keys and values to be deleted
+// which don't exist are ignored.
+//
+// Stops at the first error.
+//
+// # Example
+//
+//	contents, _ := win.FileRead("C:\\Temp\\foo.reg")
+//	regFile, _ := win.RegFileParse(contents)
+//	_ = regFile.Apply()
+func (me *RegFile) Apply() error {
+	for idxKey := range me.Keys {
+		key := &me.Keys[idxKey]
+
+		hRoot, subKey, err := regFileSplitPath(key.Path)
+		if err != nil {
+			return err
+		}
+
+		if key.Delete {
+			if err := hRoot.RegDeleteTree(subKey); err != nil && err != co.ERROR_FILE_NOT_FOUND {
+				return fmt.Errorf("deleting %s: %w", key.Path, err)
+			}
+			continue
+		}
+
+		hKey, err := hRoot.RegCreateKeyEx(subKey, co.REG_OPTION_NONE, co.KEY_WRITE, nil)
+		if err != nil {
+			return fmt.Errorf("creating %s: %w", key.Path, err)
+		}
+
+		for idxVal := range key.Values {
+			value := &key.Values[idxVal]
+			if value.Delete {
+				err = hKey.RegDeleteValue(value.Name)
+				if err == co.ERROR_FILE_NOT_FOUND {
+					err = nil
+				}
+			} else {
+				err = hKey.RegSetValueEx(value.Name, value.Val)
+			}
+			if err != nil {
+				hKey.RegCloseKey()
+				return fmt.Errorf("writing %s\\%s: %w", key.Path, value.Name, err)
+			}
+		}
+		hKey.RegCloseKey()
+	}
+	return nil
+}
+
+// Exports the subtree of an open key, with all its values and subkeys,
+// recursively, into a [RegFile].
+//
+// Since the key handle doesn't carry its own name, keyPath is the full path to
+// be written in the .reg file, like "HKEY_CURRENT_USER\Software\Foo". The key
+// must have been opened with [co.KEY_READ].
+//
+// # Example
+//
+//	hKey, _ := win.HKEY_CURRENT_USER.RegOpenKeyEx(
+//		"Control Panel\\Keyboard",
+//		co.REG_OPTION_NONE,
+//		co.KEY_READ)
+//	defer hKey.RegCloseKey()
+//
+//	regFile, _ := win.RegFileExport(hKey, "HKEY_CURRENT_USER\\Control Panel\\Keyboard")
+//	_ = regFile.SaveToFile("C:\\Temp\\keyboard.reg")
+func RegFileExport(hKey HKEY, keyPath string) (*RegFile, error) {
+	me := &RegFile{Keys: make([]RegFileKey, 0)}
+	if err := regFileExportKey(me, hKey, keyPath); err != nil {
+		return nil, err
+	}
+	return me, nil
+}
+
+func regFileExportKey(me *RegFile, hKey HKEY, keyPath string) error {
+	namesVals, err := hKey.RegEnumValue()
+	if err != nil {
+		return fmt.Errorf("enumerating values of %s: %w", keyPath, err)
+	}
+
+	key := RegFileKey{
+		Path:   keyPath,
+		Values: make([]RegFileValue, 0, len(namesVals)),
+	}
+	for _, nameVal := range namesVals {
+		if nameVal.Name == "" {
+			// The default value comes first, like regedit does.
+			key.Values = append([]RegFileValue{{Val: nameVal.Val}}, key.Values...)
+		} else {
+			key.Values = append(key.Values, RegFileValue{Name: nameVal.Name, Val: nameVal.Val})
+		}
+	}
+	me.Keys = append(me.Keys, key)
+
+	subKeys, err := hKey.RegEnumKeyEx()
+	if err != nil {
+		return fmt.Errorf("enumerating subkeys of %s: %w", keyPath, err)
+	}
+	for _, subKey := range subKeys {
+		hSubKey, err := hKey.RegOpenKeyEx(subKey, co.REG_OPTION_NONE, co.KEY_READ)
+		if err != nil {
+			return fmt.Errorf("opening %s\\%s: %w", keyPath, subKey, err)
+		}
+		err = regFileExportKey(me, hSubKey, keyPath+"\\"+subKey)
+		hSubKey.RegCloseKey()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Splits a full key path into its predefined root key and the subkey path.
+// Accepts both the long and the abbreviated root key names.
+func regFileSplitPath(keyPath string) (HKEY, string, error) {
+	rootName, subKey, _ := strings.Cut(keyPath, "\\")
+
+	switch strings.ToUpper(rootName) {
+	case "HKEY_CLASSES_ROOT", "HKCR":
+		return HKEY_CLASSES_ROOT, subKey, nil
+	case "HKEY_CURRENT_USER", "HKCU":
+		return HKEY_CURRENT_USER, subKey, nil
+	case "HKEY_LOCAL_MACHINE", "HKLM":
+		return HKEY_LOCAL_MACHINE, subKey, nil
+	case "HKEY_USERS", "HKU":
+		return HKEY_USERS, subKey, nil
+	case "HKEY_CURRENT_CONFIG", "HKCC":
+		return HKEY_CURRENT_CONFIG, subKey, nil
+	default:
+		return HKEY(0), "", fmt.Errorf("unknown root key in %s", keyPath)
+	}
+}
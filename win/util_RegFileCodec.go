@@ -0,0 +1,397 @@
+package win
+
+// This file has no knowledge of windows, so the .reg parser and serializer can
+// be tested on any OS.
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+type (
+	// High-level abstraction for the contents of a .reg file, in the REGEDIT5
+	// text format. Parsed with [RegFileParse], or exported from the registry
+	// with [RegFileExport].
+	RegFile struct {
+		// Keys of the .reg file, in the order they appear.
+		Keys []RegFileKey
+	}
+
+	// A key within a [RegFile].
+	RegFileKey struct {
+		// Full path of the key, starting with the root key name, like
+		// "HKEY_CURRENT_USER\Software\Foo".
+		Path string
+		// If true, the key is written as [-HKEY...], which means the key and
+		// all its subkeys are to be deleted. Values are ignored.
+		Delete bool
+		// Values of the key.
+		Values []RegFileValue
+	}
+
+	// A value within a [RegFileKey].
+	RegFileValue struct {
+		// Name of the value. Empty for the default value, written as "@".
+		Name string
+		// If true, the value is written as "name"=-, which means the value is
+		// to be deleted.
+		Delete bool
+		// Actual value.
+		Val RegVal
+	}
+)
+
+// Error returned by [RegFileParse], carrying the line of the offending text.
+type RegFileError struct {
+	Line int   // 1-based line number in the .reg file; zero if unknown.
+	Err  error // Underlying error.
+}
+
+// Implements [error].
+func (e *RegFileError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err.Error())
+}
+
+// Allows [errors.Is] and [errors.As] on the underlying error.
+func (e *RegFileError) Unwrap() error {
+	return e.Err
+}
+
+// Serializes the contents in the REGEDIT5 text format.
+//
+// To obtain the contents in UTF-16, like regedit writes, use [RegFile.Bytes].
+func (me *RegFile) Serialize() string {
+	return regFileSerialize(me)
+}
+
+// Serializes the contents in the REGEDIT5 text format, encoded as UTF-16 LE
+// with byte order mark, like regedit writes.
+func (me *RegFile) Bytes() []byte {
+	return iniEncode(regFileSerialize(me), co.INI_ENC_UTF16LE)
+}
+
+const _REGFILE_HEADER = "Windows Registry Editor Version 5.00"
+
+// Parses the contents of a .reg file, in the REGEDIT5 format, as exported by
+// regedit. The contents can be UTF-16 LE with byte order mark, like regedit
+// writes, or UTF-8.
+//
+// This function doesn't touch the registry, so it can be used with contents
+// from any source.
+//
+// # Example
+//
+//	contents, _ := win.FileRead("C:\\Temp\\foo.reg")
+//	regFile, _ := win.RegFileParse(contents)
+//	for _, key := range regFile.Keys {
+//		println(key.Path, len(key.Values))
+//	}
+func RegFileParse(contents []byte) (*RegFile, error) {
+	text, _, err := iniDecode(contents) // same BOM detection of .ini files
+	if err != nil {
+		return nil, err
+	}
+
+	lines := regFileJoinContinuations(strings.Split(text, "\n"))
+	me := &RegFile{Keys: make([]RegFileKey, 0)}
+	var curKey *RegFileKey
+	headerFound := false
+
+	for _, ln := range lines {
+		line := strings.TrimSpace(ln.text)
+		if line == "" || line[0] == ';' {
+			continue // blank line or comment
+		}
+
+		if !headerFound {
+			if line != _REGFILE_HEADER {
+				return nil, &RegFileError{ln.num, fmt.Errorf("expected header %q", _REGFILE_HEADER)}
+			}
+			headerFound = true
+			continue
+		}
+
+		if line[0] == '[' { // [key] or [-key]
+			if line[len(line)-1] != ']' {
+				return nil, &RegFileError{ln.num, fmt.Errorf("unterminated key path")}
+			}
+			path, isDelete := line[1:len(line)-1], false
+			if strings.HasPrefix(path, "-") {
+				path, isDelete = path[1:], true
+			}
+			me.Keys = append(me.Keys, RegFileKey{
+				Path:   path,
+				Delete: isDelete,
+				Values: make([]RegFileValue, 0),
+			})
+			curKey = &me.Keys[len(me.Keys)-1]
+			continue
+		}
+
+		if curKey == nil {
+			return nil, &RegFileError{ln.num, fmt.Errorf("value outside of a key")}
+		}
+		value, err := regFileParseValue(line)
+		if err != nil {
+			return nil, &RegFileError{ln.num, err}
+		}
+		curKey.Values = append(curKey.Values, value)
+	}
+
+	if !headerFound {
+		return nil, &RegFileError{0, fmt.Errorf("expected header %q", _REGFILE_HEADER)}
+	}
+	return me, nil
+}
+
+type _RegFileLine struct {
+	num  int // 1-based number of the first physical line
+	text string
+}
+
+// Joins the lines ending with a backslash, which continue in the next line.
+func regFileJoinContinuations(physical []string) []_RegFileLine {
+	logical := make([]_RegFileLine, 0, len(physical))
+	var cur strings.Builder
+	startNum := 0
+
+	for idx, line := range physical {
+		line = strings.TrimRight(line, "\r")
+		if cur.Len() == 0 {
+			startNum = idx + 1
+		} else {
+			line = strings.TrimLeft(line, " \t") // continuation lines are indented
+		}
+
+		trimmed := strings.TrimRight(line, " \t")
+		isComment := strings.HasPrefix(strings.TrimSpace(cur.String()+trimmed), ";")
+		if strings.HasSuffix(trimmed, "\\") && !isComment {
+			cur.WriteString(trimmed[:len(trimmed)-1])
+			continue
+		}
+		cur.WriteString(line)
+		logical = append(logical, _RegFileLine{startNum, cur.String()})
+		cur.Reset()
+	}
+	if cur.Len() > 0 {
+		logical = append(logical, _RegFileLine{startNum, cur.String()})
+	}
+	return logical
+}
+
+// Parses a "name"=data line.
+func regFileParseValue(line string) (RegFileValue, error) {
+	var name, rest string
+
+	if strings.HasPrefix(line, "@") {
+		rest = line[1:] // default value, with empty name
+	} else if strings.HasPrefix(line, "\"") {
+		var ok bool
+		if name, rest, ok = regFileUnquote(line); !ok {
+			return RegFileValue{}, fmt.Errorf("unterminated value name")
+		}
+	} else {
+		return RegFileValue{}, fmt.Errorf("invalid value line")
+	}
+
+	rest = strings.TrimSpace(rest)
+	if !strings.HasPrefix(rest, "=") {
+		return RegFileValue{}, fmt.Errorf("expected '=' after value name")
+	}
+	data := strings.TrimSpace(rest[1:])
+
+	switch {
+	case data == "-":
+		return RegFileValue{Name: name, Delete: true}, nil
+
+	case strings.HasPrefix(data, "\""):
+		str, tail, ok := regFileUnquote(data)
+		if !ok || strings.TrimSpace(tail) != "" {
+			return RegFileValue{}, fmt.Errorf("invalid string data")
+		}
+		return RegFileValue{Name: name, Val: RegValSz(str)}, nil
+
+	case strings.HasPrefix(strings.ToLower(data), "dword:"):
+		n, err := strconv.ParseUint(strings.TrimSpace(data[6:]), 16, 32)
+		if err != nil {
+			return RegFileValue{}, fmt.Errorf("invalid dword data: %w", err)
+		}
+		return RegFileValue{Name: name, Val: RegValDword(uint32(n))}, nil
+
+	case strings.HasPrefix(strings.ToLower(data), "hex"):
+		regType := co.REG_BINARY
+		data = data[3:]
+		if strings.HasPrefix(data, "(") {
+			idxClose := strings.IndexByte(data, ')')
+			if idxClose == -1 {
+				return RegFileValue{}, fmt.Errorf("invalid hex type")
+			}
+			n, err := strconv.ParseUint(data[1:idxClose], 16, 32)
+			if err != nil {
+				return RegFileValue{}, fmt.Errorf("invalid hex type: %w", err)
+			}
+			regType = co.REG(n)
+			data = data[idxClose+1:]
+		}
+		if !strings.HasPrefix(data, ":") {
+			return RegFileValue{}, fmt.Errorf("expected ':' after hex")
+		}
+		bytes, err := regFileParseHex(data[1:])
+		if err != nil {
+			return RegFileValue{}, err
+		}
+		val, err := regValParse(bytes, regType)
+		if err != nil {
+			return RegFileValue{}, fmt.Errorf("invalid data for type %d", regType)
+		}
+		return RegFileValue{Name: name, Val: val}, nil
+
+	default:
+		return RegFileValue{}, fmt.Errorf("unknown data format")
+	}
+}
+
+// Parses a quoted string with \\ and \" escapes, returning the remaining text
+// after the closing quote.
+func regFileUnquote(s string) (str, rest string, ok bool) {
+	var buf strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				buf.WriteByte(s[i])
+			}
+		case '"':
+			return buf.String(), s[i+1:], true
+		default:
+			buf.WriteByte(s[i])
+		}
+	}
+	return "", "", false
+}
+
+// Parses comma-separated hex bytes, like "01,ab,ff".
+func regFileParseHex(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return []byte{}, nil
+	}
+	parts := strings.Split(s, ",")
+	bytes := make([]byte, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue // trailing comma
+		}
+		b, err := strconv.ParseUint(part, 16, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex byte %q", part)
+		}
+		bytes = append(bytes, byte(b))
+	}
+	return bytes, nil
+}
+
+// Serializes the document in the REGEDIT5 text format, with CRLF line breaks.
+func regFileSerialize(me *RegFile) string {
+	var buf strings.Builder
+	buf.WriteString(_REGFILE_HEADER + "\r\n")
+
+	for idxKey := range me.Keys {
+		key := &me.Keys[idxKey]
+		buf.WriteString("\r\n")
+		if key.Delete {
+			buf.WriteString("[-" + key.Path + "]\r\n")
+			continue
+		}
+		buf.WriteString("[" + key.Path + "]\r\n")
+
+		for idxVal := range key.Values {
+			buf.WriteString(regFileSerializeValue(&key.Values[idxVal]))
+			buf.WriteString("\r\n")
+		}
+	}
+
+	buf.WriteString("\r\n")
+	return buf.String()
+}
+
+func regFileSerializeValue(value *RegFileValue) string {
+	prefix := "@="
+	if value.Name != "" {
+		prefix = regFileQuote(value.Name) + "="
+	}
+
+	if value.Delete {
+		return prefix + "-"
+	}
+
+	data := value.Val.data
+	switch value.Val.tag {
+	case co.REG_SZ:
+		if str, ok := regFileStrFromData(data); ok {
+			return prefix + regFileQuote(str)
+		}
+	case co.REG_DWORD:
+		if len(data) == 4 {
+			return prefix + fmt.Sprintf("dword:%08x", binary.LittleEndian.Uint32(data))
+		}
+	case co.REG_BINARY:
+		return regFileFormatHex(prefix+"hex:", data)
+	}
+	return regFileFormatHex(prefix+fmt.Sprintf("hex(%x):", uint32(value.Val.tag)), data)
+}
+
+// Decodes a REG_SZ data block, if it can be written as a quoted string.
+func regFileStrFromData(data []byte) (string, bool) {
+	if len(data)%2 != 0 {
+		return "", false
+	}
+	words := make([]uint16, 0, len(data)/2)
+	for i := 0; i < len(data); i += 2 {
+		words = append(words, binary.LittleEndian.Uint16(data[i:]))
+	}
+	if len(words) > 0 && words[len(words)-1] == 0 {
+		words = words[:len(words)-1] // terminating null
+	}
+	for _, w := range words {
+		if w == 0 || w == '\r' || w == '\n' {
+			return "", false // can't be represented within quotes
+		}
+	}
+	return string(utf16.Decode(words)), true
+}
+
+func regFileQuote(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, "\"", "\\\"")
+	return "\"" + s + "\""
+}
+
+// Formats the bytes as comma-separated hex, breaking the lines like regedit
+// does, so no line is longer than 80 chars.
+func regFileFormatHex(prefix string, data []byte) string {
+	var buf strings.Builder
+	buf.WriteString(prefix)
+	lineLen := len(prefix)
+
+	for i, b := range data {
+		item := fmt.Sprintf("%02x", b)
+		if i < len(data)-1 {
+			item += ","
+		}
+		if lineLen+len(item) > 77 && lineLen > 2 {
+			buf.WriteString("\\\r\n  ")
+			lineLen = 2
+		}
+		buf.WriteString(item)
+		lineLen += len(item)
+	}
+	return buf.String()
+}
@@ -0,0 +1,233 @@
+package win
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+func regFileTestDoc() *RegFile {
+	return &RegFile{Keys: []RegFileKey{
+		{
+			Path: `HKEY_CURRENT_USER\Software\Foo`,
+			Values: []RegFileValue{
+				{Name: "", Val: RegValSz("default")},
+				{Name: `quote"back\`, Val: RegValSz(`C:\dir "x"`)},
+				{Name: "n", Val: RegValDword(42)},
+				{Name: "bin", Val: RegValBinary([]byte{0x01, 0xab})},
+				{Name: "exp", Val: RegValExpandSz("%P%")},
+				{Name: "multi", Val: RegValMultiSz("a", "b")},
+				{Name: "q", Val: RegValQword(0x0102_0304_0506_0708)},
+				{Name: "nl", Val: RegValSz("a\nb")},
+				{Name: "gone", Delete: true},
+			},
+		},
+		{
+			Path:   `HKEY_CURRENT_USER\Software\Old`,
+			Delete: true,
+			Values: []RegFileValue{},
+		},
+	}}
+}
+
+const _REGFILE_TEST_TEXT = "Windows Registry Editor Version 5.00\r\n" +
+	"\r\n" +
+	"[HKEY_CURRENT_USER\\Software\\Foo]\r\n" +
+	"@=\"default\"\r\n" +
+	"\"quote\\\"back\\\\\"=\"C:\\\\dir \\\"x\\\"\"\r\n" +
+	"\"n\"=dword:0000002a\r\n" +
+	"\"bin\"=hex:01,ab\r\n" +
+	"\"exp\"=hex(2):25,00,50,00,25,00,00,00\r\n" +
+	"\"multi\"=hex(7):61,00,00,00,62,00,00,00,00,00\r\n" +
+	"\"q\"=hex(b):08,07,06,05,04,03,02,01\r\n" +
+	"\"nl\"=hex(1):61,00,0a,00,62,00,00,00\r\n" +
+	"\"gone\"=-\r\n" +
+	"\r\n" +
+	"[-HKEY_CURRENT_USER\\Software\\Old]\r\n" +
+	"\r\n"
+
+func TestRegFileSerialize(t *testing.T) {
+	doc := regFileTestDoc()
+	if got := doc.Serialize(); got != _REGFILE_TEST_TEXT {
+		t.Errorf("Serialize() =\n%s\nwant\n%s", got, _REGFILE_TEST_TEXT)
+	}
+
+	wantStart := []byte{0xff, 0xfe, 'W', 0, 'i', 0, 'n', 0}
+	if got := doc.Bytes(); !bytes.HasPrefix(got, wantStart) || len(got) != 2+len(_REGFILE_TEST_TEXT)*2 {
+		t.Errorf("Bytes() is not UTF-16 LE with BOM: % x...", got[:8])
+	}
+}
+
+func TestRegFileSerializeLongHex(t *testing.T) {
+	doc := &RegFile{Keys: []RegFileKey{{
+		Path:   `HKEY_CURRENT_USER\Foo`,
+		Values: []RegFileValue{{Name: "v", Val: RegValBinary(make([]byte, 30))}},
+	}}}
+
+	// No line is longer than 80 chars; continuation lines are indented.
+	want := "Windows Registry Editor Version 5.00\r\n" +
+		"\r\n" +
+		"[HKEY_CURRENT_USER\\Foo]\r\n" +
+		"\"v\"=hex:" + strings.Repeat("00,", 23) + "\\\r\n" +
+		"  " + strings.Repeat("00,", 6) + "00\r\n" +
+		"\r\n"
+	if got := doc.Serialize(); got != want {
+		t.Errorf("Serialize() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRegFileParse(t *testing.T) {
+	doc, err := RegFileParse([]byte(_REGFILE_TEST_TEXT))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := regFileTestDoc(); !reflect.DeepEqual(doc, want) {
+		t.Errorf("got %+v\nwant %+v", doc, want)
+	}
+}
+
+func TestRegFileRoundTrip(t *testing.T) {
+	doc := regFileTestDoc()
+	parsed, err := RegFileParse(doc.Bytes()) // UTF-16 LE, like regedit writes
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(parsed, doc) {
+		t.Errorf("got %+v\nwant %+v", parsed, doc)
+	}
+	if got := parsed.Serialize(); got != _REGFILE_TEST_TEXT {
+		t.Errorf("Serialize() =\n%s\nwant\n%s", got, _REGFILE_TEST_TEXT)
+	}
+}
+
+func TestRegFileParseValues(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want RegFileValue
+	}{
+		{"default value", `@="x"`, RegFileValue{Val: RegValSz("x")}},
+		{"escapes", `"a\\b\"c"="\\\\srv\\\"s\""`, RegFileValue{Name: `a\b"c`, Val: RegValSz(`\\srv\"s"`)}},
+		{"spaces around equal", `"a" = "b"`, RegFileValue{Name: "a", Val: RegValSz("b")}},
+		{"dword", `"a"=dword:FFFFFFFF`, RegFileValue{Name: "a", Val: RegValDword(0xffff_ffff)}},
+		{"hex", `"a"=hex:00, 7F ,ff,`, RegFileValue{Name: "a", Val: RegValBinary([]byte{0x00, 0x7f, 0xff})}},
+		{"empty hex", `"a"=hex:`, RegFileValue{Name: "a", Val: RegValBinary([]byte{})}},
+		{"hex(2)", `"a"=hex(2):41,00,00,00`, RegFileValue{Name: "a", Val: RegValExpandSz("A")}},
+		{"hex(7)", `"a"=hex(7):41,00,00,00,42,00,00,00,00,00`, RegFileValue{Name: "a", Val: RegValMultiSz("A", "B")}},
+		{"hex(b)", `"a"=hex(b):01,00,00,00,00,00,00,80`, RegFileValue{Name: "a", Val: RegValQword(0x8000_0000_0000_0001)}},
+		{"hex(4)", `"a"=hex(4):2a,00,00,00`, RegFileValue{Name: "a", Val: RegValDword(42)}},
+		{"delete", `"a"=-`, RegFileValue{Name: "a", Delete: true}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := RegFileParse([]byte(_REGFILE_HEADER + "\n[HKEY_CURRENT_USER\\Foo]\n" + tc.line + "\n"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := doc.Keys[0].Values[0]; !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRegFileParseStructure(t *testing.T) {
+	text := "\xef\xbb\xbf; exported\r\n" +
+		_REGFILE_HEADER + "\r\n" +
+		"\r\n" +
+		"[-HKEY_CURRENT_USER\\Gone]\r\n" +
+		"\r\n" +
+		"[HKEY_CURRENT_USER\\Foo]\r\n" +
+		"; comment ending with backslash \\\r\n" +
+		"\"long\"=hex:01,02,\\\r\n" +
+		"  03,04,\\\r\n" +
+		"\t05\r\n" +
+		"\"after\"=dword:00000001\r\n"
+
+	doc, err := RegFileParse([]byte(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &RegFile{Keys: []RegFileKey{
+		{Path: `HKEY_CURRENT_USER\Gone`, Delete: true, Values: []RegFileValue{}},
+		{Path: `HKEY_CURRENT_USER\Foo`, Values: []RegFileValue{
+			{Name: "long", Val: RegValBinary([]byte{1, 2, 3, 4, 5})},
+			{Name: "after", Val: RegValDword(1)},
+		}},
+	}}
+	if !reflect.DeepEqual(doc, want) {
+		t.Errorf("got %+v\nwant %+v", doc, want)
+	}
+}
+
+func TestRegFileParseErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		wantLine int
+	}{
+		{"empty", "", 0},
+		{"no header", "[HKEY_CURRENT_USER\\Foo]\n", 1},
+		{"REGEDIT4 header", "REGEDIT4\n", 1},
+		{"unterminated key", _REGFILE_HEADER + "\n\n[HKEY_CURRENT_USER\\Foo\n", 3},
+		{"value outside key", _REGFILE_HEADER + "\n\"a\"=\"b\"\n", 2},
+		{"invalid line", _REGFILE_HEADER + "\n[K]\na=b\n", 3},
+		{"missing equal", _REGFILE_HEADER + "\n[K]\n\"a\" \"b\"\n", 3},
+		{"unterminated name", _REGFILE_HEADER + "\n[K]\n\"a=\"b\n", 3},
+		{"unterminated string", _REGFILE_HEADER + "\n[K]\n\"a\"=\"b\n", 3},
+		{"text after string", _REGFILE_HEADER + "\n[K]\n\"a\"=\"b\" c\n", 3},
+		{"invalid dword", _REGFILE_HEADER + "\n[K]\n\"a\"=dword:1234567890\n", 3},
+		{"invalid hex byte", _REGFILE_HEADER + "\n[K]\n\"a\"=hex:01,\\\n  0g\n\"b\"=-\n", 3},
+		{"invalid hex type", _REGFILE_HEADER + "\n[K]\n\"a\"=hex(z):00\n", 3},
+		{"unterminated hex type", _REGFILE_HEADER + "\n[K]\n\"a\"=hex(2:00\n", 3},
+		{"short qword", _REGFILE_HEADER + "\n[K]\n\"a\"=hex(b):01,02,03,04\n", 3},
+		{"line after continuation", _REGFILE_HEADER + "\n[K]\n\"a\"=hex:01,\\\n  02\n\"b\"=x\n", 5},
+		{"unknown format", _REGFILE_HEADER + "\n[K]\n\"a\"=str:x\n", 3},
+		{"odd UTF-16", "\xff\xfeR", -1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := RegFileParse([]byte(tc.text))
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if tc.wantLine < 0 {
+				return // not a syntax error
+			}
+			var regErr *RegFileError
+			if !errors.As(err, &regErr) {
+				t.Fatalf("error = %v, want a *RegFileError", err)
+			}
+			if regErr.Line != tc.wantLine {
+				t.Errorf("line = %d, want %d (%v)", regErr.Line, tc.wantLine, err)
+			}
+		})
+	}
+}
+
+func TestRegFileSerializeTypes(t *testing.T) {
+	tests := []struct {
+		val  RegVal
+		want string
+	}{
+		{RegValSz(""), `""`},
+		{RegValDwordBigEndian(1), "hex(5):00,00,00,01"},
+		{RegValNone(), "hex(0):"},
+		{RegVal{co.REG_SZ, []byte{'a', 0, 0, 0, 'b', 0}}, "hex(1):61,00,00,00,62,00"}, // embedded null
+		{RegVal{co.REG_DWORD, []byte{1, 2}}, "hex(4):01,02"},                          // malformed dword
+	}
+
+	for _, tc := range tests {
+		doc := &RegFile{Keys: []RegFileKey{{Path: "K", Values: []RegFileValue{{Name: "v", Val: tc.val}}}}}
+		want := _REGFILE_HEADER + "\r\n\r\n[K]\r\n\"v\"=" + tc.want + "\r\n\r\n"
+		if got := doc.Serialize(); got != want {
+			t.Errorf("Serialize() =\n%q\nwant\n%q", got, want)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+//go:build windows
+
+package win
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"strings"
+
+	"github.com/rodrigocfd/windigo/internal/utl"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// A change notification delivered by [RegWatch].
+type RegChange struct {
+	// If not nil, watching failed and the channel is about to be closed. This
+	// happens, for example, when the watched key is deleted.
+	Err error
+	// Changes of the values of the key itself, if value diffing was requested.
+	// Changes in subkeys are not diffed, therefore this slice may be empty even
+	// when a change was notified.
+	Values []RegValueChange
+}
+
+// A change in a single value, within a [RegChange].
+type RegValueChange struct {
+	Name string        // Name of the value; empty for the default value.
+	Kind co.REG_CHANGE // What happened to the value.
+	Old  RegVal        // Previous data; zero value if the value was added.
+	New  RegVal        // Current data; zero value if the value was removed.
+}
+
+// Watches a registry key for changes with [HKEY.RegNotifyChangeKeyValue],
+// delivering each notification on the returned channel, until the context is
+// cancelled, when the channel is closed.
+//
+// The key must remain open while watching, and it must have been opened with
+// [co.KEY_NOTIFY]; if diffValues is true, also with [co.KEY_QUERY_VALUE]. In
+// this case, the values are enumerated with [HKEY.RegEnumValue] on each change,
+// and compared with the previous enumeration.
+//
+// The watch is re-armed as soon as a change is signaled, before the values are
+// diffed and the notification is sent, so no change is lost. Since the channel
+// is unbuffered, all the changes which happen while the previous notification
+// is not yet received are delivered in a single notification.
+//
+// # Example
+//
+//	hKey, _ := win.HKEY_CURRENT_USER.RegOpenKeyEx(
+//		"Control Panel\\Mouse",
+//		co.REG_OPTION_NONE,
+//		co.KEY_NOTIFY|co.KEY_QUERY_VALUE)
+//	defer hKey.RegCloseKey()
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//
+//	changes, _ := win.RegWatch(ctx, hKey, false,
+//		co.REG_NOTIFY_NAME|co.REG_NOTIFY_LAST_SET, true)
+//	for change := range changes {
+//		for _, val := range change.Values {
+//			println(val.Name, val.Kind)
+//		}
+//	}
+func RegWatch(
+	ctx context.Context,
+	hKey HKEY,
+	watchSubtree bool,
+	filter co.REG_NOTIFY,
+	diffValues bool,
+) (<-chan RegChange, error) {
+	var prev []HkeyNameVal
+	if diffValues {
+		var err error
+		if prev, err = hKey.RegEnumValue(); err != nil {
+			return nil, err
+		}
+	}
+
+	hChanged, err := CreateEvent(nil, false, false, "")
+	if err != nil {
+		return nil, err
+	}
+	hStop, err := CreateEvent(nil, true, false, "")
+	if err != nil {
+		hChanged.CloseHandle()
+		return nil, err
+	}
+
+	changes := make(chan RegChange)
+	loopDone := make(chan struct{})
+	stopperDone := make(chan struct{})
+
+	go func() { // wakes the loop when the context is cancelled
+		defer close(stopperDone)
+		select {
+		case <-ctx.Done():
+			hStop.SetEvent()
+		case <-loopDone:
+		}
+	}()
+
+	go func() {
+		// The notification is bound to the thread which registered it.
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+
+		defer func() {
+			close(loopDone)
+			<-stopperDone // the stopper won't touch hStop anymore
+			hStop.CloseHandle()
+			hChanged.CloseHandle()
+			close(changes)
+		}()
+
+		if err := hKey.RegNotifyChangeKeyValue(watchSubtree, filter, hChanged, true); err != nil {
+			regWatchSend(ctx, changes, RegChange{Err: err})
+			return
+		}
+
+		for {
+			handles := []HANDLE{HANDLE(hChanged), HANDLE(hStop)}
+			wait, err := WaitForMultipleObjects(handles, false, utl.INFINITE)
+			if err != nil {
+				regWatchSend(ctx, changes, RegChange{Err: err})
+				return
+			} else if wait != co.WAIT_OBJECT_0 { // context cancelled
+				return
+			}
+
+			// Re-arm right away, so changes made while we diff and send are
+			// signaled too.
+			if err := hKey.RegNotifyChangeKeyValue(watchSubtree, filter, hChanged, true); err != nil {
+				regWatchSend(ctx, changes, RegChange{Err: err})
+				return
+			}
+
+			change := RegChange{}
+			if diffValues {
+				cur, err := hKey.RegEnumValue()
+				if err != nil {
+					regWatchSend(ctx, changes, RegChange{Err: err})
+					return
+				}
+				change.Values = regWatchDiff(prev, cur)
+				prev = cur
+			}
+			if !regWatchSend(ctx, changes, change) {
+				return
+			}
+		}
+	}()
+
+	return changes, nil
+}
+
+// Sends the change, unless the context is cancelled first.
+func regWatchSend(ctx context.Context, changes chan<- RegChange, change RegChange) bool {
+	select {
+	case changes <- change:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Compares two enumerations of values. Value names are case-insensitive.
+func regWatchDiff(prev, cur []HkeyNameVal) []RegValueChange {
+	prevByName := make(map[string]*HkeyNameVal, len(prev))
+	for idx := range prev {
+		prevByName[strings.ToUpper(prev[idx].Name)] = &prev[idx]
+	}
+
+	diffs := make([]RegValueChange, 0)
+	for idx := range cur {
+		curVal := &cur[idx]
+		upperName := strings.ToUpper(curVal.Name)
+		if prevVal, ok := prevByName[upperName]; !ok {
+			diffs = append(diffs, RegValueChange{
+				Name: curVal.Name,
+				Kind: co.REG_CHANGE_ADDED,
+				New:  curVal.Val,
+			})
+		} else {
+			if prevVal.Val.tag != curVal.Val.tag || !bytes.Equal(prevVal.Val.data, curVal.Val.data) {
+				diffs = append(diffs, RegValueChange{
+					Name: curVal.Name,
+					Kind: co.REG_CHANGE_MODIFIED,
+					Old:  prevVal.Val,
+					New:  curVal.Val,
+				})
+			}
+			delete(prevByName, upperName)
+		}
+	}
+
+	for idx := range prev { // whatever is left was removed; keep the original order
+		if _, ok := prevByName[strings.ToUpper(prev[idx].Name)]; ok {
+			diffs = append(diffs, RegValueChange{
+				Name: prev[idx].Name,
+				Kind: co.REG_CHANGE_REMOVED,
+				Old:  prev[idx].Val,
+			})
+		}
+	}
+	return diffs
+}
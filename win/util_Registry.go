@@ -0,0 +1,218 @@
+//go:build windows
+
+package win
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// High-level abstraction to an open registry key, created with [RegistryOpen]
+// or [RegistryCreate].
+//
+// Typed values are read and written with [RegistryGet] and [RegistrySet];
+// whole structs with [Registry.Load] and [Registry.Save].
+//
+// ⚠️ You must defer [Registry.Close].
+//
+// # Example
+//
+//	reg, _ := win.RegistryOpen(win.HKEY_CURRENT_USER,
+//		"Control Panel\\Desktop", co.KEY_READ)
+//	defer reg.Close()
+//
+//	wallpaper, _ := win.RegistryGet[string](reg, "WallPaper")
+type Registry struct {
+	hKey HKEY
+	path string // for error messages
+}
+
+// Opens an existing registry key.
+//
+// ⚠️ You must defer [Registry.Close].
+func RegistryOpen(hKeyParent HKEY, subKey string, accessRights co.KEY) (*Registry, error) {
+	hKey, err := hKeyParent.RegOpenKeyEx(subKey, co.REG_OPTION_NONE, accessRights)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", subKey, err)
+	}
+	return &Registry{hKey, subKey}, nil
+}
+
+// Opens a registry key, creating it if it doesn't exist.
+//
+// ⚠️ You must defer [Registry.Close].
+func RegistryCreate(hKeyParent HKEY, subKey string, accessRights co.KEY) (*Registry, error) {
+	hKey, err := hKeyParent.RegCreateKeyEx(subKey, co.REG_OPTION_NONE, accessRights, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating %s: %w", subKey, err)
+	}
+	return &Registry{hKey, subKey}, nil
+}
+
+// Closes the underlying [HKEY].
+func (me *Registry) Close() {
+	if me.hKey != 0 {
+		me.hKey.RegCloseKey()
+		me.hKey = 0
+	}
+}
+
+// Returns the underlying [HKEY].
+func (me *Registry) Hkey() HKEY {
+	return me.hKey
+}
+
+// Opens an existing subkey.
+//
+// ⚠️ You must defer [Registry.Close].
+func (me *Registry) Open(subKey string, accessRights co.KEY) (*Registry, error) {
+	reg, err := RegistryOpen(me.hKey, subKey, accessRights)
+	if err == nil {
+		reg.path = me.path + "\\" + subKey
+	}
+	return reg, err
+}
+
+// Opens a subkey, creating it if it doesn't exist.
+//
+// ⚠️ You must defer [Registry.Close].
+func (me *Registry) Create(subKey string, accessRights co.KEY) (*Registry, error) {
+	reg, err := RegistryCreate(me.hKey, subKey, accessRights)
+	if err == nil {
+		reg.path = me.path + "\\" + subKey
+	}
+	return reg, err
+}
+
+// Tells whether the value exists.
+func (me *Registry) Has(valueName string) bool {
+	_, err := me.hKey.RegQueryValueEx(valueName)
+	return err == nil
+}
+
+// Deletes the value. A value which doesn't exist is ignored.
+func (me *Registry) DeleteValue(valueName string) error {
+	if err := me.hKey.RegDeleteValue(valueName); err != nil && err != co.ERROR_FILE_NOT_FOUND {
+		return fmt.Errorf("deleting %s\\%s: %w", me.path, valueName, err)
+	}
+	return nil
+}
+
+// Deletes the subkey, with all its values and subkeys, recursively. A subkey
+// which doesn't exist is ignored.
+func (me *Registry) DeleteSubKey(subKey string) error {
+	if err := me.hKey.RegDeleteTree(subKey); err != nil && err != co.ERROR_FILE_NOT_FOUND {
+		return fmt.Errorf("deleting %s\\%s: %w", me.path, subKey, err)
+	}
+	return nil
+}
+
+// Returns the names of the direct subkeys, sorted alphabetically.
+func (me *Registry) SubKeys() ([]string, error) {
+	return me.hKey.RegEnumKeyEx()
+}
+
+// Returns the values of the key, sorted alphabetically.
+func (me *Registry) Values() ([]HkeyNameVal, error) {
+	return me.hKey.RegEnumValue()
+}
+
+// Calls fn for the key itself and for each of its subkeys, recursively, in
+// depth-first order. The subKey path is relative to the key, and it's empty for
+// the key itself. Subkeys are opened with [co.KEY_READ].
+//
+// If fn returns an error, the walk stops and the error is returned.
+//
+// # Example
+//
+//	reg, _ := win.RegistryOpen(win.HKEY_CURRENT_USER,
+//		"Software\\Microsoft\\Notepad", co.KEY_READ)
+//	defer reg.Close()
+//
+//	reg.Walk(func(subKey string, values []win.HkeyNameVal) error {
+//		println(subKey, len(values))
+//		return nil
+//	})
+func (me *Registry) Walk(fn func(subKey string, values []HkeyNameVal) error) error {
+	return me.walk("", fn)
+}
+
+func (me *Registry) walk(subKey string, fn func(subKey string, values []HkeyNameVal) error) error {
+	values, err := me.Values()
+	if err != nil {
+		return fmt.Errorf("enumerating values of %s: %w", me.path, err)
+	}
+	if err := fn(subKey, values); err != nil {
+		return err
+	}
+
+	children, err := me.SubKeys()
+	if err != nil {
+		return fmt.Errorf("enumerating subkeys of %s: %w", me.path, err)
+	}
+	for _, child := range children {
+		childPath := child
+		if subKey != "" {
+			childPath = subKey + "\\" + child
+		}
+		regChild, err := me.Open(child, co.KEY_READ)
+		if err != nil {
+			return err
+		}
+		err = regChild.walk(childPath, fn)
+		regChild.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reads a value, decoding it into a Go type, according to the rules of
+// [RegValDecode].
+//
+// If the value doesn't exist, the error is [co.ERROR_FILE_NOT_FOUND], which
+// can be checked with [errors.Is].
+//
+// # Example
+//
+//	reg, _ := win.RegistryOpen(win.HKEY_CURRENT_USER,
+//		"Control Panel\\Mouse", co.KEY_READ)
+//	defer reg.Close()
+//
+//	speed, _ := win.RegistryGet[string](reg, "MouseSpeed")
+func RegistryGet[T RegType](reg *Registry, valueName string) (T, error) {
+	var ret T
+	val, err := reg.hKey.RegQueryValueEx(valueName)
+	if err != nil {
+		return ret, fmt.Errorf("reading %s\\%s: %w", reg.path, valueName, err)
+	}
+	if err := regValDecode(val, reflect.ValueOf(&ret).Elem()); err != nil {
+		return ret, fmt.Errorf("reading %s\\%s: %w", reg.path, valueName, err)
+	}
+	return ret, nil
+}
+
+// Writes a value, encoding it from a Go type, according to the rules of
+// [RegValEncode].
+//
+// # Example
+//
+//	reg, _ := win.RegistryCreate(win.HKEY_CURRENT_USER,
+//		"Software\\MyApp", co.KEY_READ|co.KEY_WRITE)
+//	defer reg.Close()
+//
+//	_ = win.RegistrySet(reg, "Width", uint32(800))
+//	_ = win.RegistrySet(reg, "Recent", []string{"a.txt", "b.txt"})
+func RegistrySet[T RegType](reg *Registry, valueName string, value T) error {
+	val, err := regValEncode(reflect.ValueOf(value))
+	if err != nil {
+		return fmt.Errorf("writing %s\\%s: %w", reg.path, valueName, err)
+	}
+	if err := reg.hKey.RegSetValueEx(valueName, val); err != nil {
+		return fmt.Errorf("writing %s\\%s: %w", reg.path, valueName, err)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+//go:build windows
+
+package win
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Loads the values of the key into the struct pointed to by v.
+//
+// Each field of the struct maps to a value, decoded according to the rules of
+// [RegValDecode]. A struct field maps to a subkey, recursively, unless it
+// implements [encoding.TextUnmarshaler].
+//
+// Field names are used as value and subkey names, unless overridden by a "reg"
+// struct tag. A "-" tag skips the field.
+//
+// Values and subkeys absent from the registry leave the fields untouched.
+//
+// # Example
+//
+//	type Settings struct {
+//		Width   uint32
+//		Height  uint32
+//		Recent  []string `reg:"RecentFiles"`
+//		Toolbar struct {
+//			Visible bool
+//		}
+//	}
+//
+//	reg, _ := win.RegistryOpen(win.HKEY_CURRENT_USER,
+//		"Software\\MyApp", co.KEY_READ)
+//	defer reg.Close()
+//
+//	var settings Settings
+//	_ = reg.Load(&settings)
+func (me *Registry) Load(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("Registry.Load needs a non-nil pointer to a struct")
+	}
+	return me.loadStruct(rv.Elem())
+}
+
+func (me *Registry) loadStruct(rvStruct reflect.Value) error {
+	for i := 0; i < rvStruct.NumField(); i++ {
+		name, _, ok := regFieldName(rvStruct.Type().Field(i))
+		if !ok {
+			continue
+		}
+		rvField := rvStruct.Field(i)
+
+		if regIsSubKey(rvField) {
+			regSub, err := me.Open(name, co.KEY_READ)
+			if errors.Is(err, co.ERROR_FILE_NOT_FOUND) {
+				continue
+			} else if err != nil {
+				return err
+			}
+			err = regSub.loadStruct(rvField)
+			regSub.Close()
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		val, err := me.hKey.RegQueryValueEx(name)
+		if err == co.ERROR_FILE_NOT_FOUND {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("reading %s\\%s: %w", me.path, name, err)
+		}
+		if err := regValDecode(val, rvField); err != nil {
+			return fmt.Errorf("reading %s\\%s: %w", me.path, name, err)
+		}
+	}
+	return nil
+}
+
+// Writes the fields of the struct v, which can be a struct or a pointer to it,
+// into the key. Subkeys are created as needed. The mapping rules are the same
+// of [Registry.Load], and the values are encoded according to [RegValEncode].
+//
+// The "omitempty" tag option skips fields with zero values; existing values are
+// not deleted.
+//
+// The key must have been opened with [co.KEY_WRITE].
+//
+// # Example
+//
+//	var settings Settings // initialized somewhere
+//
+//	reg, _ := win.RegistryCreate(win.HKEY_CURRENT_USER,
+//		"Software\\MyApp", co.KEY_WRITE)
+//	defer reg.Close()
+//
+//	_ = reg.Save(&settings)
+func (me *Registry) Save(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return errors.New("Registry.Save needs a struct or a pointer to a struct")
+	}
+	return me.saveStruct(rv)
+}
+
+func (me *Registry) saveStruct(rvStruct reflect.Value) error {
+	for i := 0; i < rvStruct.NumField(); i++ {
+		name, omitEmpty, ok := regFieldName(rvStruct.Type().Field(i))
+		if !ok {
+			continue
+		}
+		rvField := rvStruct.Field(i)
+
+		if regIsSubKey(rvField) {
+			regSub, err := me.Create(name, co.KEY_WRITE)
+			if err != nil {
+				return err
+			}
+			err = regSub.saveStruct(rvField)
+			regSub.Close()
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if omitEmpty && rvField.IsZero() {
+			continue
+		}
+		val, err := regValEncode(rvField)
+		if err != nil {
+			return fmt.Errorf("writing %s\\%s: %w", me.path, name, err)
+		}
+		if err := me.hKey.RegSetValueEx(name, val); err != nil {
+			return fmt.Errorf("writing %s\\%s: %w", me.path, name, err)
+		}
+	}
+	return nil
+}
+
+// Returns the value or subkey name of the struct field, and whether the field
+// is mapped at all.
+func regFieldName(field reflect.StructField) (name string, omitEmpty, ok bool) {
+	if !field.IsExported() {
+		return "", false, false
+	}
+	tag := field.Tag.Get("reg")
+	if tag == "-" {
+		return "", false, false
+	}
+
+	name = field.Name
+	parts := strings.Split(tag, ",")
+	if parts[0] != "" {
+		name = parts[0]
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty, true
+}
+
+// Tells whether the struct field maps to a subkey, instead of a single value.
+func regIsSubKey(rv reflect.Value) bool {
+	return rv.Kind() == reflect.Struct &&
+		!reflect.PointerTo(rv.Type()).Implements(regTypeTextUnmarshaler) &&
+		!rv.Type().Implements(regTypeTextMarshaler)
+}
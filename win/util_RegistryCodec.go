@@ -0,0 +1,226 @@
+package win
+
+// This file has no knowledge of windows, so the value conversions can be tested
+// on any OS.
+
+import (
+	"encoding"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Go types which can be stored in the registry with [RegistryGet] and
+// [RegistrySet]:
+//   - strings, as [co.REG_SZ];
+//   - bool, as [co.REG_DWORD] 0 or 1;
+//   - integers up to 32 bits, as [co.REG_DWORD];
+//   - 64-bit integers, including int, uint and [time.Duration], as
+//     [co.REG_QWORD];
+//   - []byte, as [co.REG_BINARY];
+//   - []string, as [co.REG_MULTI_SZ].
+type RegType interface {
+	~string | ~bool |
+		~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~[]byte | ~[]string
+}
+
+// Encodes a Go value into a [RegVal], according to the rules of [RegType].
+// Types implementing [encoding.TextMarshaler] are stored as [co.REG_SZ].
+//
+// This function doesn't touch the registry.
+//
+// # Example
+//
+//	regVal, _ := win.RegValEncode(uint32(400))
+//	n, _ := regVal.Dword() // 400
+func RegValEncode(v interface{}) (RegVal, error) {
+	return regValEncode(reflect.ValueOf(v))
+}
+
+// Decodes a [RegVal] into the Go value pointed to by v, according to the rules
+// of [RegType]. Types implementing [encoding.TextUnmarshaler] are read from
+// [co.REG_SZ].
+//
+// The decoding is lenient: [co.REG_EXPAND_SZ] can be read into a string, but
+// it won't be expanded; [co.REG_DWORD], [co.REG_DWORD_BIG_ENDIAN] and
+// [co.REG_QWORD] can be read into any integer, as long as the number fits.
+//
+// This function doesn't touch the registry.
+//
+// # Example
+//
+//	var n int
+//	_ = win.RegValDecode(win.RegValDword(400), &n)
+func RegValDecode(val RegVal, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("RegValDecode needs a non-nil pointer")
+	}
+	return regValDecode(val, rv.Elem())
+}
+
+var (
+	regTypeTextMarshaler   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	regTypeTextUnmarshaler = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+func regValEncode(rv reflect.Value) (RegVal, error) {
+	if rv.IsValid() && rv.Type().Implements(regTypeTextMarshaler) {
+		text, err := rv.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return RegVal{}, err
+		}
+		return regValEncodeSz(string(text))
+	}
+
+	if !rv.IsValid() {
+		return RegVal{}, fmt.Errorf("cannot encode nil")
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		return regValEncodeSz(rv.String())
+	case reflect.Bool:
+		if rv.Bool() {
+			return RegValDword(1), nil
+		}
+		return RegValDword(0), nil
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return RegValDword(uint32(int32(rv.Int()))), nil
+	case reflect.Int, reflect.Int64:
+		return RegValQword(uint64(rv.Int())), nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return RegValDword(uint32(rv.Uint())), nil
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return RegValQword(rv.Uint()), nil
+	case reflect.Slice:
+		switch rv.Type().Elem().Kind() {
+		case reflect.Uint8:
+			data := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(data), rv)
+			return RegValBinary(data), nil
+		case reflect.String:
+			strs := make([]string, 0, rv.Len())
+			for i := 0; i < rv.Len(); i++ {
+				str := rv.Index(i).String()
+				if str == "" || strings.IndexByte(str, 0) != -1 {
+					return RegVal{}, fmt.Errorf("multi-string items cannot be empty or contain nulls")
+				}
+				strs = append(strs, str)
+			}
+			return RegValMultiSz(strs...), nil
+		}
+	}
+	return RegVal{}, fmt.Errorf("unsupported type %s", rv.Type())
+}
+
+func regValEncodeSz(s string) (RegVal, error) {
+	if strings.IndexByte(s, 0) != -1 {
+		return RegVal{}, fmt.Errorf("string cannot contain nulls")
+	}
+	return RegValSz(s), nil
+}
+
+func regValDecode(val RegVal, rv reflect.Value) error {
+	if rv.CanAddr() && rv.Addr().Type().Implements(regTypeTextUnmarshaler) {
+		str, ok := regValDecodeStr(val)
+		if !ok {
+			return regValMismatch(val, rv.Type())
+		}
+		return rv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(str))
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		str, ok := regValDecodeStr(val)
+		if !ok {
+			return regValMismatch(val, rv.Type())
+		}
+		rv.SetString(str)
+	case reflect.Bool:
+		n, ok := regValDecodeNum(val)
+		if !ok {
+			return regValMismatch(val, rv.Type())
+		}
+		rv.SetBool(n != 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := regValDecodeNum(val)
+		if !ok {
+			return regValMismatch(val, rv.Type())
+		}
+		signed := int64(n)
+		if val.tag != co.REG_QWORD && rv.Type().Bits() <= 32 {
+			signed = int64(int32(uint32(n))) // DWORD stored from a small signed int
+		}
+		if rv.OverflowInt(signed) {
+			return fmt.Errorf("value %d overflows %s", signed, rv.Type())
+		}
+		rv.SetInt(signed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, ok := regValDecodeNum(val)
+		if !ok {
+			return regValMismatch(val, rv.Type())
+		}
+		if rv.OverflowUint(n) {
+			return fmt.Errorf("value %d overflows %s", n, rv.Type())
+		}
+		rv.SetUint(n)
+	case reflect.Slice:
+		switch rv.Type().Elem().Kind() {
+		case reflect.Uint8:
+			if val.tag != co.REG_BINARY {
+				return regValMismatch(val, rv.Type())
+			}
+			data := reflect.MakeSlice(rv.Type(), len(val.data), len(val.data))
+			reflect.Copy(data, reflect.ValueOf(val.data))
+			rv.Set(data)
+		case reflect.String:
+			if val.tag != co.REG_MULTI_SZ {
+				return regValMismatch(val, rv.Type())
+			}
+			strs := regValDecodeMultiSz(val.data)
+			items := reflect.MakeSlice(rv.Type(), 0, len(strs))
+			for _, str := range strs {
+				items = reflect.Append(items, reflect.ValueOf(str).Convert(rv.Type().Elem()))
+			}
+			rv.Set(items)
+		default:
+			return fmt.Errorf("unsupported type %s", rv.Type())
+		}
+	default:
+		return fmt.Errorf("unsupported type %s", rv.Type())
+	}
+	return nil
+}
+
+// Wraps [co.ERROR_DATATYPE_MISMATCH], so the caller can check it with
+// [errors.Is].
+func regValMismatch(val RegVal, typ reflect.Type) error {
+	return fmt.Errorf("cannot decode registry type %d into %s: %w",
+		val.tag, typ, co.ERROR_DATATYPE_MISMATCH)
+}
+
+func regValDecodeStr(val RegVal) (string, bool) {
+	if val.tag != co.REG_SZ && val.tag != co.REG_EXPAND_SZ {
+		return "", false
+	}
+	return regValDecodeSz(val.data), true
+}
+
+func regValDecodeNum(val RegVal) (uint64, bool) {
+	switch {
+	case val.tag == co.REG_DWORD && len(val.data) == 4:
+		return uint64(binary.LittleEndian.Uint32(val.data)), true
+	case val.tag == co.REG_DWORD_BIG_ENDIAN && len(val.data) == 4:
+		return uint64(binary.BigEndian.Uint32(val.data)), true
+	case val.tag == co.REG_QWORD && len(val.data) == 8:
+		return binary.LittleEndian.Uint64(val.data), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,186 @@
+package win
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Struct stored as a string, like "#a5".
+type _RegTestTag struct{ N uint8 }
+
+func (tag _RegTestTag) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("#%02x", tag.N)), nil
+}
+
+func (tag *_RegTestTag) UnmarshalText(text []byte) error {
+	_, err := fmt.Sscanf(string(text), "#%02x", &tag.N)
+	return err
+}
+
+func TestRegValEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		v        interface{}
+		wantType co.REG
+		wantData []byte
+	}{
+		{"string", "ab", co.REG_SZ, []byte{'a', 0, 'b', 0, 0, 0}},
+		{"empty string", "", co.REG_SZ, []byte{0, 0}},
+		{"bool", true, co.REG_DWORD, []byte{1, 0, 0, 0}},
+		{"int8", int8(-2), co.REG_DWORD, []byte{0xfe, 0xff, 0xff, 0xff}},
+		{"uint16", uint16(0x1234), co.REG_DWORD, []byte{0x34, 0x12, 0, 0}},
+		{"int32", int32(-1), co.REG_DWORD, []byte{0xff, 0xff, 0xff, 0xff}},
+		{"int", 1, co.REG_QWORD, []byte{1, 0, 0, 0, 0, 0, 0, 0}},
+		{"uint64", uint64(0x0102_0304_0506_0708), co.REG_QWORD, []byte{8, 7, 6, 5, 4, 3, 2, 1}},
+		{"duration", time.Duration(-1), co.REG_QWORD, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{"bytes", []byte{9, 8}, co.REG_BINARY, []byte{9, 8}},
+		{"empty bytes", []byte{}, co.REG_BINARY, []byte{}},
+		{"strings", []string{"a", "b"}, co.REG_MULTI_SZ, []byte{'a', 0, 0, 0, 'b', 0, 0, 0, 0, 0}},
+		{"one string", []string{"a"}, co.REG_MULTI_SZ, []byte{'a', 0, 0, 0, 0, 0}},
+		{"no strings", []string{}, co.REG_MULTI_SZ, []byte{0, 0}},
+		{"text marshaler", _RegTestTag{N: 0xa5}, co.REG_SZ, []byte{'#', 0, 'a', 0, '5', 0, 0, 0}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			val, err := RegValEncode(tc.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if val.Type() != tc.wantType || !bytes.Equal(val.data, tc.wantData) {
+				t.Errorf("got type %d % x, want type %d % x", val.Type(), val.data, tc.wantType, tc.wantData)
+			}
+		})
+	}
+}
+
+func TestRegValEncodeErrors(t *testing.T) {
+	for _, v := range []interface{}{
+		nil,
+		"a\x00b",
+		[]string{"a", "", "b"},
+		[]string{"a\x00"},
+		1.5,
+		[]int{1},
+		struct{}{},
+	} {
+		if _, err := RegValEncode(v); err == nil {
+			t.Errorf("RegValEncode(%#v): expected error", v)
+		}
+	}
+}
+
+func TestRegValDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		val  RegVal
+		want interface{} // zero value of the target type, and the expected result
+	}{
+		{"sz", RegValSz("ab"), "ab"},
+		{"sz without null", RegVal{co.REG_SZ, []byte{'a', 0, 'b', 0}}, "ab"},
+		{"sz with trailing garbage", RegVal{co.REG_SZ, []byte{'a', 0, 0, 0, 'b', 0}}, "a"},
+		{"sz odd length", RegVal{co.REG_SZ, []byte{'a', 0, 'b'}}, "a"},
+		{"empty sz", RegVal{co.REG_SZ, []byte{}}, ""},
+		{"expand sz", RegValExpandSz("%P%"), "%P%"},
+		{"expand sz without null", RegVal{co.REG_EXPAND_SZ, []byte{'%', 0, 'P', 0, '%', 0}}, "%P%"},
+		{"dword to bool", RegValDword(2), true},
+		{"dword to int32", RegValDword(0xffff_ffff), int32(-1)},
+		{"dword to int64", RegValDword(0xffff_ffff), int64(0xffff_ffff)},
+		{"dword to uint8", RegValDword(200), uint8(200)},
+		{"big endian dword", RegValDwordBigEndian(0x0102_0304), uint32(0x0102_0304)},
+		{"qword to int", RegValQword(0xffff_ffff_ffff_ffff), -1},
+		{"qword to uint64", RegValQword(0x8000_0000_0000_0000), uint64(0x8000_0000_0000_0000)},
+		{"qword to duration", RegValQword(uint64(time.Second)), time.Second},
+		{"binary", RegValBinary([]byte{1, 2}), []byte{1, 2}},
+		{"empty binary", RegValBinary([]byte{}), []byte{}},
+		{"multi sz", RegValMultiSz("a", "b"), []string{"a", "b"}},
+		{"one multi sz", RegValMultiSz("a"), []string{"a"}},
+		{"empty multi sz", RegValMultiSz(), []string{}},
+		{"no data multi sz", RegVal{co.REG_MULTI_SZ, []byte{}}, []string{}},
+		{"multi sz with embedded empty", RegVal{co.REG_MULTI_SZ, []byte{'a', 0, 0, 0, 0, 0, 'b', 0, 0, 0, 0, 0}}, []string{"a"}},
+		{"multi sz without final null", RegVal{co.REG_MULTI_SZ, []byte{'a', 0, 0, 0, 'b', 0}}, []string{"a", "b"}},
+		{"text unmarshaler", RegValSz("#a5"), _RegTestTag{N: 0xa5}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			target := reflect.New(reflect.TypeOf(tc.want))
+			if err := RegValDecode(tc.val, target.Interface()); err != nil {
+				t.Fatal(err)
+			}
+			if got := target.Elem().Interface(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %#v, want %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRegValRoundTrip(t *testing.T) {
+	type Named []string
+	for _, v := range []interface{}{
+		"text", "", true, false, int8(-128), int16(-1), int32(-5), int64(-5), 7,
+		uint8(255), uint16(1), uint32(0xffff_ffff), uint64(1 << 63), uint(3),
+		time.Minute, []byte{0, 1, 0xff}, []string{"x", "y z"}, Named{"n"},
+		_RegTestTag{N: 0x3c},
+	} {
+		val, err := RegValEncode(v)
+		if err != nil {
+			t.Fatalf("RegValEncode(%#v): %v", v, err)
+		}
+		target := reflect.New(reflect.TypeOf(v))
+		if err := RegValDecode(val, target.Interface()); err != nil {
+			t.Fatalf("RegValDecode(%#v): %v", v, err)
+		}
+		if got := target.Elem().Interface(); !reflect.DeepEqual(got, v) {
+			t.Errorf("round-trip got %#v, want %#v", got, v)
+		}
+	}
+}
+
+func TestRegValDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		val      RegVal
+		target   interface{}
+		mismatch bool // whether it wraps co.ERROR_DATATYPE_MISMATCH
+	}{
+		{"short dword", RegVal{co.REG_DWORD, []byte{1, 0}}, new(uint32), true},
+		{"short big endian dword", RegVal{co.REG_DWORD_BIG_ENDIAN, []byte{1}}, new(uint32), true},
+		{"short qword", RegVal{co.REG_QWORD, []byte{1, 0, 0, 0}}, new(uint64), true},
+		{"sz into int", RegValSz("1"), new(int), true},
+		{"dword into string", RegValDword(1), new(string), true},
+		{"binary into string", RegValBinary([]byte{'a', 0}), new(string), true},
+		{"sz into bytes", RegValSz("a"), new([]byte), true},
+		{"sz into strings", RegValSz("a"), new([]string), true},
+		{"multi sz into string", RegValMultiSz("a"), new(string), true},
+		{"dword into text unmarshaler", RegValDword(1), new(_RegTestTag), true},
+		{"none into int", RegValNone(), new(int), true},
+		{"overflow uint8", RegValDword(256), new(uint8), false},
+		{"overflow int8", RegValDword(0x80), new(int8), false},
+		{"overflow int16 from qword", RegValQword(1 << 40), new(int16), false},
+		{"overflow uint32 from qword", RegValQword(1 << 32), new(uint32), false},
+		{"invalid text", RegValSz("red"), new(_RegTestTag), false},
+		{"unsupported type", RegValDword(1), new(float64), false},
+		{"unsupported slice", RegValBinary([]byte{1}), new([]int), false},
+		{"nil pointer", RegValDword(1), (*int)(nil), false},
+		{"not a pointer", RegValDword(1), 5, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := RegValDecode(tc.val, tc.target)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if isMismatch := errors.Is(err, co.ERROR_DATATYPE_MISMATCH); isMismatch != tc.mismatch {
+				t.Errorf("mismatch = %t, want %t (%v)", isMismatch, tc.mismatch, err)
+			}
+		})
+	}
+}
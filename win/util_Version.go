@@ -39,6 +39,10 @@ type VersionInfo struct {
 // Loads the embedded version information from an EXE or DLL, with
 // [GetFileVersionInfo] and [VerQueryValue].
 //
+// Only the first translation block and the predefined strings are loaded. To
+// read all string tables, custom strings and translations, use
+// [VersionResourceLoad].
+//
 // # Example
 //
 //	hInst, _ := win.GetModuleHandle("")
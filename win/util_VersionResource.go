@@ -0,0 +1,238 @@
+//go:build windows
+
+package win
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/rodrigocfd/windigo/internal/verblock"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+type (
+	// Full contents of a [VS_VERSIONINFO] resource, parsed with
+	// [VersionResourceParse] or loaded with [VersionResourceLoad].
+	//
+	// Unlike [VersionInfo], it keeps all string tables, including custom string
+	// keys, and all translations. It can also be serialized back into the binary
+	// format, to be embedded as a resource.
+	//
+	// [VS_VERSIONINFO]: https://learn.microsoft.com/en-us/windows/win32/menurc/vs-versioninfo
+	VersionResource struct {
+		// The fixed file information. Signature and struct version are set
+		// automatically when serializing, if zero.
+		Fixed VS_FIXEDFILEINFO
+		// Tables of the StringFileInfo block, one for each language.
+		StringTables []VersionStringTable
+		// Language and code page pairs of the VarFileInfo\Translation block.
+		Translations []VersionTranslation
+	}
+
+	// A table within the StringFileInfo block of a [VersionResource].
+	VersionStringTable struct {
+		LangId   LANGID
+		CodePage co.CP
+		// Strings of the table, in the order they appear.
+		Strings []VersionString
+	}
+
+	// A string within a [VersionStringTable], like "CompanyName".
+	VersionString struct {
+		Key   string
+		Value string
+	}
+
+	// A language and code page pair, within a [VersionResource].
+	VersionTranslation struct {
+		LangId   LANGID
+		CodePage co.CP
+	}
+)
+
+// Loads the whole version information from an EXE or DLL, with
+// [GetFileVersionInfo], and parses it with [VersionResourceParse].
+//
+// # Example
+//
+//	res, _ := win.VersionResourceLoad("C:\\Windows\\notepad.exe")
+//	for _, table := range res.StringTables {
+//		for _, str := range table.Strings {
+//			println(str.Key, str.Value)
+//		}
+//	}
+func VersionResourceLoad(moduleName string) (*VersionResource, error) {
+	szData, err := GetFileVersionInfoSize(moduleName)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, szData)
+	if err := GetFileVersionInfo(moduleName, data); err != nil {
+		return nil, err
+	}
+	return VersionResourceParse(data)
+}
+
+// Parses a raw [VS_VERSIONINFO] block, as stored in the RT_VERSION resource
+// or returned by [GetFileVersionInfo].
+//
+// This function doesn't call any system function, so it can be used with
+// contents from any source.
+//
+// [VS_VERSIONINFO]: https://learn.microsoft.com/en-us/windows/win32/menurc/vs-versioninfo
+func VersionResourceParse(blob []byte) (*VersionResource, error) {
+	root, err := verblock.Parse(blob)
+	if err != nil {
+		return nil, err
+	}
+	if root.Key != "VS_VERSION_INFO" {
+		return nil, fmt.Errorf("invalid VS_VERSIONINFO key: %q", root.Key)
+	}
+
+	me := &VersionResource{
+		StringTables: make([]VersionStringTable, 0),
+		Translations: make([]VersionTranslation, 0),
+	}
+	if len(root.Value) >= 13*4 {
+		me.Fixed = verFixedParse(root.Value)
+	}
+
+	for _, child := range root.Children {
+		switch child.Key {
+		case "StringFileInfo":
+			for _, tableBlock := range child.Children {
+				langCp, err := strconv.ParseUint(tableBlock.Key, 16, 32)
+				if err != nil {
+					return nil, fmt.Errorf("invalid string table key: %q", tableBlock.Key)
+				}
+				table := VersionStringTable{
+					LangId:   LANGID(langCp >> 16),
+					CodePage: co.CP(langCp & 0xffff),
+					Strings:  make([]VersionString, 0, len(tableBlock.Children)),
+				}
+				for _, strBlock := range tableBlock.Children {
+					table.Strings = append(table.Strings, VersionString{
+						Key:   strBlock.Key,
+						Value: verblock.DecodeStr(strBlock.Value),
+					})
+				}
+				me.StringTables = append(me.StringTables, table)
+			}
+
+		case "VarFileInfo":
+			for _, varBlock := range child.Children {
+				if varBlock.Key != "Translation" {
+					continue
+				}
+				for i := 0; i+4 <= len(varBlock.Value); i += 4 {
+					me.Translations = append(me.Translations, VersionTranslation{
+						LangId:   LANGID(binary.LittleEndian.Uint16(varBlock.Value[i:])),
+						CodePage: co.CP(binary.LittleEndian.Uint16(varBlock.Value[i+2:])),
+					})
+				}
+			}
+		}
+	}
+	return me, nil
+}
+
+// Serializes the contents into a raw [VS_VERSIONINFO] block, ready to be
+// embedded as an RT_VERSION resource.
+//
+// [VS_VERSIONINFO]: https://learn.microsoft.com/en-us/windows/win32/menurc/vs-versioninfo
+func (me *VersionResource) Serialize() []byte {
+	fixed := me.Fixed
+	if fixed.DwSignature == 0 {
+		fixed.DwSignature = verblock.FFI_SIGNATURE
+	}
+	if fixed.DwStrucVersion == 0 {
+		fixed.DwStrucVersion = verblock.FFI_STRUCVERSION
+	}
+
+	info := verblock.VersionInfo{
+		Fixed:        verFixedValues(&fixed),
+		StringTables: make([]verblock.StringTable, 0, len(me.StringTables)),
+		Translations: make([]verblock.Translation, 0, len(me.Translations)),
+	}
+	for _, table := range me.StringTables {
+		strs := make([]verblock.String, 0, len(table.Strings))
+		for _, str := range table.Strings {
+			strs = append(strs, verblock.String{Key: str.Key, Value: str.Value})
+		}
+		info.StringTables = append(info.StringTables, verblock.StringTable{
+			LangId:   uint16(table.LangId),
+			CodePage: uint16(table.CodePage),
+			Strings:  strs,
+		})
+	}
+	for _, tr := range me.Translations {
+		info.Translations = append(info.Translations, verblock.Translation{
+			LangId:   uint16(tr.LangId),
+			CodePage: uint16(tr.CodePage),
+		})
+	}
+	return info.Serialize()
+}
+
+// Returns a pointer to the string table of the given language and code page,
+// or nil if not existing.
+func (me *VersionResource) GetStringTable(langId LANGID, codePage co.CP) *VersionStringTable {
+	for idx := range me.StringTables {
+		if me.StringTables[idx].LangId == langId && me.StringTables[idx].CodePage == codePage {
+			return &me.StringTables[idx]
+		}
+	}
+	return nil
+}
+
+// Returns the given string, if existing.
+func (me *VersionStringTable) Get(key string) (string, bool) {
+	for idx := range me.Strings {
+		if me.Strings[idx].Key == key {
+			return me.Strings[idx].Value, true
+		}
+	}
+	return "", false
+}
+
+// Sets the given string. If the key doesn't exist, creates it.
+func (me *VersionStringTable) Set(key, value string) {
+	for idx := range me.Strings {
+		if me.Strings[idx].Key == key {
+			me.Strings[idx].Value = value
+			return
+		}
+	}
+	me.Strings = append(me.Strings, VersionString{key, value})
+}
+
+func verFixedParse(data []byte) VS_FIXEDFILEINFO {
+	u := func(idx int) uint32 { return binary.LittleEndian.Uint32(data[idx*4:]) }
+	return VS_FIXEDFILEINFO{
+		DwSignature:        u(0),
+		DwStrucVersion:     u(1),
+		dwFileVersionMS:    u(2),
+		dwFileVersionLS:    u(3),
+		dwProductVersionMS: u(4),
+		dwProductVersionLS: u(5),
+		DwFileFlagsMask:    co.VS_FF(u(6)),
+		DwFileFlags:        co.VS_FF(u(7)),
+		DwFileOS:           co.VOS(u(8)),
+		DwFileType:         co.VFT(u(9)),
+		DwFileSubtype:      co.VFT2(u(10)),
+		dwFileDateMS:       u(11),
+		dwFileDateLS:       u(12),
+	}
+}
+
+func verFixedValues(ffi *VS_FIXEDFILEINFO) [13]uint32 {
+	return [13]uint32{
+		ffi.DwSignature, ffi.DwStrucVersion,
+		ffi.dwFileVersionMS, ffi.dwFileVersionLS,
+		ffi.dwProductVersionMS, ffi.dwProductVersionLS,
+		uint32(ffi.DwFileFlagsMask), uint32(ffi.DwFileFlags),
+		uint32(ffi.DwFileOS), uint32(ffi.DwFileType), uint32(ffi.DwFileSubtype),
+		ffi.dwFileDateMS, ffi.dwFileDateLS,
+	}
+}
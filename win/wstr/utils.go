@@ -1,10 +1,10 @@
-//go:build windows
-
 package wstr
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // Compares two strings [lexographically].
@@ -21,6 +21,30 @@ func Cmp(a, b string) int {
 	}
 }
 
+// Compares two strings holding numbers of bytes, as formatted by [FmtBytes],
+// like "300 bytes" or "1.50 MB". Strings which cannot be parsed come first,
+// compared with [CmpI].
+func CmpBytes(a, b string) int {
+	return cmpParsed(a, b, parseBytes, cmpFloat)
+}
+
+// Returns a function which compares two strings holding dates in the given
+// [time.Parse] layout. Strings which cannot be parsed come first, compared
+// with [CmpI].
+//
+// # Example
+//
+//	cmpDates := wstr.CmpDate("2006-01-02")
+//	cmpDates("2023-10-01", "2023-09-30") // 1
+func CmpDate(layout string) func(a, b string) int {
+	return func(a, b string) int {
+		return cmpParsed(a, b, func(s string) (time.Time, bool) {
+			t, err := time.Parse(layout, strings.TrimSpace(s))
+			return t, err == nil
+		}, time.Time.Compare)
+	}
+}
+
 // Compares two strings [lexographically], case insensitive.
 //
 // [lexographically]: https://stackoverflow.com/a/52831144/6923555
@@ -28,6 +52,41 @@ func CmpI(a, b string) int {
 	return Cmp(strings.ToUpper(a), strings.ToUpper(b))
 }
 
+// Compares two strings in natural order, case insensitive: sequences of
+// digits are compared by their numeric values, so "file2" comes before
+// "file10".
+func CmpNatural(a, b string) int {
+	ra, rb := []rune(strings.ToUpper(a)), []rune(strings.ToUpper(b))
+	i, j := 0, 0
+
+	for i < len(ra) && j < len(rb) {
+		if isDigit(ra[i]) && isDigit(rb[j]) {
+			startA, startB := i, j
+			for i < len(ra) && isDigit(ra[i]) {
+				i++
+			}
+			for j < len(rb) && isDigit(rb[j]) {
+				j++
+			}
+			numA := strings.TrimLeft(string(ra[startA:i]), "0")
+			numB := strings.TrimLeft(string(rb[startB:j]), "0")
+			if len(numA) != len(numB) { // more digits, greater number
+				return sign(len(numA) - len(numB))
+			}
+			if c := Cmp(numA, numB); c != 0 {
+				return c
+			}
+		} else {
+			if ra[i] != rb[j] {
+				return sign(int(ra[i]) - int(rb[j]))
+			}
+			i++
+			j++
+		}
+	}
+	return sign((len(ra) - i) - (len(rb) - j))
+}
+
 // Formats a number of bytes into KB, MB, GB, TB or PB.
 func FmtBytes(numBytes uint) string {
 	switch {
@@ -144,3 +203,67 @@ func SubstrRunes(s string, start, length uint) string {
 	}
 	return s[startStrIdx:]
 }
+
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func cmpParsed[T any](a, b string, parse func(s string) (T, bool), cmp func(x, y T) int) int {
+	valA, okA := parse(a)
+	valB, okB := parse(b)
+	switch {
+	case !okA && !okB:
+		return CmpI(a, b)
+	case !okA:
+		return -1
+	case !okB:
+		return 1
+	default:
+		return cmp(valA, valB)
+	}
+}
+
+func isDigit(ch rune) bool {
+	return ch >= '0' && ch <= '9'
+}
+
+// Parses a string formatted by FmtBytes.
+func parseBytes(s string) (float64, bool) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return 0, false
+	}
+	val, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, false
+	}
+
+	units := []string{"BYTES", "KB", "MB", "GB", "TB", "PB"}
+	for i, unit := range units {
+		if strings.ToUpper(fields[1]) == unit || (i == 0 && fields[1] == "B") {
+			for ; i > 0; i-- {
+				val *= 1024
+			}
+			return val, true
+		}
+	}
+	return 0, false
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
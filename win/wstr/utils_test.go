@@ -0,0 +1,77 @@
+package wstr
+
+import (
+	"testing"
+)
+
+func TestCmpNatural(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "a", -1},
+		{"a", "b", -1},
+		{"a", "a1", -1},
+		{"File2", "file2", 0},
+		{"file2", "file10", -1},
+		{"file10", "file2", 1},
+		{"file02", "file2", 0},
+		{"x100y", "x99z", 1},
+		{"file 10b", "file 10a", 1},
+		{"9", "a", -1},
+	}
+
+	for _, tc := range tests {
+		if got := CmpNatural(tc.a, tc.b); got != tc.want {
+			t.Errorf("CmpNatural(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestCmpBytes(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"300 bytes", "1.50 KB", -1},
+		{"1.50 MB", "1.50 KB", 1},
+		{"1024 bytes", "1.00 KB", 0},
+		{"2 B", "1 bytes", 1},
+		{"1.00 gb", "1023.00 MB", 1},
+		{"foo", "1 KB", -1},
+		{"1 KB", "foo", 1},
+		{"abc", "ABD", -1},
+		{"abc", "ABC", 0},
+	}
+
+	for _, tc := range tests {
+		if got := CmpBytes(tc.a, tc.b); got != tc.want {
+			t.Errorf("CmpBytes(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestCmpDate(t *testing.T) {
+	cmpDates := CmpDate("2006-01-02")
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"2023-10-01", "2023-09-30", 1},
+		{"2023-09-30", "2023-10-01", -1},
+		{" 2023-10-01 ", "2023-10-01", 0},
+		{"0001-01-01", "0001-01-02", -1},
+		{"9999-12-31", "9999-12-30", 1},
+		{"1677-01-01", "2263-01-01", -1},
+		{"bad", "2023-10-01", -1},
+		{"2023-10-01", "bad", 1},
+		{"x", "Y", -1},
+	}
+
+	for _, tc := range tests {
+		if got := cmpDates(tc.a, tc.b); got != tc.want {
+			t.Errorf("CmpDate(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}